@@ -0,0 +1,112 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		changelogFile = flag.String("changelog", "", "Local CHANGELOG-X.Y.md file to read the release notes from (default: fetched from antrea-io/antrea)")
+		category      = flag.String("category", "Announcements", "Discussions category of the announcement")
+		dryRun        = flag.Bool("dry-run", false, "Print the announcement instead of creating it")
+		envFile       = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile       = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper    = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir       = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] X.Y.Z\n\nCreates the release announcement in the antrea-io/antrea Discussions.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return err
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return fmt.Errorf("expected exactly one release argument (e.g., 2.5.0)")
+	}
+	release := flag.Arg(0)
+	ver, err := version.Parse(release)
+	if err != nil {
+		return fmt.Errorf("invalid release %q: %w", release, err)
+	}
+
+	githubToken := os.Getenv("GITHUB_TOKEN")
+	if githubToken == "" && !*dryRun {
+		return fmt.Errorf("GITHUB_TOKEN environment variable is required to create the announcement")
+	}
+	ctx := context.Background()
+	githubClient := github.NewClient(ctx, githubToken)
+
+	var content string
+	if *changelogFile != "" {
+		data, err := os.ReadFile(*changelogFile)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", *changelogFile, err)
+		}
+		content = string(data)
+	} else {
+		path := fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", ver.Major(), ver.Minor())
+		if content, err = githubClient.GetFileContent(ctx, "antrea-io", "antrea", path); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", path, err)
+		}
+	}
+
+	section, err := changelog.ReleaseSection(content, release)
+	if err != nil {
+		return err
+	}
+	title, body := changelog.FormatAnnouncement(release, section)
+
+	if *dryRun {
+		fmt.Printf("Category: %s\nTitle: %s\n\n%s", *category, title, body)
+		return nil
+	}
+	url, err := githubClient.CreateDiscussion(ctx, "antrea-io", "antrea", *category, title, body)
+	if err != nil {
+		return err
+	}
+	log.Printf("Created announcement %s", url)
+	return nil
+}
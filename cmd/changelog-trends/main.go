@@ -0,0 +1,115 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		release      = flag.String("release", "", "Release to compare with the previous releases, e.g. 2.5.0 (default: the latest minor release of the CHANGELOGs)")
+		previous     = flag.Int("previous", 3, "Number of previous releases to compare with: minor releases for a minor release, patch releases of the same minor for a patch release")
+		changelogDir = flag.String("changelog-dir", "", "Local CHANGELOG directory of an antrea checkout to read (default: fetched from antrea-io/antrea)")
+		configFile   = flag.String("config", "", "Path to a YAML configuration file, for the category headers (optional, default: $CHANGELOG_CONFIG)")
+		outputFile   = flag.String("output", "", "Output file for the report (default: stdout)")
+		envFile      = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile      = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper   = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir      = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nCompares the entry counts, contributor counts and category mix of a release with the previous releases, from the historical CHANGELOGs.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return err
+	}
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		return fmt.Errorf("unexpected arguments: %v", flag.Args())
+	}
+	if *previous < 1 {
+		return fmt.Errorf("--previous must be at least 1")
+	}
+
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		var err error
+		if cfg, err = config.Load(file); err != nil {
+			return err
+		}
+	}
+
+	var files map[string]string
+	var err error
+	if *changelogDir != "" {
+		if files, err = changelog.ReadCHANGELOGs(*changelogDir); err != nil {
+			return err
+		}
+	} else {
+		// GITHUB_TOKEN is optional (improves rate limits if provided)
+		ctx := context.Background()
+		githubClient := github.NewClient(ctx, os.Getenv("GITHUB_TOKEN"))
+		if files, err = changelog.FetchCHANGELOGs(ctx, githubClient); err != nil {
+			return err
+		}
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no CHANGELOG-X.Y.md file to compare")
+	}
+
+	stats, err := changelog.ReleaseTrends(files, cfg.Categories, *release, *previous)
+	if err != nil {
+		return err
+	}
+	report := changelog.FormatTrendsReport(stats)
+	if *outputFile == "" {
+		fmt.Print(report)
+		return nil
+	}
+	if err := artifacts.WriteFile(*outputFile, []byte(report)); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	log.Printf("Trends report written to %s", *outputFile)
+	return nil
+}
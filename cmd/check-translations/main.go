@@ -0,0 +1,107 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		changelogFile = flag.String("changelog", "", "English CHANGELOG-X.Y.md file the translations are checked against (required)")
+		releases      = flag.String("release", "", "Comma-separated list of releases (X.Y.Z) to check (default: all the releases of the English CHANGELOG)")
+		outputFile    = flag.String("output", "", "Output file for the report (default: stdout)")
+		configFile    = flag.String("config", "", "Path to a YAML configuration file, for the PR link template (optional, default: $CHANGELOG_CONFIG)")
+		workdir       = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] LOCALIZED_CHANGELOG...\n\nChecks that each release section of the localized CHANGELOGs links the same PRs as the English CHANGELOG, and fails if any drifted.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if *changelogFile == "" {
+		return fmt.Errorf("--changelog flag is required")
+	}
+	if flag.NArg() == 0 {
+		flag.Usage()
+		return fmt.Errorf("expected at least one localized CHANGELOG argument")
+	}
+	english, err := os.ReadFile(*changelogFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", *changelogFile, err)
+	}
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		if cfg, err = config.Load(file); err != nil {
+			return err
+		}
+	}
+	var releaseList []string
+	for _, r := range strings.Split(*releases, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			releaseList = append(releaseList, r)
+		}
+	}
+
+	var reports []string
+	var drifted int
+	for _, file := range flag.Args() {
+		localized, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		drifts := changelog.CompareTranslation(string(english), string(localized), releaseList)
+		if len(drifts) > 0 {
+			log.Printf("%s drifted from %s in %d release sections", file, *changelogFile, len(drifts))
+			drifted++
+		}
+		reports = append(reports, changelog.FormatTranslationReport(file, drifts, cfg.Links))
+	}
+
+	report := strings.Join(reports, "\n")
+	if *outputFile == "" {
+		fmt.Print(report)
+	} else {
+		if err := artifacts.WriteFile(*outputFile, []byte(report)); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		log.Printf("Report written to %s", *outputFile)
+	}
+	if drifted > 0 {
+		return fmt.Errorf("%d of %d translations drifted from the English CHANGELOG", drifted, flag.NArg())
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		outputDir     = flag.String("output-dir", "", "Directory of the prepare-changelog model artifacts (default: current directory), may be a template (e.g. releases/{{.Version}})")
+		nameTmpl      = flag.String("artifact-name", artifacts.DefaultNameTemplate, "Template of the prepare-changelog model artifact filenames")
+		storeURL      = flag.String("artifact-store", "", "Bucket to read the model artifacts from instead of the local file system, s3://bucket[/prefix] or gs://bucket[/prefix]")
+		artifactsOnly = flag.Bool("artifacts-only", false, "Only export the run history from the artifacts, without fetching the PR readiness and the release drift from GitHub")
+		compareNotes  = flag.Bool("compare-notes", false, "Also compare the CHANGELOG section of each release with the release notes GitHub generates for its tag (requires GITHUB_TOKEN and the vX.Y.Z tags)")
+		outputFile    = flag.String("output", "", "Output file for the JSON document (default: stdout)")
+		envFile       = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile       = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper    = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir       = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] X.Y.Z...\n\nExports the release dashboard data of releases as one JSON document: the prepare-changelog run history, the PR readiness, the release drift and the release notes comparison.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return err
+	}
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		return fmt.Errorf("expected at least one release argument (e.g., 2.5.0)")
+	}
+	var releases []*version.Version
+	for _, arg := range flag.Args() {
+		ver, err := version.Parse(arg)
+		if err != nil {
+			return fmt.Errorf("invalid release %q: %w", arg, err)
+		}
+		releases = append(releases, ver)
+	}
+	if *artifactsOnly && *compareNotes {
+		return fmt.Errorf("--compare-notes cannot be used with --artifacts-only")
+	}
+
+	ctx := context.Background()
+	// Generating release notes requires a token, even for public repositories
+	githubToken := os.Getenv("GITHUB_TOKEN")
+	if *compareNotes && githubToken == "" {
+		return fmt.Errorf("GITHUB_TOKEN environment variable is required to generate the GitHub release notes")
+	}
+	githubClient := github.NewClient(ctx, githubToken)
+	storage, err := artifacts.NewStorage(ctx, *storeURL)
+	if err != nil {
+		return err
+	}
+	clock, err := changelog.ClockFromEnv()
+	if err != nil {
+		return err
+	}
+
+	dashboard := types.Dashboard{
+		GeneratedAt: clock().UTC().Format(time.RFC3339),
+		ToolVersion: changelog.ToolVersion(),
+		Unavailable: changelog.DashboardUnavailable,
+	}
+	var failed int
+	for _, ver := range releases {
+		release := ver.String()
+		log.Printf("Exporting the dashboard data of %s", release)
+		r := types.DashboardRelease{Release: release}
+		if err := exportRelease(ctx, githubClient, storage, &r, ver, *outputDir, *nameTmpl, *artifactsOnly, *compareNotes); err != nil {
+			// The other releases are still exported, the failure is recorded
+			// in the document
+			log.Printf("Error: release %s: %v", release, err)
+			r.Err = err.Error()
+			failed++
+		}
+		dashboard.Releases = append(dashboard.Releases, r)
+	}
+
+	data, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the dashboard data: %w", err)
+	}
+	data = append(data, '\n')
+	if *outputFile == "" {
+		fmt.Print(string(data))
+	} else {
+		if err := artifacts.WriteFile(*outputFile, data); err != nil {
+			return fmt.Errorf("failed to write dashboard data: %w", err)
+		}
+		log.Printf("Dashboard data written to %s", *outputFile)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d releases could not be exported completely", failed, len(releases))
+	}
+	return nil
+}
+
+// exportRelease fills the dashboard data of a release, stopping at the first
+// data source which fails
+func exportRelease(ctx context.Context, githubClient *github.RealClient, storage artifacts.Storage, r *types.DashboardRelease, ver *version.Version, outputDir, nameTmpl string, artifactsOnly, compareNotes bool) error {
+	runs, err := changelog.RunHistory(outputDir, nameTmpl, r.Release, storage)
+	if err != nil {
+		return fmt.Errorf("failed to read the run history: %w", err)
+	}
+	r.Runs = runs
+	if artifactsOnly {
+		return nil
+	}
+
+	// The PR readiness fetches the PRs of the release without calling the
+	// model, and the release drift is audited while fetching them
+	generator := changelog.NewChangelogGenerator(r.Release, "", false, "", nil, githubClient, changelog.WithDriftReport(true))
+	readiness, err := generator.PRReadiness(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to assess the PR descriptions: %w", err)
+	}
+	r.PRs = len(readiness)
+	for _, pr := range readiness {
+		if len(pr.Issues) > 0 {
+			r.NotReady = append(r.NotReady, pr)
+		}
+	}
+	r.Drift = generator.ReleaseDrift()
+
+	if compareNotes {
+		path := fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", ver.Major(), ver.Minor())
+		content, err := githubClient.GetFileContent(ctx, "antrea-io", "antrea", path)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", path, err)
+		}
+		section, err := changelog.ReleaseSection(content, r.Release)
+		if err != nil {
+			return err
+		}
+		notes, err := githubClient.GenerateReleaseNotes(ctx, "antrea-io", "antrea", "v"+r.Release, "", "")
+		if err != nil {
+			return err
+		}
+		r.Notes = changelog.CompareReleaseNotes(r.Release, section, notes)
+	}
+	return nil
+}
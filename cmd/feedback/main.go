@@ -0,0 +1,131 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		draftFile     = flag.String("draft", "", "Draft CHANGELOG generated by prepare-changelog for the release (required)")
+		changelogFile = flag.String("changelog", "", "Local merged CHANGELOG-X.Y.md file (default: fetched from antrea-io/antrea)")
+		dataset       = flag.String("dataset", "corrections.jsonl", "Corrections dataset to append to")
+		configFile    = flag.String("config", "", "Path to a YAML configuration file (optional, default: $CHANGELOG_CONFIG)")
+		dryRun        = flag.Bool("dry-run", false, "Print the corrections instead of appending them to the dataset")
+		envFile       = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile       = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper    = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir       = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] X.Y.Z\n\nRecords how the generated draft of a release was edited before the CHANGELOG was merged, in the corrections dataset used as prompt examples.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return err
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return fmt.Errorf("expected exactly one release argument (e.g., 2.5.0)")
+	}
+	release := flag.Arg(0)
+	ver, err := version.Parse(release)
+	if err != nil {
+		return fmt.Errorf("invalid release %q: %w", release, err)
+	}
+	if *draftFile == "" {
+		return fmt.Errorf("--draft flag is required")
+	}
+
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		if cfg, err = config.Load(file); err != nil {
+			return err
+		}
+	}
+
+	draft, err := os.ReadFile(*draftFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", *draftFile, err)
+	}
+	var merged string
+	if *changelogFile != "" {
+		data, err := os.ReadFile(*changelogFile)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", *changelogFile, err)
+		}
+		merged = string(data)
+	} else {
+		// GITHUB_TOKEN is optional (improves rate limits if provided)
+		ctx := context.Background()
+		githubClient := github.NewClient(ctx, os.Getenv("GITHUB_TOKEN"))
+		path := fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", ver.Major(), ver.Minor())
+		if merged, err = githubClient.GetFileContent(ctx, "antrea-io", "antrea", path); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", path, err)
+		}
+	}
+	if _, err := changelog.ReleaseSection(merged, release); err != nil {
+		return fmt.Errorf("the merged CHANGELOG has no %s section, was it released: %w", release, err)
+	}
+
+	corrections := changelog.CollectCorrections(release, string(draft), merged, cfg.Categories)
+	log.Printf("Found %d entries edited, added or removed by hand", len(corrections))
+
+	if *dryRun {
+		for _, c := range corrections {
+			line, err := json.Marshal(c)
+			if err != nil {
+				return fmt.Errorf("failed to marshal correction: %w", err)
+			}
+			fmt.Println(string(line))
+		}
+		return nil
+	}
+	appended, err := changelog.AppendCorrections(*dataset, corrections)
+	if err != nil {
+		return err
+	}
+	log.Printf("Appended %d corrections to %s (%d already recorded)", appended, *dataset, len(corrections)-appended)
+	return nil
+}
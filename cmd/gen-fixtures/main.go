@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/selftest"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		release    = flag.String("release", "2.5.0", "Minor release of the generated fixture")
+		prs        = flag.Int("prs", 50, "Number of PRs merged for the release, including bot and unlabeled PRs")
+		historical = flag.Int("historical-releases", 3, "Number of previous minor releases with a historical CHANGELOG")
+		seed       = flag.Uint64("seed", 1, "Seed of the generated data, the same flags always generate the same fixture")
+		outputFile = flag.String("output", "", "Output file for the fixture, e.g. fixtures/large.json (default: stdout)")
+		workdir    = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nGenerates a selftest fixture with synthetic PRs, historical CHANGELOGs and model response, to run the pipeline without access tokens or real repository data.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		return fmt.Errorf("unexpected arguments: %v", flag.Args())
+	}
+
+	// The dates of the historical CHANGELOGs are fixed by SOURCE_DATE_EPOCH, if set
+	clock, err := changelog.ClockFromEnv()
+	if err != nil {
+		return err
+	}
+	fixture, err := selftest.GenerateFixture(selftest.FixtureOptions{
+		Release:            *release,
+		PRs:                *prs,
+		HistoricalReleases: *historical,
+		Seed:               *seed,
+	}, clock())
+	if err != nil {
+		return err
+	}
+	data, err := selftest.WriteFixture(fixture)
+	if err != nil {
+		return err
+	}
+	if *outputFile == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := artifacts.WriteFile(*outputFile, data); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	log.Printf("Fixture with %d PRs written to %s", len(fixture.Pulls), *outputFile)
+	return nil
+}
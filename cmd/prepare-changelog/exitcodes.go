@@ -0,0 +1,98 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// Exit codes of prepare-changelog, so that wrappers and workflows can react to
+// the failure class without parsing logs. Code 2 is used by the flag package
+// for invalid command-line syntax.
+const (
+	exitSuccess             = 0
+	exitFailure             = 1
+	exitSuccessWithWarnings = 3
+	exitValidationFailure   = 4
+	exitModelFailure        = 5
+	exitGitHubFailure       = 6
+	exitBudgetExceeded      = 7
+)
+
+// exitCode returns the exit code for an error returned by run
+func exitCode(err error) int {
+	if err == nil {
+		return exitSuccess
+	}
+	var budgetErr *types.BudgetExceededError
+	if errors.As(err, &budgetErr) {
+		return exitBudgetExceeded
+	}
+	var validationErr *types.ValidationError
+	if errors.As(err, &validationErr) {
+		return exitValidationFailure
+	}
+	var timeoutErr *types.StageTimeoutError
+	if errors.As(err, &timeoutErr) {
+		if timeoutErr.Stage == changelog.StageModel {
+			return exitModelFailure
+		}
+		return exitGitHubFailure
+	}
+	// Deadlines which are not attributed to a stage are the --timeout of the run
+	if errors.Is(err, context.DeadlineExceeded) {
+		return exitBudgetExceeded
+	}
+	var modelErr *types.ModelError
+	var malformedErr *types.ModelMalformedOutputError
+	if errors.As(err, &modelErr) || errors.As(err, &malformedErr) {
+		return exitModelFailure
+	}
+	var rateLimitedErr *types.RateLimitedError
+	if errors.As(err, &rateLimitedErr) {
+		if rateLimitedErr.Service == "github" {
+			return exitGitHubFailure
+		}
+		return exitModelFailure
+	}
+	var githubErr *types.GitHubError
+	var notFoundErr *types.NotFoundError
+	if errors.As(err, &githubErr) || errors.As(err, &notFoundErr) {
+		return exitGitHubFailure
+	}
+	return exitFailure
+}
+
+// failureClass returns the class of an error returned by run, for telemetry
+func failureClass(err error) string {
+	if errors.Is(err, context.Canceled) {
+		return "interrupted"
+	}
+	switch exitCode(err) {
+	case exitValidationFailure:
+		return "validation"
+	case exitModelFailure:
+		return "model"
+	case exitGitHubFailure:
+		return "github"
+	case exitBudgetExceeded:
+		return "budget"
+	}
+	return "other"
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestExitCode(t *testing.T) {
+	apiErr := errors.New("500 Internal Server Error")
+	tests := []struct {
+		name          string
+		err           error
+		expectedCode  int
+		expectedClass string
+	}{
+		{
+			name:         "success",
+			err:          nil,
+			expectedCode: exitSuccess,
+		},
+		{
+			name:          "unclassified",
+			err:           errors.New("invalid release version"),
+			expectedCode:  exitFailure,
+			expectedClass: "other",
+		},
+		{
+			name:          "budget exceeded",
+			err:           &types.BudgetExceededError{Reason: "too many PRs"},
+			expectedCode:  exitBudgetExceeded,
+			expectedClass: "budget",
+		},
+		{
+			name:          "validation",
+			err:           &types.ValidationError{Err: errors.New("duplicate entry")},
+			expectedCode:  exitValidationFailure,
+			expectedClass: "validation",
+		},
+		{
+			name:          "model",
+			err:           &types.ModelError{Err: apiErr},
+			expectedCode:  exitModelFailure,
+			expectedClass: "model",
+		},
+		{
+			name:          "malformed model output",
+			err:           &types.ModelMalformedOutputError{Err: errors.New("unexpected end of JSON input")},
+			expectedCode:  exitModelFailure,
+			expectedClass: "model",
+		},
+		{
+			name:          "model rate limited",
+			err:           &types.RateLimitedError{Service: "gemini", Err: apiErr},
+			expectedCode:  exitModelFailure,
+			expectedClass: "model",
+		},
+		{
+			name:          "GitHub rate limited",
+			err:           &types.RateLimitedError{Service: "github", Err: apiErr},
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+		{
+			name:          "GitHub",
+			err:           &types.GitHubError{Err: apiErr},
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+		{
+			name:          "not found",
+			err:           &types.NotFoundError{Err: errors.New("404 Not Found")},
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+		{
+			name:          "wrapped",
+			err:           fmt.Errorf("failed to fetch PRs: %w", fmt.Errorf("failed to list pull requests: %w", &types.GitHubError{Err: apiErr})),
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+		{
+			name:          "fetch stage timeout",
+			err:           fmt.Errorf("failed to generate: %w", &types.StageTimeoutError{Stage: changelog.StageFetch, Timeout: time.Minute, Err: context.DeadlineExceeded}),
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+		{
+			name:          "model stage timeout",
+			err:           &types.StageTimeoutError{Stage: changelog.StageModel, Timeout: time.Minute, Err: &types.ModelError{Err: context.DeadlineExceeded}},
+			expectedCode:  exitModelFailure,
+			expectedClass: "model",
+		},
+		{
+			name:          "run timeout",
+			err:           fmt.Errorf("failed to fetch PRs: %w", context.DeadlineExceeded),
+			expectedCode:  exitBudgetExceeded,
+			expectedClass: "budget",
+		},
+		{
+			name:          "interrupted",
+			err:           fmt.Errorf("failed to fetch PRs: %w", context.Canceled),
+			expectedCode:  exitFailure,
+			expectedClass: "interrupted",
+		},
+		{
+			name:          "train",
+			err:           fmt.Errorf("%d of %d releases of the train failed, first error: %w", 1, 2, &types.ValidationError{Err: errors.New("duplicate entry")}),
+			expectedCode:  exitValidationFailure,
+			expectedClass: "validation",
+		},
+		{
+			name:          "wrapped train",
+			err:           fmt.Errorf("%d of %d releases of the train failed, first error: %w", 2, 3, fmt.Errorf("failed to generate: %w", &types.RateLimitedError{Service: "github", Err: apiErr})),
+			expectedCode:  exitGitHubFailure,
+			expectedClass: "github",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedCode, exitCode(tt.err))
+			if tt.err != nil {
+				assert.Equal(t, tt.expectedClass, failureClass(tt.err))
+			}
+		})
+	}
+}
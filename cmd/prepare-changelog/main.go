@@ -15,121 +15,777 @@
 package main
 
 import (
+	"bufio"
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
 	"strings"
-
-	"github.com/joho/godotenv"
+	"syscall"
+	"time"
 
 	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/genai"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/telemetry"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/trace"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
 )
 
 func main() {
-	if err := run(); err != nil {
-		log.Fatalf("Error: %v", err)
+	result, err := run()
+	if err != nil {
+		log.Printf("Error: %v", err)
+		os.Exit(exitCode(err))
+	}
+	if len(result.warnings) > 0 {
+		lines := make([]string, 0, len(result.warnings))
+		for _, w := range result.warnings {
+			lines = append(lines, "  - "+w.String())
+		}
+		log.Printf("Run warnings (%d):\n%s", len(result.warnings), strings.Join(lines, "\n"))
+	}
+	if len(result.reviewWarnings) > 0 {
+		log.Printf("Completed with warnings: %s", strings.Join(result.reviewWarnings, "; "))
+		os.Exit(exitSuccessWithWarnings)
 	}
 }
 
-func run() error {
-	// Load .env file if it exists (optional)
-	_ = godotenv.Load()
+// runResult is the outcome of a successful run
+type runResult struct {
+	// reviewWarnings make the command exit with exitSuccessWithWarnings
+	reviewWarnings []string
+	// warnings are all the non-fatal issues of the run, printed at the end
+	warnings []types.Warning
+	// releases are the outcomes of the releases of the run, several for a
+	// release train
+	releases []types.TrainResult
+	// latePRs are the PRs merged since the previous run, found by a --watch
+	// check
+	latePRs []types.PRInfo
+}
+
+func run() (result *runResult, err error) {
+	start := time.Now()
 
 	// Parse command-line flags
 	var (
-		release     = flag.String("release", "", "Release version (e.g., 2.5.0)")
+		release     = flag.String("release", "", "Release version (e.g., 2.5.0), or 'unreleased' for the changes merged into main since the last minor release")
+		configFile  = flag.String("config", "", "Path to a YAML configuration file (optional, default: $CHANGELOG_CONFIG)")
 		fromRelease = flag.String("from-release", "", "Previous release version (optional, auto-calculated if not provided)")
-		all         = flag.Bool("all", false, "Include all PRs (not just those with action/release-note label)")
-		outputFile  = flag.String("output", "", "Output file (default: stdout)")
-		model       = flag.String("model", "gemini-2.5-flash", "Gemini model to use")
+		all         = flag.Bool("all", false, "Deprecated alias for --fetch-all")
+		fetchAll    = flag.Bool("fetch-all", false, "Send all PRs to the model (not just those with action/release-note label)")
+		includeOpt  = flag.Bool("include-optional", true, "Emit entries below the include score threshold with the *OPTIONAL* prefix (otherwise they are left out)")
+		outputFile  = flag.String("output", "", "Output file (default: stdout), may be a template (e.g. {{.Version}}/CHANGELOG.md)")
+		model       = flag.String("model", "gemini-2.5-flash", "Model to use (a Gemini model, or a model of the --model-base-url API)")
+		outputDir   = flag.String("output-dir", "", "Directory for model artifacts (default: current directory), may be a template (e.g. releases/{{.Version}})")
+		nameTmpl    = flag.String("artifact-name", artifacts.DefaultNameTemplate, "Template for model artifact filenames")
+		storeURL    = flag.String("artifact-store", "", "Bucket to write the model artifacts to instead of the local file system, s3://bucket[/prefix] or gs://bucket[/prefix] (the trace and --output stay local)")
+		bundle      = flag.Bool("bundle", false, "Package all artifacts and the changelog into a single tar.gz")
+		mergeInto   = flag.String("merge-into", "", "Existing CHANGELOG-X.Y.md file to merge the new release section into (created if missing)")
+		authorLinks = flag.String("author-links", string(changelog.LinkPlacementAuto), "Author link placement when merging: auto, per-section, or end-of-file")
+		styleFl     = flag.String("style", "", "Style profile of the descriptions: antrea-classic (imperative mood) or kubernetes-style (past tense, inline links) (default: style in the config file, antrea-classic if not set)")
+		linkStyleFl = flag.String("link-style", string(changelog.LinkStyleAuto), "Style of the PR and author links: auto (style of the file merged into, mixed otherwise), mixed (inline PR links, author references), inline, or reference")
+		excludeLbls = flag.String("exclude-labels", "", "Comma-separated list of PR labels to exclude from the changelog")
+		prSource    = flag.String("pr-source", string(changelog.PRSourceList), "How PRs are discovered: list (PRs merged into the branch after the from-release) or compare (commits between the from-release tag and the branch, supports merge commits)")
+		maxMonths   = flag.Int("max-window-months", 0, "Warn and ask for confirmation if the from-release is older than this many months (0 to disable)")
+		maxPRs      = flag.Int("max-prs", 0, "Warn and ask for confirmation if more PRs than this would be sent to the model (0 to disable)")
+		assumeYes   = flag.Bool("yes", false, "Assume yes for all confirmation prompts (for unattended runs)")
+		telemetryEP = flag.String("telemetry-endpoint", "", "Opt in to post anonymized metrics of the run (duration, token usage, failure class) to this URL, see telemetry_endpoint in the config file (default: disabled)")
+		traceCalls  = flag.Bool("trace", false, "Record metadata of every GitHub and model call to a trace file")
+		provenance  = flag.Bool("provenance", false, "Embed an HTML comment recording the tool version, model, prompt hash and timestamp in the generated section")
+		frontMatter = flag.Bool("front-matter", false, "Prepend YAML front matter with the version, date, entry counts per category and tool version to the generated section (not merged with --merge-into)")
+		includeMin  = flag.Int("include-score", config.DefaultThresholds().Include, "Minimum include_score for an entry to be included normally (overrides the config file)")
+		maxDescLen  = flag.Int("max-description-length", config.Default().MaxDescriptionLength, "Maximum length of single-sentence entry descriptions, longer ones are shortened by the model (0 for no limit, overrides the config file)")
+		minDescConf = flag.Int("min-description-confidence", config.DefaultMinDescriptionConfidence, "Minimum description_confidence of an entry, the PR title marked with a TODO comment is used instead of less confident descriptions (0 to disable, overrides the config file)")
+		windows     = flag.Bool("windows-callout", false, "Repeat Windows-specific entries in a dedicated section (uses the windows settings of the config file, if any)")
+		knownIssues = flag.String("known-issues-label", "", "Render a Known Issues section listing the open issues with this label (e.g. known-issue/v2.5)")
+		docsOnlyFl  = flag.String("docs-only", string(changelog.DocsOnlyOff), "Handling of the PRs which only change documentation (see docs_paths in the config file): off, mark (ask the model for a low include_score), or exclude (fetches the files of every PR)")
+		notableDeps = flag.Bool("notable-deps", false, "Keep the bot PRs upgrading a notable dependency (see notable_dependencies in the config file) and ask the model for a CHANGED entry about each of them (fetches the files of bot PRs)")
+		platformHnt = flag.Bool("platform-hints", false, "Detect build matrix and Dockerfile changes and ask the model to call out newly supported platforms (fetches the files of every PR)")
+		optionalMin = flag.Int("optional-score", config.DefaultThresholds().Optional, "Minimum include_score for an entry to be included with the *OPTIONAL* prefix (overrides the config file)")
+		k8sCheck    = flag.Bool("kubernetes-version-check", true, "Detect upgrades of the Kubernetes dependencies in go.mod, ask the model for a CHANGED entry about the supported Kubernetes versions and warn if there is none")
+		configCheck = flag.Bool("config-defaults-check", true, "Detect changed defaults of the Helm values and of the antrea-agent/antrea-controller config templates, ask the model for a CHANGED entry about each of them and warn about unexplained changes")
+		flagsCheck  = flag.Bool("cli-flags-check", true, "Detect the antctl, antrea-agent and antrea-controller flags added, deprecated or removed since the from-release tag, and ask the model to mention them")
+		flagsSect   = flag.Bool("cli-flags-section", false, "Also list the CLI flag changes of --cli-flags-check in their own CHANGELOG section")
+		deprecCheck = flag.Bool("deprecations-check", true, "Detect the Prometheus metrics and CRD fields deprecated or removed since the from-release tag, and list them in a Deprecated section")
+		polite      = flag.Bool("polite", false, "Cap the rate and concurrency of GitHub requests, leave part of the rate limit to other clients and wait for the off-peak window, when sharing a GitHub token with other automation (see polite in the config file)")
+		followUps   = flag.Bool("group-follow-ups", true, "Detect the PRs following up on another PR of the release (e.g. \"Follow-up to #123\") and list them in the entry of the original PR")
+		issueSympt  = flag.Bool("issue-symptoms", false, "Fetch the titles of the issues closed by the PRs (e.g. \"Fixes #123\") and ask the model to describe fixes by the symptom users reported")
+		labelLegend = flag.Bool("label-legend", true, "Explain the area/*, kind/* and action/* labels of the PRs in the prompt, using the label descriptions of the repository")
+		auditLabels = flag.Bool("audit-labels", false, "Send all PRs to the model (implies --fetch-all) and report the ones the model would include but which lack the action/release-note label")
+		createPR    = flag.Bool("create-pr", false, "Commit the changelog to CHANGELOG/CHANGELOG-X.Y.md on a new branch and open a pull request against antrea-io/antrea (requires GITHUB_TOKEN)")
+		prFork      = flag.String("pr-fork", "", "Repository (owner/repo) to push the --create-pr branch to (default: antrea-io/antrea)")
+		prBranch    = flag.String("pr-branch", "", "Name of the --create-pr branch (default: changelog-vX.Y.Z)")
+		modelURL    = flag.String("model-base-url", "", "Base URL of an OpenAI-compatible API to call instead of the Gemini API, e.g. https://openrouter.ai/api/v1 (uses MODEL_API_KEY)")
+		minQuality  = flag.Int("min-quality", 0, "Fail before merging or publishing the changelog if the quality score (0-100) is lower than this")
+		prDryRun    = flag.Bool("pr-dry-run", false, "With --create-pr, print the CHANGELOG file diff and the pull request which would be submitted instead of creating it (only reads from GitHub)")
+		timeout     = flag.Duration("timeout", 0, "Overall deadline of the run, e.g. 30m (0 for no deadline)")
+		fetchTmout  = flag.Duration("fetch-timeout", 0, "Deadline for fetching data from GitHub, before and after the model call (0 for no deadline)")
+		modelTmout  = flag.Duration("model-timeout", 0, "Deadline for each model call (0 for no deadline)")
+		prFilterExp = flag.String("pr-filter", "", "Only send the PRs matching this expression to the model, e.g. 'label:area/agent AND NOT label:kind/documentation' (terms: label:<pattern>, author:<login>, title:<text>)")
+		format      = flag.String("format", string(changelog.OutputFormatMarkdown), "Format of the changelog output: markdown (CHANGELOG-X.Y.md section), hugo (page with front matter for the antrea.io website) or email (plain text for the announce mailing list)")
+		websitePR   = flag.Bool("create-website-pr", false, "Open a pull request adding the release notes as a Hugo page to the website repository (requires GITHUB_TOKEN)")
+		websiteRepo = flag.String("website-repo", "antrea-io/website", "Website repository (owner/repo) for --create-website-pr")
+		websitePath = flag.String("website-path", "content/releases/v{{.Version}}.md", "Path of the --create-website-pr page in the website repository, may be a template")
+		includePRs  = flag.String("include-prs", "", "Comma-separated list of PR numbers to send to the model and include regardless of their author, labels and the filters (e.g. a bot PR with user impact)")
+		excludePRs  = flag.String("exclude-prs", "", "Comma-separated list of PR numbers to exclude from the changelog")
+		driftReport = flag.Bool("drift-report", false, "Compare the PRs of the commits on the release branch since the from-release tag with the selected PRs before calling the model, and write a report of the discrepancies")
+		readiness   = flag.Bool("pr-readiness", false, "Only score the descriptions of the PRs of the release for release-note readiness (empty body, missing release-note block, vague title) and write a report, without calling the model")
+		readyIssue  = flag.Bool("pr-readiness-issue", false, "With --pr-readiness, also open an issue in antrea-io/antrea with the report, mentioning the authors of the PRs to improve (requires GITHUB_TOKEN)")
+		anchors     = flag.Bool("entry-anchors", false, "Add an HTML anchor derived from the PR number to each entry (e.g. <a id=\"pr-7200\"></a>), so that entries can be deep-linked")
+		ackPatchAdd = flag.String("ack-patch-features", "", "Comma-separated list of PR numbers acknowledged as ADDED entries of a patch release (e.g. intended feature backports)")
+		correctFile = flag.String("corrections", "corrections.jsonl", "Corrections dataset written by the feedback command, whose most recent entries are added to the prompt as examples (ignored if missing)")
+		envFile     = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile     = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper  = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir     = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+		codeOwners  = flag.String("codeowners", "", "CODEOWNERS-style file mapping paths to owners: assign each entry to the owners of most of the files changed by its PRs, write a review routing report, and request their reviews with --create-pr (fetches the files of every rendered entry)")
+		incremental = flag.Bool("incremental", false, "Reuse the entries of the latest output artifact of the release in --output-dir, only send the PRs merged since that run to the model, and render the release section again with all the entries (e.g. for late cherry-picks)")
+		watch       = flag.Duration("watch", 0, "Watch the release branch between the changelog freeze and the release tag: check at this interval (e.g. 10m) for PRs merged since the latest output artifact of the release in --output-dir, alert about them, and stop once the release is tagged (implies --incremental)")
+		watchRegen  = flag.Bool("watch-regenerate", false, "With --watch, generate the changelog again (incrementally) when PRs are merged, instead of only alerting")
+		watchIssue  = flag.Int("watch-issue", 0, "With --watch, also post the alerts as comments on this issue of antrea-io/antrea, e.g. the release tracking issue (requires GITHUB_TOKEN)")
+		trainFile   = flag.String("train", "", "Release train file listing the releases cut together: generate (and publish, with --create-pr) each of them, sharing the GitHub responses, and write one summary report")
+		machine     = flag.Bool("machine", false, "Write only the changelog to stdout, once the run has succeeded, for use in shell pipelines (artifacts go to --output-dir, logs and other output to stderr)")
 	)
 	flag.Parse()
 
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return nil, err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return nil, err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return nil, err
+	}
+
 	// Validate required flags
-	if *release == "" {
-		return fmt.Errorf("--release flag is required")
+	var train *config.Train
+	releases := []config.TrainRelease{{Release: *release, FromRelease: *fromRelease, PRBranch: *prBranch}}
+	if *trainFile != "" {
+		if *release != "" || *fromRelease != "" || *prBranch != "" {
+			return nil, fmt.Errorf("--release, --from-release and --pr-branch cannot be used with --train, set them for each release in the train file")
+		}
+		if *mergeInto != "" || *machine {
+			return nil, fmt.Errorf("--merge-into and --machine cannot be used with --train")
+		}
+		if *outputFile != "" && !strings.Contains(*outputFile, "{{") {
+			return nil, fmt.Errorf("--output must be a template (e.g. {{.Version}}/CHANGELOG.md) with --train")
+		}
+		var err error
+		if train, err = config.LoadTrain(*trainFile); err != nil {
+			return nil, err
+		}
+		releases = train.Releases
+	} else if *release == "" {
+		return nil, fmt.Errorf("--release flag is required")
+	}
+	runName := *release
+	if train != nil {
+		runName = "train-" + train.Name
+	}
+
+	ackedPatchFeatures, err := parsePRNumbers("--ack-patch-features", *ackPatchAdd)
+	if err != nil {
+		return nil, err
+	}
+	var overrides changelog.PROverrides
+	if overrides.Include, err = parsePRNumbers("--include-prs", *includePRs); err != nil {
+		return nil, err
+	}
+	if overrides.Exclude, err = parsePRNumbers("--exclude-prs", *excludePRs); err != nil {
+		return nil, err
+	}
+	for _, number := range overrides.Include {
+		if slices.Contains(overrides.Exclude, number) {
+			return nil, fmt.Errorf("PR #%d cannot be in both --include-prs and --exclude-prs", number)
+		}
+	}
+
+	if *machine {
+		if *outputFile != "" {
+			return nil, fmt.Errorf("--output cannot be used with --machine, the changelog is written to stdout")
+		}
+		// This is the default, but stdout must never receive logs in this mode
+		log.SetOutput(os.Stderr)
+	}
+
+	outputFormat, err := changelog.ParseOutputFormat(*format)
+	if err != nil {
+		return nil, err
+	}
+
+	if *release == changelog.UnreleasedRelease && (*mergeInto != "" || *createPR || *websitePR || outputFormat == changelog.OutputFormatHugo) {
+		return nil, fmt.Errorf("--merge-into, --create-pr, --create-website-pr and --format %s cannot be used with --release %s", changelog.OutputFormatHugo, changelog.UnreleasedRelease)
+	}
+
+	if *readiness && (*mergeInto != "" || *createPR || *websitePR || train != nil) {
+		return nil, fmt.Errorf("--pr-readiness only writes a report, it cannot be used with --merge-into, --create-pr, --create-website-pr or --train")
+	}
+	if *readyIssue && !*readiness {
+		return nil, fmt.Errorf("--pr-readiness-issue requires --pr-readiness")
+	}
+
+	if *watch > 0 {
+		if train != nil || *readiness || *createPR || *websitePR || *machine || *release == changelog.UnreleasedRelease {
+			return nil, fmt.Errorf("--watch cannot be used with --train, --pr-readiness, --create-pr, --create-website-pr, --machine or --release %s", changelog.UnreleasedRelease)
+		}
+		// Every check compares the selected PRs with the latest output artifact
+		*incremental = true
+	} else if *watch < 0 {
+		return nil, fmt.Errorf("--watch must be a positive interval, got: %s", *watch)
+	} else if *watchRegen || *watchIssue != 0 {
+		return nil, fmt.Errorf("--watch-regenerate and --watch-issue require --watch")
+	}
+	if *watchIssue < 0 {
+		return nil, fmt.Errorf("invalid issue number %d in --watch-issue", *watchIssue)
 	}
 
-	// Validate model name
-	if !strings.HasPrefix(*model, "gemini-") {
-		return fmt.Errorf("model must start with 'gemini-', got: %s", *model)
+	// Validate model name, any model may be served by an OpenAI-compatible API
+	if *modelURL == "" && !strings.HasPrefix(*model, "gemini-") {
+		return nil, fmt.Errorf("model must start with 'gemini-', got: %s", *model)
+	}
+
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		var err error
+		if cfg, err = config.Load(file); err != nil {
+			return nil, err
+		}
 	}
 
-	// Get API keys from environment
-	googleAPIKey := os.Getenv("GOOGLE_API_KEY")
-	if googleAPIKey == "" {
-		return fmt.Errorf("GOOGLE_API_KEY environment variable is required")
+	// Flags take precedence over the config file, but only when explicitly set
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "include-score":
+			cfg.Thresholds.Include = *includeMin
+		case "optional-score":
+			cfg.Thresholds.Optional = *optionalMin
+		case "max-description-length":
+			cfg.MaxDescriptionLength = *maxDescLen
+		case "min-description-confidence":
+			cfg.MinDescriptionConfidence = *minDescConf
+		case "windows-callout":
+			if !*windows {
+				cfg.Windows = nil
+			} else if cfg.Windows == nil {
+				cfg.Windows = config.DefaultWindowsCallout()
+			}
+		case "telemetry-endpoint":
+			cfg.TelemetryEndpoint = *telemetryEP
+		case "style":
+			cfg.Style = config.StyleProfile(*styleFl)
+		}
+	})
+	if err := cfg.Thresholds.Validate(); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateTelemetryEndpoint(cfg.TelemetryEndpoint); err != nil {
+		return nil, err
+	}
+	if _, err := config.ParseStyleProfile(string(cfg.Style)); err != nil {
+		return nil, err
+	}
+
+	source, err := changelog.ParsePRSource(*prSource)
+	if err != nil {
+		return nil, err
+	}
+
+	linkPlacement, err := changelog.ParseLinkPlacement(*authorLinks)
+	if err != nil {
+		return nil, err
+	}
+	docsOnly, err := changelog.ParseDocsOnlyMode(*docsOnlyFl)
+	if err != nil {
+		return nil, err
+	}
+	linkStyle, err := changelog.ParseLinkStyle(*linkStyleFl)
+	if err != nil {
+		return nil, err
+	}
+
+	var prFilter *changelog.PRFilter
+	if *prFilterExp != "" {
+		if prFilter, err = changelog.ParsePRFilter(*prFilterExp); err != nil {
+			return nil, err
+		}
+	}
+
+	var prOwner, prRepo string
+	if *prFork != "" {
+		var ok bool
+		if prOwner, prRepo, ok = strings.Cut(*prFork, "/"); !ok || prOwner == "" || prRepo == "" {
+			return nil, fmt.Errorf("--pr-fork must be in the owner/repo format, got: %s", *prFork)
+		}
+	}
+	websiteOwner, websiteName, ok := strings.Cut(*websiteRepo, "/")
+	if !ok || websiteOwner == "" || websiteName == "" {
+		return nil, fmt.Errorf("--website-repo must be in the owner/repo format, got: %s", *websiteRepo)
+	}
+
+	// Get API keys from environment. GOOGLE_API_KEY may be a comma-separated
+	// list of keys, which are rotated when a quota is exhausted. MODEL_API_KEY
+	// is optional, as gateways may authenticate requests otherwise (e.g. on an
+	// internal network).
+	googleAPIKeys := splitList(os.Getenv("GOOGLE_API_KEY"))
+	// --pr-readiness and --watch without --watch-regenerate do not call the model
+	if *modelURL == "" && len(googleAPIKeys) == 0 && !*readiness && (*watch == 0 || *watchRegen) {
+		return nil, fmt.Errorf("GOOGLE_API_KEY environment variable is required")
 	}
 
 	githubToken := os.Getenv("GITHUB_TOKEN")
-	// GITHUB_TOKEN is optional (improves rate limits if provided)
-
-	// Create dependencies
-	ctx := context.Background()
-	modelCaller := genai.NewGeminiCaller(googleAPIKey)
-	githubClient := github.NewClient(ctx, githubToken)
-
-	// Create changelog generator
-	generator := changelog.NewChangelogGenerator(
-		*release,
-		*fromRelease,
-		*all,
-		*model,
-		modelCaller,
-		githubClient,
-	)
+	// GITHUB_TOKEN is optional (improves rate limits if provided), unless a
+	// pull request needs to be created
+	if *prDryRun && !*createPR {
+		return nil, fmt.Errorf("--pr-dry-run requires --create-pr")
+	}
+	if *createPR && !*prDryRun && githubToken == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN environment variable is required with --create-pr")
+	}
+	if *websitePR && githubToken == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN environment variable is required with --create-website-pr")
+	}
+	if *readyIssue && githubToken == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN environment variable is required with --pr-readiness-issue")
+	}
+	if *watchIssue != 0 && githubToken == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN environment variable is required with --watch-issue")
+	}
 
-	// Generate changelog
-	log.Println("Starting changelog generation...")
-	changelogText, promptData, modelResponse, modelDetails, err := generator.Generate(ctx)
+	// The release date and the artifact timestamps are fixed by SOURCE_DATE_EPOCH, if set
+	clock, err := changelog.ClockFromEnv()
 	if err != nil {
-		return fmt.Errorf("failed to generate changelog: %w", err)
+		return nil, err
 	}
 
-	// Save prompt to file
-	promptFilename := fmt.Sprintf("changelog-model-prompt-%s-%s.txt", *release, promptData.Timestamp)
-	if err := os.WriteFile(promptFilename, []byte(promptData.Text), 0600); err != nil {
-		return fmt.Errorf("failed to write prompt file: %w", err)
+	// Create dependencies. An interrupted run (e.g. killed by a pipeline
+	// enforcing its time budget) aborts the outstanding calls, and still
+	// reports the cost of the model calls made so far.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
 	}
-	log.Printf("Saved prompt to %s", promptFilename)
 
-	// Save model response to JSON file
-	outputFilename := fmt.Sprintf("changelog-model-output-%s-%s.json", *release, modelDetails.Timestamp)
-	outputJSON, err := json.MarshalIndent(modelResponse, "", "  ")
+	// A shared token is used during the off-peak window only, if any. The
+	// window was validated when loading the config. The wait counts against
+	// --timeout and is interrupted like the run.
+	if *polite && cfg.Polite.OffPeak != "" {
+		window, _ := config.ParseOffPeak(cfg.Polite.OffPeak)
+		if start := window.Next(time.Now()); start.After(time.Now()) {
+			log.Printf("Polite mode: waiting for the off-peak window (%s UTC) until %s", cfg.Polite.OffPeak, start.Format(time.RFC3339))
+			timer := time.NewTimer(time.Until(start))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, fmt.Errorf("stopped while waiting for the off-peak window: %w", ctx.Err())
+			case <-timer.C:
+			}
+		}
+	}
+
+	// usage sums the model calls of all the releases of the run, successful
+	// or not
+	usage := &types.ModelDetails{}
+	if cfg.TelemetryEndpoint != "" {
+		log.Printf("Telemetry enabled, anonymized metrics of the run will be sent to %s", cfg.TelemetryEndpoint)
+		mode := "release"
+		if *readiness {
+			mode = "pr-readiness"
+		} else if *watch > 0 {
+			mode = "watch"
+		} else if train != nil {
+			mode = "train"
+		}
+		modelName := *model
+		if *modelURL != "" {
+			modelName = "custom"
+		}
+		// Sent when the run ends, whatever its outcome
+		defer func() {
+			report := telemetryReport(mode, modelName, len(releases), time.Since(start), usage, result, err)
+			if err := telemetry.Send(context.WithoutCancel(ctx), http.DefaultClient, cfg.TelemetryEndpoint, report); err != nil {
+				log.Printf("Warning: %v", err)
+			}
+		}()
+	}
+	storage, err := artifacts.NewStorage(ctx, *storeURL)
 	if err != nil {
-		return fmt.Errorf("failed to marshal model response: %w", err)
+		return nil, err
 	}
-	if err := os.WriteFile(outputFilename, outputJSON, 0600); err != nil {
-		return fmt.Errorf("failed to write model output file: %w", err)
+	var modelCaller types.ModelCaller
+	switch {
+	case *modelURL != "":
+		modelCaller = genai.NewOpenAICaller(*modelURL, os.Getenv("MODEL_API_KEY"))
+	case len(googleAPIKeys) > 1:
+		log.Printf("Using a pool of %d Google API keys", len(googleAPIKeys))
+		modelCaller = genai.NewGeminiKeyPool(googleAPIKeys)
+	case len(googleAPIKeys) == 0:
+		// --pr-readiness and --watch checks do not call the model
+	default:
+		modelCaller = genai.NewGeminiCaller(googleAPIKeys[0])
 	}
-	log.Printf("Saved model output to %s", outputFilename)
+	var githubOpts []github.ClientOption
+
+	if *traceCalls {
+		// The trace file is created up front and streamed to, so that it is
+		// useful even if the run fails or hangs
+		traceWriter, err := artifacts.NewWriter(*outputDir, *nameTmpl, runName, clock().Format(artifacts.TimestampFormat))
+		if err != nil {
+			return nil, err
+		}
+		traceFilename, err := traceWriter.Path(artifacts.KindTrace, "jsonl")
+		if err != nil {
+			return nil, err
+		}
+		if err := artifacts.WriteFile(traceFilename, nil); err != nil {
+			return nil, fmt.Errorf("failed to create trace file: %w", err)
+		}
+		traceFile, err := os.OpenFile(traceFilename, os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		defer traceFile.Close()
+		log.Printf("Tracing external calls to %s", traceFilename)
 
-	// Save model details to JSON file
-	detailsFilename := fmt.Sprintf("changelog-model-details-%s-%s.json", *release, modelDetails.Timestamp)
-	detailsJSON, err := json.MarshalIndent(modelDetails, "", "  ")
+		recorder := trace.NewRecorder(traceFile)
+		modelCaller = &trace.ModelCaller{Inner: modelCaller, Recorder: recorder}
+		githubOpts = append(githubOpts, github.WithTransportWrapper(recorder.WrapTransport))
+	}
+	if tokens := splitList(githubToken); len(tokens) > 1 {
+		log.Printf("Using a pool of %d GitHub tokens", len(tokens))
+	}
+	if *polite {
+		log.Printf("Polite mode: at most %d GitHub requests per minute and %d at a time, leaving %d%% of the rate limit to other clients",
+			cfg.Polite.RequestsPerMinute, cfg.Polite.MaxConcurrency, cfg.Polite.RateLimitReserve)
+		githubOpts = append(githubOpts, github.WithPoliteness(github.Politeness{
+			MinInterval:   time.Minute / time.Duration(cfg.Polite.RequestsPerMinute),
+			MaxConcurrent: cfg.Polite.MaxConcurrency,
+			Reserve:       float64(cfg.Polite.RateLimitReserve) / 100,
+		}))
+	}
+	githubClient := github.NewClient(ctx, githubToken, githubOpts...)
+	var cachingClient *github.CachingClient
+	if train != nil {
+		// The releases of a train share the pull requests, tags and historical
+		// CHANGELOGs fetched from GitHub
+		cachingClient = github.NewCachingClient(githubClient)
+	}
+
+	corrections, err := changelog.LoadCorrections(*correctFile)
 	if err != nil {
-		return fmt.Errorf("failed to marshal model details: %w", err)
+		return nil, err
 	}
-	if err := os.WriteFile(detailsFilename, detailsJSON, 0600); err != nil {
-		return fmt.Errorf("failed to write model details file: %w", err)
+	if len(corrections) > 0 {
+		log.Printf("Loaded %d corrections of past releases from %s", len(corrections), *correctFile)
 	}
-	log.Printf("Saved model details to %s", detailsFilename)
-	log.Printf("Estimated cost: $%.4f", modelDetails.EstimatedCostUSD)
 
-	// Output changelog
-	if *outputFile != "" {
-		if err := os.WriteFile(*outputFile, []byte(changelogText), 0600); err != nil {
-			return fmt.Errorf("failed to write output file: %w", err)
+	var buildPaths []string
+	if *platformHnt {
+		buildPaths = cfg.BuildPaths
+	}
+
+	var notableDependencies []config.NotableDependency
+	if *notableDeps {
+		notableDependencies = cfg.NotableDependencies
+	}
+
+	var owners *changelog.CodeOwners
+	if *codeOwners != "" {
+		if owners, err = changelog.LoadCodeOwners(*codeOwners); err != nil {
+			return nil, err
 		}
-		log.Printf("Changelog written to %s", *outputFile)
-	} else {
-		fmt.Print(changelogText)
 	}
 
+	var generatorClient types.GitHubClient = githubClient
+	if cachingClient != nil {
+		generatorClient = cachingClient
+	}
+
+	rr := &releaseRunner{
+		ctx:             ctx,
+		cfg:             cfg,
+		model:           *model,
+		fetchAll:        *fetchAll || *all || *auditLabels,
+		modelCaller:     modelCaller,
+		generatorClient: generatorClient,
+		generatorOpts: []changelog.Option{
+			changelog.WithProvenanceComment(*provenance),
+			changelog.WithFrontMatter(*frontMatter),
+			changelog.WithExcludedLabels(splitList(*excludeLbls)),
+			changelog.WithPRFilter(prFilter),
+			changelog.WithCategories(cfg.Categories),
+			changelog.WithScoreThresholds(cfg.Thresholds),
+			changelog.WithIncludeOptional(*includeOpt),
+			changelog.WithMaxDescriptionLength(cfg.MaxDescriptionLength),
+			changelog.WithMinDescriptionConfidence(cfg.MinDescriptionConfidence),
+			changelog.WithWindowsCallout(cfg.Windows),
+			changelog.WithKnownIssuesLabel(*knownIssues),
+			changelog.WithYankedReleases(cfg.YankedReleases),
+			changelog.WithGuardrails(cfg.Guardrails),
+			changelog.WithAcknowledgedPatchFeatures(ackedPatchFeatures),
+			changelog.WithBackportExceptions(cfg.BackportExceptions),
+			changelog.WithDriftReport(*driftReport),
+			changelog.WithEntryAnchors(*anchors),
+			changelog.WithLinkStyle(linkStyle),
+			changelog.WithStyleProfile(cfg.Style),
+			changelog.WithPROverrides(overrides),
+			changelog.WithCorrections(corrections),
+			changelog.WithCodeOwners(owners),
+			changelog.WithClock(clock),
+			changelog.WithPlatformHints(buildPaths),
+			changelog.WithDocsOnlyPRs(docsOnly, cfg.DocsPaths),
+			changelog.WithNotableDependencies(notableDependencies),
+			changelog.WithFollowUpGrouping(*followUps),
+			changelog.WithIssueSymptoms(*issueSympt),
+			changelog.WithKubernetesVersionCheck(*k8sCheck),
+			changelog.WithConfigDefaultsCheck(*configCheck),
+			changelog.WithCLIFlagsCheck(*flagsCheck, *flagsSect),
+			changelog.WithDeprecationsCheck(*deprecCheck),
+			changelog.WithLabelLegend(*labelLegend),
+			changelog.WithLinkTemplates(cfg.Links),
+			changelog.WithPRSource(source),
+			changelog.WithStageTimeouts(changelog.StageTimeouts{Fetch: *fetchTmout, Model: *modelTmout}),
+			changelog.WithWindowLimits(changelog.WindowLimits{MaxMonths: *maxMonths, MaxPRs: *maxPRs}, confirmFunc(*assumeYes)),
+		},
+		githubClient:   githubClient,
+		usage:          usage,
+		clock:          clock,
+		timeout:        *timeout,
+		storage:        storage,
+		outputDir:      *outputDir,
+		nameTmpl:       *nameTmpl,
+		outputFile:     *outputFile,
+		outputFormat:   outputFormat,
+		bundle:         *bundle,
+		machine:        *machine,
+		auditLabels:    *auditLabels,
+		owners:         owners,
+		incremental:    *incremental,
+		readiness:      *readiness,
+		readinessIssue: *readyIssue,
+		minQuality:     *minQuality,
+		mergeInto:      *mergeInto,
+		linkPlacement:  linkPlacement,
+		linkStyle:      linkStyle,
+		createPR:       *createPR,
+		prDryRun:       *prDryRun,
+		prOwner:        prOwner,
+		prRepo:         prRepo,
+		websitePR:      *websitePR,
+		websitePath:    *websitePath,
+		websiteOwner:   websiteOwner,
+		websiteName:    websiteName,
+	}
+	// The quality score outputs are written once for the whole run, even when a
+	// release failed, so that the keys are not repeated for a train
+	defer func() {
+		if rr.quality == nil {
+			return
+		}
+		if werr := writeGitHubOutputs(*rr.quality); werr != nil && err == nil {
+			result, err = nil, werr
+		}
+	}()
+
+	if *watch > 0 {
+		if result, err = watchRelease(ctx, releases[0].Release, *watch, *watchRegen, *watchIssue, githubClient, func(check bool) (*runResult, error) {
+			rr.checkLatePRs = check
+			defer func() { rr.checkLatePRs = false }()
+			return rr.generate(releases[0])
+		}); err != nil {
+			return nil, err
+		}
+		if err := writeGitHubStepSummary(result.warnings); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	result = &runResult{}
+	var failed []error
+	for _, r := range releases {
+		if train != nil {
+			log.Printf("Release train %s: generating the CHANGELOG of %s", train.Name, r.Release)
+		}
+		releaseResult, err := rr.generate(r)
+		if err != nil {
+			if train == nil {
+				return nil, err
+			}
+			// The other releases of the train are still generated, the
+			// failure is reported in the summary
+			log.Printf("Error: release %s of the train failed: %v", r.Release, err)
+			failedResult := types.TrainResult{Release: r.Release, Err: err.Error()}
+			if releaseResult != nil && len(releaseResult.releases) > 0 {
+				failedResult.EstimatedCostUSD = releaseResult.releases[0].EstimatedCostUSD
+			}
+			result.releases = append(result.releases, failedResult)
+			failed = append(failed, err)
+			continue
+		}
+		for _, w := range releaseResult.reviewWarnings {
+			if train != nil {
+				w = r.Release + ": " + w
+			}
+			result.reviewWarnings = append(result.reviewWarnings, w)
+		}
+		result.warnings = append(result.warnings, releaseResult.warnings...)
+		result.releases = append(result.releases, releaseResult.releases...)
+	}
+
+	if train != nil {
+		trainWriter, err := artifacts.NewWriter(*outputDir, *nameTmpl, runName, clock().Format(artifacts.TimestampFormat), artifacts.WithStorage(storage))
+		if err != nil {
+			return nil, err
+		}
+		reportFilename, err := trainWriter.Write(artifacts.KindTrain, "md", []byte(changelog.FormatTrainReport(train.Name, result.releases)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to write release train summary: %w", err)
+		}
+		log.Printf("Saved release train summary to %s", reportFilename)
+	}
+	if err := writeGitHubStepSummary(result.warnings); err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("%d of %d releases of the train failed, first error: %w", len(failed), len(releases), failed[0])
+	}
+	return result, nil
+}
+
+// warnf logs a warning raised outside of the generator and returns it, so
+// that it is reported again at the end of the run
+func warnf(kind types.WarningKind, format string, args ...any) types.Warning {
+	w := types.Warning{Kind: kind, Message: fmt.Sprintf(format, args...)}
+	log.Printf("Warning: %s", w.Message)
+	return w
+}
+
+// writeGitHubStepSummary appends the run warnings to the job summary when
+// running in a GitHub Actions workflow, where log lines are easy to miss
+func writeGitHubStepSummary(warnings []types.Warning) error {
+	summaryFile := os.Getenv("GITHUB_STEP_SUMMARY")
+	if summaryFile == "" || len(warnings) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "### Changelog warnings (%d)\n\n| Kind | PR | Message |\n|------|----|---------|\n", len(warnings))
+	for _, w := range warnings {
+		pr := ""
+		if w.PRNumber != 0 {
+			pr = fmt.Sprintf("#%d", w.PRNumber)
+		}
+		message := strings.ReplaceAll(w.Message, "|", "\\|")
+		if w.Path != "" {
+			message = fmt.Sprintf("`%s`: %s", w.Path, message)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", w.Kind, pr, message)
+	}
+	f, err := os.OpenFile(summaryFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open GitHub Actions step summary file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(b.String()); err != nil {
+		return fmt.Errorf("failed to write GitHub Actions step summary: %w", err)
+	}
+	return nil
+}
+
+// writeGitHubOutputs exposes the quality score as step outputs when running
+// in a GitHub Actions workflow, so that later steps can gate publishing on it
+func writeGitHubOutputs(quality types.QualityScore) error {
+	outputFile := os.Getenv("GITHUB_OUTPUT")
+	if outputFile == "" {
+		return nil
+	}
+	f, err := os.OpenFile(outputFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open GitHub Actions output file: %w", err)
+	}
+	defer f.Close()
+	_, err = fmt.Fprintf(f, "quality_score=%d\nquality_coverage=%.2f\nquality_confidence=%.2f\nquality_lint_violations=%d\nquality_reuse_compliance=%.2f\n",
+		quality.Score, quality.Coverage, quality.Confidence, quality.LintViolations, quality.ReuseCompliance)
+	if err != nil {
+		return fmt.Errorf("failed to write GitHub Actions outputs: %w", err)
+	}
 	return nil
 }
+
+// parsePRNumbers parses a comma-separated list of PR numbers, with an optional # prefix
+func parsePRNumbers(flagName, s string) ([]int, error) {
+	var numbers []int
+	for _, item := range splitList(s) {
+		number, err := strconv.Atoi(strings.TrimPrefix(item, "#"))
+		if err != nil || number <= 0 {
+			return nil, fmt.Errorf("invalid PR number %q in %s", item, flagName)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// splitList splits a comma-separated flag value, ignoring empty items
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// confirmFunc returns a ConfirmFunc asking for confirmation on the terminal.
+// When stdin is not a terminal, runs are aborted unless assumeYes is set.
+func confirmFunc(assumeYes bool) changelog.ConfirmFunc {
+	return func(_ string) bool {
+		if assumeYes {
+			return true
+		}
+		return askYesNo("Continue anyway?", "use --yes to continue anyway")
+	}
+}
+
+// acknowledgePatchFeatures lists the unacknowledged ADDED entries of a patch
+// release and asks the release manager to acknowledge them on the terminal.
+// --yes does not apply: outside of a terminal, they must be acknowledged with
+// --ack-patch-features.
+func acknowledgePatchFeatures(release string, features []types.ChangeEntry) bool {
+	banner := strings.Repeat("!", 80)
+	var sb strings.Builder
+	for _, f := range features {
+		sb.WriteString(fmt.Sprintf("\n  - #%d: %s", f.PRNumber, f.Description))
+	}
+	log.Printf("%s\nWARNING: Features should not land in patch releases, but %s has %d ADDED entries. Were they cherry-picked by mistake?%s\n%s",
+		banner, release, len(features), sb.String(), banner)
+	return askYesNo("Acknowledge these entries and continue?", "use --ack-patch-features to acknowledge them")
+}
+
+// askYesNo asks a yes/no question on the terminal, and returns false without
+// asking (logging the hint) when stdin is not a terminal
+func askYesNo(question, hint string) bool {
+	if fi, err := os.Stdin.Stat(); err != nil || fi.Mode()&os.ModeCharDevice == 0 {
+		log.Printf("Not running in a terminal, %s", hint)
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,482 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// releaseRunner generates (and publishes) the CHANGELOG of each release of a
+// run, with the settings shared by all the releases
+type releaseRunner struct {
+	ctx context.Context
+	cfg *config.Config
+
+	// model, fetchAll, modelCaller, generatorClient and generatorOpts create
+	// the generator of each release
+	model           string
+	fetchAll        bool
+	modelCaller     types.ModelCaller
+	generatorClient types.GitHubClient
+	generatorOpts   []changelog.Option
+	// githubClient publishes the changelog, bypassing the cache of a train
+	githubClient *github.RealClient
+
+	// usage sums the model calls of all the releases, successful or not
+	usage *types.ModelDetails
+	// quality is the lowest quality score of the releases, exposed once as
+	// the GitHub Actions outputs of the run
+	quality *types.QualityScore
+
+	clock   changelog.Clock
+	timeout time.Duration
+
+	// Artifacts and output
+	storage      artifacts.Storage
+	outputDir    string
+	nameTmpl     string
+	outputFile   string
+	outputFormat changelog.OutputFormat
+	bundle       bool
+	machine      bool
+	auditLabels  bool
+	owners       *changelog.CodeOwners
+
+	// Modes
+	incremental    bool
+	readiness      bool
+	readinessIssue bool
+	// checkLatePRs only looks for the PRs merged since the previous run, for
+	// --watch
+	checkLatePRs bool
+
+	// Gates, merging and publishing
+	minQuality    int
+	mergeInto     string
+	linkPlacement changelog.LinkPlacement
+	linkStyle     changelog.LinkStyle
+	createPR      bool
+	prDryRun      bool
+	prOwner       string
+	prRepo        string
+	websitePR     bool
+	websitePath   string
+	websiteOwner  string
+	websiteName   string
+}
+
+// generate generates (and publishes) the CHANGELOG of a release
+func (rr *releaseRunner) generate(r config.TrainRelease) (*runResult, error) {
+	previousResponse, err := rr.previousResponse(r.Release)
+	if err != nil {
+		return nil, err
+	}
+	opts := append(slices.Clone(rr.generatorOpts), changelog.WithPreviousResponse(previousResponse))
+	generator := changelog.NewChangelogGenerator(r.Release, r.FromRelease, rr.fetchAll, rr.model, rr.modelCaller, rr.generatorClient, opts...)
+
+	// The readiness report is written instead of generating the changelog
+	if rr.readiness {
+		return rr.writeReadiness(generator, r.Release)
+	}
+
+	if rr.checkLatePRs {
+		prs, err := generator.LatePRs(rr.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for PRs merged since the previous run: %w", err)
+		}
+		return &runResult{latePRs: prs}, nil
+	}
+
+	// Generate changelog
+	log.Println("Starting changelog generation...")
+	changelogText, promptData, modelResponse, modelDetails, err := generator.Generate(rr.ctx)
+	if modelDetails != nil {
+		rr.usage.Add(modelDetails)
+	}
+	if err != nil {
+		// Keep what the model returned before the failure, for debugging
+		if promptData != nil {
+			if w, werr := artifacts.NewWriter(rr.outputDir, rr.nameTmpl, r.Release, promptData.Timestamp, artifacts.WithStorage(rr.storage)); werr != nil {
+				log.Printf("Warning: failed to save the artifacts of the failed run: %v", werr)
+			} else if werr := writeModelArtifacts(w, promptData, modelResponse, modelDetails, generator.RunWarnings(), nil); werr != nil {
+				log.Printf("Warning: failed to save the artifacts of the failed run: %v", werr)
+			}
+		}
+		// The model calls made before the failure are still billed
+		var partial *runResult
+		if modelDetails != nil && modelDetails.Calls > 0 {
+			log.Printf("Estimated cost of the failed run: $%.4f (%d tokens across %d model calls)", modelDetails.EstimatedCostUSD, modelDetails.TotalTokens, modelDetails.Calls)
+			partial = &runResult{releases: []types.TrainResult{{Release: r.Release, EstimatedCostUSD: modelDetails.EstimatedCostUSD}}}
+		}
+		if errors.Is(rr.ctx.Err(), context.DeadlineExceeded) {
+			return partial, fmt.Errorf("failed to generate changelog within --timeout %s: %w", rr.timeout, err)
+		}
+		if errors.Is(rr.ctx.Err(), context.Canceled) {
+			return partial, fmt.Errorf("changelog generation interrupted: %w", err)
+		}
+		return partial, fmt.Errorf("failed to generate changelog: %w", err)
+	}
+
+	// Save model artifacts, all sharing the prompt timestamp
+	artifactWriter, err := artifacts.NewWriter(rr.outputDir, rr.nameTmpl, r.Release, promptData.Timestamp, artifacts.WithStorage(rr.storage))
+	if err != nil {
+		return nil, err
+	}
+	quality := generator.QualityScore()
+	if err := writeModelArtifacts(artifactWriter, promptData, modelResponse, modelDetails, generator.RunWarnings(), &quality); err != nil {
+		return nil, err
+	}
+	if err := rr.writeReports(generator, artifactWriter, r.Release); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Estimated cost: $%.4f (%d tokens across %d model calls)", modelDetails.EstimatedCostUSD, modelDetails.TotalTokens, modelDetails.Calls)
+	// Warnings raised outside of the generator
+	var runWarnings []types.Warning
+	if quota := modelDetails.Quota; quota != nil {
+		log.Printf("Model provider quota: %s", quota)
+		if quota.RemainingTokens != nil && *quota.RemainingTokens < int64(modelDetails.TotalTokens) {
+			runWarnings = append(runWarnings, warnf(types.WarningKindQuota, "another run like this one (%d tokens) would exceed the remaining token quota (%d tokens)", modelDetails.TotalTokens, *quota.RemainingTokens))
+		}
+		if quota.RemainingRequests != nil && *quota.RemainingRequests == 0 {
+			runWarnings = append(runWarnings, warnf(types.WarningKindQuota, "the request quota of the model provider is exhausted"))
+		}
+	}
+	log.Printf("Release notes quality score: %s", changelog.FormatQualityScore(quality))
+	if rr.quality == nil || quality.Score < rr.quality.Score {
+		rr.quality = &quality
+	}
+
+	// Output changelog
+	output, err := changelog.RenderOutput(rr.outputFormat, changelogText)
+	if err != nil {
+		return nil, err
+	}
+	if rr.outputFile != "" {
+		changelogFilename, err := artifacts.RenderName(rr.outputFile, artifacts.NameData{
+			Version:   r.Release,
+			Timestamp: promptData.Timestamp,
+			Kind:      "changelog",
+			Ext:       "md",
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := artifacts.WriteFile(changelogFilename, []byte(output)); err != nil {
+			return nil, fmt.Errorf("failed to write output file: %w", err)
+		}
+		log.Printf("Changelog written to %s", changelogFilename)
+		artifactWriter.Add(filepath.Base(changelogFilename), []byte(output))
+	} else {
+		if !rr.machine {
+			fmt.Print(output)
+		}
+		artifactWriter.Add(fmt.Sprintf("CHANGELOG-%s.md", r.Release), []byte(output))
+	}
+
+	if quality.Score < rr.minQuality {
+		return nil, &types.ValidationError{Err: fmt.Errorf("quality score %d is lower than --min-quality %d, not merging or publishing the changelog", quality.Score, rr.minQuality)}
+	}
+	if violations := generator.GuardrailViolations(); len(violations) > 0 && rr.cfg.Guardrails.Action == config.GuardrailActionFail {
+		return nil, &types.ValidationError{Err: fmt.Errorf("%d guardrails violated (first: %s), not merging or publishing the changelog", len(violations), violations[0])}
+	}
+	if features := generator.PatchReleaseFeatures(); len(features) > 0 && !acknowledgePatchFeatures(r.Release, features) {
+		var numbers []string
+		for _, f := range features {
+			numbers = append(numbers, strconv.Itoa(f.PRNumber))
+		}
+		return nil, &types.ValidationError{Err: fmt.Errorf("%d ADDED entries in patch release %s were not acknowledged, check the cherry-picks or use --ack-patch-features=%s", len(features), r.Release, strings.Join(numbers, ","))}
+	}
+
+	if rr.mergeInto != "" {
+		existing, err := os.ReadFile(rr.mergeInto)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read %s: %w", rr.mergeInto, err)
+		}
+		merged, err := changelog.MergeChangelog(string(existing), changelogText, rr.linkPlacement, rr.linkStyle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge changelog into %s: %w", rr.mergeInto, err)
+		}
+		merged = changelog.MarkYanked(merged, rr.cfg.YankedReleases)
+		if err := changelog.Validate(merged); err != nil {
+			runWarnings = append(runWarnings, warnf(types.WarningKindMarkdown, "merged %s has markdown issues: %v", rr.mergeInto, err))
+		}
+		if err := artifacts.WriteFile(rr.mergeInto, []byte(merged)); err != nil {
+			return nil, fmt.Errorf("failed to write merged changelog: %w", err)
+		}
+		log.Printf("Merged changelog section into %s", rr.mergeInto)
+	}
+
+	trainResult := generator.TrainResult(modelResponse, modelDetails)
+	if err := rr.publish(generator, r, changelogText, promptData.Timestamp, modelResponse, modelDetails, &trainResult); err != nil {
+		return nil, err
+	}
+
+	if rr.bundle {
+		bundleFilename, err := artifactWriter.WriteBundle()
+		if err != nil {
+			return nil, fmt.Errorf("failed to write artifact bundle: %w", err)
+		}
+		log.Printf("Saved artifact bundle to %s", bundleFilename)
+	}
+
+	if rr.machine {
+		// Written last, so that nothing is piped to the next command if the run fails
+		fmt.Print(output)
+	}
+
+	return &runResult{
+		reviewWarnings: generator.Warnings(),
+		warnings:       append(generator.RunWarnings(), runWarnings...),
+		releases:       []types.TrainResult{trainResult},
+	}, nil
+}
+
+// previousResponse returns the model response of the latest run of the
+// release with --incremental, nil otherwise
+func (rr *releaseRunner) previousResponse(release string) (*types.ModelResponse, error) {
+	if !rr.incremental {
+		return nil, nil
+	}
+	previousWriter, err := artifacts.NewWriter(rr.outputDir, rr.nameTmpl, release, "", artifacts.WithStorage(rr.storage))
+	if err != nil {
+		return nil, err
+	}
+	previousOutput, err := previousWriter.Latest(artifacts.KindOutput, "json")
+	if err != nil {
+		return nil, err
+	}
+	if previousOutput == "" {
+		return nil, fmt.Errorf("--incremental requires the output artifact of a previous run of %s in the output directory", release)
+	}
+	data, err := previousWriter.Read(previousOutput)
+	if err != nil {
+		return nil, err
+	}
+	previousResponse, err := changelog.ParsePreviousResponse(previousOutput, data)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Incremental run based on %s", previousOutput)
+	return previousResponse, nil
+}
+
+// writeReadiness writes the PR readiness report of a release, and opens an
+// issue with it with --pr-readiness-issue
+func (rr *releaseRunner) writeReadiness(generator *changelog.ChangelogGenerator, release string) (*runResult, error) {
+	prs, err := generator.PRReadiness(rr.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assess the PR descriptions: %w", err)
+	}
+	readinessWriter, err := artifacts.NewWriter(rr.outputDir, rr.nameTmpl, release, rr.clock().Format(artifacts.TimestampFormat), artifacts.WithStorage(rr.storage))
+	if err != nil {
+		return nil, err
+	}
+	report := changelog.FormatPRReadinessReport(release, prs)
+	readinessFilename, err := readinessWriter.Write(artifacts.KindReadiness, "md", []byte(report))
+	if err != nil {
+		return nil, fmt.Errorf("failed to write PR readiness report: %w", err)
+	}
+	var notReady int
+	for _, pr := range prs {
+		if len(pr.Issues) > 0 {
+			notReady++
+		}
+	}
+	log.Printf("Saved PR readiness report (%d of %d PRs to improve) to %s", notReady, len(prs), readinessFilename)
+	if rr.readinessIssue && notReady > 0 {
+		url, err := rr.githubClient.CreateIssue(rr.ctx, "antrea-io", "antrea", fmt.Sprintf("Improve the PR descriptions for the %s release notes", release), report)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Opened PR readiness issue: %s", url)
+	}
+	return &runResult{}, nil
+}
+
+// writeReports saves the reports of the generated CHANGELOG for the reviewers
+func (rr *releaseRunner) writeReports(generator *changelog.ChangelogGenerator, artifactWriter *artifacts.Writer, release string) error {
+	// Save label audit report
+	if rr.auditLabels {
+		missingLabels := generator.MissingLabels()
+		auditFilename, err := artifactWriter.Write(artifacts.KindAudit, "md", []byte(changelog.FormatLabelAuditReport(release, missingLabels)))
+		if err != nil {
+			return fmt.Errorf("failed to write label audit report: %w", err)
+		}
+		log.Printf("%d PRs are missing the action/release-note label, see %s", len(missingLabels), auditFilename)
+	}
+
+	// Save skipped PRs report
+	skippedPRs := generator.SkippedPRs()
+	skippedFilename, err := artifactWriter.Write(artifacts.KindSkipped, "md", []byte(changelog.FormatSkippedReport(release, skippedPRs, generator.AppliedOverrides())))
+	if err != nil {
+		return fmt.Errorf("failed to write skipped PRs report: %w", err)
+	}
+	log.Printf("Saved report of %d skipped PRs to %s", len(skippedPRs), skippedFilename)
+
+	// Save release drift report
+	if drift := generator.ReleaseDrift(); drift != nil {
+		driftFilename, err := artifactWriter.Write(artifacts.KindDrift, "md", []byte(changelog.FormatDriftReport(release, drift)))
+		if err != nil {
+			return fmt.Errorf("failed to write release drift report: %w", err)
+		}
+		log.Printf("Saved release drift report (%d discrepancies) to %s", drift.Discrepancies(), driftFilename)
+	}
+
+	// Save the entries left out by the maximum numbers of entries per category
+	if fullList := generator.FullChangeList(); fullList != "" {
+		fullListFilename, err := artifactWriter.Write(artifacts.KindFullList, "md", []byte(fullList))
+		if err != nil {
+			return fmt.Errorf("failed to write full change list: %w", err)
+		}
+		log.Printf("Some categories exceed their max_entries, the other entries are in %s", fullListFilename)
+	}
+
+	// Save the review routing report, only with code owners
+	if rr.owners != nil {
+		routingFilename, err := artifactWriter.Write(artifacts.KindRouting, "md", []byte(changelog.FormatReviewRouting(release, generator.ReviewRoutes())))
+		if err != nil {
+			return fmt.Errorf("failed to write review routing report: %w", err)
+		}
+		log.Printf("Saved review routing report to %s", routingFilename)
+	}
+
+	// Save historical category conflicts report, only when there are conflicts to review
+	if conflicts := generator.HistoryConflicts(); len(conflicts) > 0 {
+		conflictsFilename, err := artifactWriter.Write(artifacts.KindConflicts, "md", []byte(changelog.FormatConflictReport(release, conflicts)))
+		if err != nil {
+			return fmt.Errorf("failed to write historical conflicts report: %w", err)
+		}
+		log.Printf("Warning: %d PRs have a historical category conflict, see %s", len(conflicts), conflictsFilename)
+	}
+	return nil
+}
+
+// publish opens the pull requests of the changelog with --create-pr and
+// --create-website-pr, recording the URL of the changelog pull request in
+// trainResult
+func (rr *releaseRunner) publish(generator *changelog.ChangelogGenerator, r config.TrainRelease, changelogText, timestamp string, modelResponse *types.ModelResponse, modelDetails *types.ModelDetails, trainResult *types.TrainResult) error {
+	if rr.createPR {
+		publishOpts := changelog.PublishOptions{
+			Release:        r.Release,
+			HeadOwner:      rr.prOwner,
+			HeadRepo:       rr.prRepo,
+			Branch:         r.PRBranch,
+			LinkPlacement:  rr.linkPlacement,
+			LinkStyle:      rr.linkStyle,
+			YankedReleases: rr.cfg.YankedReleases,
+			ReviewComment:  generator.ReviewSummary(modelResponse),
+			Summary:        generator.PRDescriptionSummary(modelResponse, modelDetails),
+			Reviewers:      changelog.ReviewOwners(generator.ReviewRoutes()),
+		}
+		if rr.prDryRun {
+			preview, err := changelog.PreviewChangelogPR(rr.ctx, rr.githubClient, changelogText, publishOpts)
+			if err != nil {
+				return fmt.Errorf("failed to preview changelog pull request: %w", err)
+			}
+			if rr.machine {
+				fmt.Fprint(os.Stderr, preview)
+			} else {
+				fmt.Print(preview)
+			}
+		} else if pr, err := changelog.PublishChangelog(rr.ctx, rr.githubClient, changelogText, publishOpts); err != nil {
+			return fmt.Errorf("failed to create changelog pull request: %w", err)
+		} else {
+			trainResult.PullRequestURL = pr.GetHTMLURL()
+		}
+	}
+
+	if rr.websitePR {
+		page, err := changelog.FormatHugoPage(changelogText)
+		if err != nil {
+			return err
+		}
+		pagePath, err := artifacts.RenderName(rr.websitePath, artifacts.NameData{Version: r.Release, Timestamp: timestamp, Kind: "changelog", Ext: "md"})
+		if err != nil {
+			return err
+		}
+		websiteOpts := changelog.WebsiteOptions{
+			Release: r.Release,
+			Owner:   rr.websiteOwner,
+			Repo:    rr.websiteName,
+			Base:    "main",
+			Path:    pagePath,
+		}
+		if rr.prOwner != "" {
+			// The website repository is forked by the same user as the antrea repository
+			websiteOpts.HeadOwner, websiteOpts.HeadRepo = rr.prOwner, rr.websiteName
+		}
+		if _, err := changelog.PublishWebsitePage(rr.ctx, rr.githubClient, page, websiteOpts); err != nil {
+			return fmt.Errorf("failed to create website pull request: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeModelArtifacts saves the prompt, the model output (with the warnings and
+// the quality score of the run) and the model details, skipping the ones which
+// are nil (e.g. when the generation failed)
+func writeModelArtifacts(w *artifacts.Writer, promptData *types.Prompt, response *types.ModelResponse, details *types.ModelDetails, warnings []types.Warning, quality *types.QualityScore) error {
+	if promptData != nil {
+		promptFilename, err := w.Write(artifacts.KindPrompt, "txt", []byte(promptData.Text))
+		if err != nil {
+			return fmt.Errorf("failed to write prompt file: %w", err)
+		}
+		log.Printf("Saved prompt to %s", promptFilename)
+	}
+
+	// Save model response to JSON file
+	if response != nil {
+		outputJSON, err := json.MarshalIndent(changelog.RunOutput{ModelResponse: response, Warnings: warnings, Quality: quality}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal model response: %w", err)
+		}
+		outputFilename, err := w.Write(artifacts.KindOutput, "json", outputJSON)
+		if err != nil {
+			return fmt.Errorf("failed to write model output file: %w", err)
+		}
+		log.Printf("Saved model output to %s", outputFilename)
+	}
+
+	// Save model details to JSON file
+	if details != nil {
+		detailsJSON, err := json.MarshalIndent(details, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal model details: %w", err)
+		}
+		detailsFilename, err := w.Write(artifacts.KindDetails, "json", detailsJSON)
+		if err != nil {
+			return fmt.Errorf("failed to write model details file: %w", err)
+		}
+		log.Printf("Saved model details to %s", detailsFilename)
+	}
+	return nil
+}
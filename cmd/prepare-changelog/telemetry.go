@@ -0,0 +1,53 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/telemetry"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// telemetryReport returns the anonymized metrics of a run, from the model
+// usage of all its releases and the outcome of run
+func telemetryReport(mode, model string, releases int, duration time.Duration, usage *types.ModelDetails, result *runResult, err error) telemetry.Report {
+	report := telemetry.Report{
+		ToolVersion:      changelog.ToolVersion(),
+		OS:               runtime.GOOS,
+		Arch:             runtime.GOARCH,
+		Model:            model,
+		Mode:             mode,
+		Releases:         releases,
+		DurationSeconds:  duration.Seconds(),
+		ModelCalls:       usage.Calls,
+		PromptTokens:     int64(usage.PromptTokens),
+		CandidatesTokens: int64(usage.CandidatesTokens),
+		TotalTokens:      int64(usage.TotalTokens),
+		EstimatedCostUSD: usage.EstimatedCostUSD,
+		Outcome:          telemetry.OutcomeSuccess,
+	}
+	switch {
+	case err != nil:
+		report.Outcome = failureClass(err)
+		report.ExitCode = exitCode(err)
+	case len(result.reviewWarnings) > 0:
+		report.Outcome = telemetry.OutcomeSuccessWithWarnings
+		report.ExitCode = exitSuccessWithWarnings
+	}
+	return report
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// watchRelease checks the release branch at every interval for the PRs merged
+// since the latest run, until the release is tagged. Each late PR is alerted
+// about once, and with regenerate the changelog is generated again to include
+// it. generate runs releaseRunner.generate, only looking for the late PRs if
+// check is true. The first check fails the watch (e.g. without a previous run), the
+// next ones are logged and retried at the next interval, as the watch may run
+// for days.
+func watchRelease(ctx context.Context, release string, interval time.Duration, regenerate bool, issue int, githubClient *github.RealClient, generate func(check bool) (*runResult, error)) (*runResult, error) {
+	result := &runResult{}
+	alerted := make(map[int]bool)
+	log.Printf("Watching the release branch of %s every %s until the release is tagged", release, interval)
+	for first := true; ; first = false {
+		tagged, err := changelog.ReleaseTagged(ctx, githubClient, release)
+		if err == nil && tagged {
+			log.Printf("Release %s was tagged, stopping the watch", release)
+			return result, nil
+		}
+		if err == nil {
+			err = watchCheck(ctx, release, regenerate, issue, githubClient, generate, alerted, result)
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("watch of %s stopped before the release was tagged: %w", release, ctx.Err())
+		}
+		if err != nil && first {
+			return nil, err
+		}
+		if err != nil {
+			log.Printf("Warning: watch check failed, retrying in %s: %v", interval, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("watch of %s stopped before the release was tagged: %w", release, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchCheck looks for the late PRs which were not alerted about yet, alerts
+// about them, and generates the changelog again if regenerate is set
+func watchCheck(ctx context.Context, release string, regenerate bool, issue int, githubClient *github.RealClient, generate func(check bool) (*runResult, error), alerted map[int]bool, result *runResult) error {
+	check, err := generate(true)
+	if err != nil {
+		return err
+	}
+	var late []types.PRInfo
+	for _, pr := range check.latePRs {
+		if !alerted[pr.Number] {
+			late = append(late, pr)
+		}
+	}
+	if len(late) == 0 {
+		log.Printf("No PRs merged since the changelog was generated")
+		return nil
+	}
+
+	if regenerate {
+		log.Printf("%d PRs merged since the changelog was generated, generating it again", len(late))
+		releaseResult, err := generate(false)
+		if err != nil {
+			return err
+		}
+		result.reviewWarnings = append(result.reviewWarnings, releaseResult.reviewWarnings...)
+		result.warnings = append(result.warnings, releaseResult.warnings...)
+		result.releases = append(result.releases, releaseResult.releases...)
+	}
+	for _, pr := range late {
+		alerted[pr.Number] = true
+		result.warnings = append(result.warnings, warnf(types.WarningKindLatePR, "PR #%d (%s) was merged after the changelog was generated", pr.Number, pr.Title))
+	}
+	if issue != 0 {
+		if err := githubClient.CreateIssueComment(ctx, "antrea-io", "antrea", issue, changelog.FormatLatePRsAlert(release, late, regenerate)); err != nil {
+			return err
+		}
+		log.Printf("Posted the alert on issue #%d", issue)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// fakeWatchServer is a GitHub API on which the tag of the release exists
+// after taggedAfter tag lookups, and which records the comments posted on
+// the issues
+type fakeWatchServer struct {
+	mu          sync.Mutex
+	taggedAfter int
+	tagLookups  int
+	comments    []string
+}
+
+func newFakeWatchServer(t *testing.T, taggedAfter int) (*fakeWatchServer, *github.RealClient) {
+	s := &fakeWatchServer{taggedAfter: taggedAfter}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/antrea-io/antrea/git/ref/tags/v2.5.0":
+			s.tagLookups++
+			if s.tagLookups <= s.taggedAfter {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ref": "refs/tags/v2.5.0", "object": {"sha": "abc123"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/antrea-io/antrea/issues/42/comments":
+			var comment struct {
+				Body string `json:"body"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+			s.comments = append(s.comments, comment.Body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return s, github.NewClient(context.Background(), "", github.WithBaseURL(baseURL))
+}
+
+// fakeGenerate returns the late PRs of each check in turn (a nil slice
+// failing the check), and counts the regenerations
+type fakeGenerate struct {
+	checks      [][]types.PRInfo
+	regenerated int
+}
+
+func (g *fakeGenerate) generate(check bool) (*runResult, error) {
+	if !check {
+		g.regenerated++
+		return &runResult{releases: []types.TrainResult{{Release: "2.5.0"}}}, nil
+	}
+	if len(g.checks) == 0 {
+		return nil, errors.New("unexpected check")
+	}
+	late := g.checks[0]
+	g.checks = g.checks[1:]
+	if late == nil {
+		return nil, errors.New("500 Internal Server Error")
+	}
+	return &runResult{latePRs: late}, nil
+}
+
+func TestWatchRelease_FirstCheckFails(t *testing.T) {
+	server, githubClient := newFakeWatchServer(t, 10)
+	g := &fakeGenerate{checks: [][]types.PRInfo{nil}}
+
+	_, err := watchRelease(context.Background(), "2.5.0", time.Millisecond, false, 42, githubClient, g.generate)
+	require.ErrorContains(t, err, "500 Internal Server Error")
+	assert.Equal(t, 1, server.tagLookups)
+}
+
+func TestWatchRelease_LaterCheckFailsIsRetried(t *testing.T) {
+	server, githubClient := newFakeWatchServer(t, 3)
+	late := []types.PRInfo{{Number: 7200, Title: "Fix IPsec tunnel flapping", Author: "alice"}}
+	g := &fakeGenerate{checks: [][]types.PRInfo{{}, nil, late}}
+
+	result, err := watchRelease(context.Background(), "2.5.0", time.Millisecond, false, 0, githubClient, g.generate)
+	require.NoError(t, err)
+	assert.Empty(t, g.checks, "the check is retried after the failure")
+	require.Len(t, result.warnings, 1)
+	assert.Equal(t, types.WarningKindLatePR, result.warnings[0].Kind)
+	assert.Contains(t, result.warnings[0].Message, "PR #7200")
+	assert.Equal(t, 4, server.tagLookups)
+}
+
+func TestWatchRelease_AlertsOnce(t *testing.T) {
+	server, githubClient := newFakeWatchServer(t, 3)
+	pr1 := types.PRInfo{Number: 7200, Title: "Fix IPsec tunnel flapping", Author: "alice"}
+	pr2 := types.PRInfo{Number: 7201, Title: "Fix Egress IP leak", Author: "bob"}
+	g := &fakeGenerate{checks: [][]types.PRInfo{{pr1}, {pr1}, {pr1, pr2}}}
+
+	result, err := watchRelease(context.Background(), "2.5.0", time.Millisecond, true, 42, githubClient, g.generate)
+	require.NoError(t, err)
+	assert.Equal(t, 2, g.regenerated, "the changelog is only generated again for the PRs not alerted about")
+	assert.Len(t, result.releases, 2)
+	require.Len(t, server.comments, 2)
+	assert.Contains(t, server.comments[0], "- #7200: Fix IPsec tunnel flapping (@alice)\n")
+	assert.NotContains(t, server.comments[1], "#7200")
+	assert.Contains(t, server.comments[1], "- #7201: Fix Egress IP leak (@bob)\n")
+	assert.Len(t, result.warnings, 2)
+}
+
+func TestWatchRelease_StopsWhenTagged(t *testing.T) {
+	server, githubClient := newFakeWatchServer(t, 0)
+	g := &fakeGenerate{}
+
+	result, err := watchRelease(context.Background(), "2.5.0", time.Millisecond, true, 42, githubClient, g.generate)
+	require.NoError(t, err)
+	assert.Empty(t, result.warnings)
+	assert.Zero(t, g.regenerated)
+	assert.Equal(t, 1, server.tagLookups)
+	assert.Empty(t, server.comments)
+}
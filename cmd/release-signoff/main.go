@@ -0,0 +1,158 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func main() {
+	complete, err := run(os.Args[1:])
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if !complete {
+		os.Exit(1)
+	}
+}
+
+// run runs the open or status subcommand, and returns false if sign-offs are
+// missing
+func run(args []string) (bool, error) {
+	fs := flag.NewFlagSet("release-signoff", flag.ExitOnError)
+	var (
+		issue      = fs.Int("issue", 0, "Number of the release tracking issue in antrea-io/antrea (required)")
+		configFile = fs.String("config", "", "Path to a YAML configuration file with the sign_offs areas and owners (default: $CHANGELOG_CONFIG)")
+		dryRun     = fs.Bool("dry-run", false, "With open, print the checklist instead of posting it")
+		outputFile = fs.String("output", "", "With status, output file for the report (default: stdout)")
+		envFile    = fs.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile    = fs.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper = fs.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir    = fs.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s open|status [flags] X.Y.Z\n\n"+
+			"open posts the sign-off checklist of a release, with one item per area of the config file, on the release tracking issue.\n"+
+			"status reports the areas whose owners have not signed off yet, and exits with code 1 if there are any.\n\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 || (args[0] != "open" && args[0] != "status") {
+		fs.Usage()
+		return false, fmt.Errorf("expected the open or status subcommand")
+	}
+	subcommand := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return false, err
+	}
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return false, err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return false, err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return false, err
+	}
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return false, fmt.Errorf("expected exactly one release argument (e.g., 2.5.0)")
+	}
+	ver, err := version.Parse(fs.Arg(0))
+	if err != nil {
+		return false, fmt.Errorf("invalid release %q: %w", fs.Arg(0), err)
+	}
+	release := ver.String()
+	if *issue <= 0 {
+		return false, fmt.Errorf("--issue is required")
+	}
+
+	// The checklist is only trusted if it was posted by the user of the token,
+	// as anyone can post a comment with its marker
+	githubToken := os.Getenv("GITHUB_TOKEN")
+	if githubToken == "" {
+		return false, fmt.Errorf("GITHUB_TOKEN environment variable is required to find the checklist posted with it")
+	}
+	ctx := context.Background()
+	githubClient := github.NewClient(ctx, githubToken)
+	login, err := githubClient.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	comments, err := githubClient.ListIssueComments(ctx, "antrea-io", "antrea", *issue)
+	if err != nil {
+		return false, err
+	}
+	checklist := changelog.FindSignOffChecklist(comments, release, login)
+
+	if subcommand == "status" {
+		if checklist == nil {
+			return false, fmt.Errorf("no sign-off checklist of %s posted by %s on issue #%d, post it with the open subcommand", release, login, *issue)
+		}
+		statuses := changelog.ParseSignOffChecklist(checklist.GetBody())
+		report := changelog.FormatSignOffStatus(release, checklist.GetHTMLURL(), statuses)
+		if *outputFile == "" {
+			fmt.Print(report)
+		} else {
+			if err := artifacts.WriteFile(*outputFile, []byte(report)); err != nil {
+				return false, fmt.Errorf("failed to write report: %w", err)
+			}
+			log.Printf("Sign-off status written to %s", *outputFile)
+		}
+		for _, s := range statuses {
+			if !s.SignedOff {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		if cfg, err = config.Load(file); err != nil {
+			return false, err
+		}
+	}
+	if len(cfg.SignOffs) == 0 {
+		return false, fmt.Errorf("no sign_offs areas in the config file")
+	}
+	if checklist != nil {
+		return false, fmt.Errorf("the sign-off checklist of %s is already on issue #%d: %s", release, *issue, checklist.GetHTMLURL())
+	}
+	body := changelog.FormatSignOffChecklist(release, cfg.SignOffs)
+	if *dryRun {
+		fmt.Print(body)
+		return true, nil
+	}
+	if err := githubClient.CreateIssueComment(ctx, "antrea-io", "antrea", *issue, body); err != nil {
+		return false, err
+	}
+	log.Printf("Posted the sign-off checklist of %s (%d areas) on issue #%d", release, len(cfg.SignOffs), *issue)
+	return true, nil
+}
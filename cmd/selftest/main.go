@@ -0,0 +1,143 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/genai"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/selftest"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func main() {
+	passed, err := run()
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if !passed {
+		os.Exit(1)
+	}
+}
+
+func run() (bool, error) {
+	var (
+		fixtureName = flag.String("fixture", "", "Name of the fixture to run (default: all fixtures)")
+		fixtureFile = flag.String("fixture-file", "", "Fixture file to run instead of the embedded fixtures, e.g. generated by gen-fixtures")
+		liveModel   = flag.Bool("live-model", false, "Call the real model instead of replaying the recorded responses, to check the model provider and the prompt (uses GOOGLE_API_KEY or MODEL_API_KEY)")
+		model       = flag.String("model", "gemini-2.5-flash", "Model to use with --live-model (a Gemini model, or a model of the --model-base-url API)")
+		modelURL    = flag.String("model-base-url", "", "Base URL of an OpenAI-compatible API to call with --live-model instead of the Gemini API (uses MODEL_API_KEY)")
+		verbose     = flag.Bool("verbose", false, "Show the logs of the pipeline")
+		envFile     = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile     = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper  = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY and MODEL_API_KEY variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir     = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nRuns the changelog pipeline against recorded fixtures and checks the invariants of its output.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return false, err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if *fixtureName != "" && *fixtureFile != "" {
+		return false, fmt.Errorf("--fixture and --fixture-file cannot be used together")
+	}
+	var fixtures []*selftest.Fixture
+	if *fixtureFile != "" {
+		fixture, err := selftest.LoadFixtureFile(*fixtureFile)
+		if err != nil {
+			return false, err
+		}
+		fixtures = append(fixtures, fixture)
+	} else {
+		var err error
+		if fixtures, err = selftest.LoadFixtures(); err != nil {
+			return false, err
+		}
+	}
+	if *fixtureName != "" {
+		var selected []*selftest.Fixture
+		for _, f := range fixtures {
+			if f.Name == *fixtureName {
+				selected = append(selected, f)
+			}
+		}
+		if len(selected) == 0 {
+			return false, fmt.Errorf("unknown fixture %q", *fixtureName)
+		}
+		fixtures = selected
+	}
+
+	// The recorded responses are replayed, unless the real model is used
+	var modelCaller types.ModelCaller
+	if *liveModel {
+		if err := config.LoadEnv(*envFile, *profile); err != nil {
+			return false, err
+		}
+		if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), "GOOGLE_API_KEY", "MODEL_API_KEY"); err != nil {
+			return false, err
+		}
+		if *modelURL != "" {
+			modelCaller = genai.NewOpenAICaller(*modelURL, os.Getenv("MODEL_API_KEY"))
+		} else {
+			apiKey, _, _ := strings.Cut(os.Getenv("GOOGLE_API_KEY"), ",")
+			if apiKey == "" {
+				return false, fmt.Errorf("GOOGLE_API_KEY environment variable is required with --live-model")
+			}
+			modelCaller = genai.NewGeminiCaller(apiKey)
+		}
+	}
+
+	logger := log.Writer()
+	passed := true
+	for _, fixture := range fixtures {
+		if !*verbose {
+			log.SetOutput(io.Discard)
+		}
+		start := time.Now()
+		result := selftest.Run(context.Background(), fixture, modelCaller, *model)
+		elapsed := time.Since(start)
+		log.SetOutput(logger)
+
+		status := "PASS"
+		if !result.Passed() {
+			status = "FAIL"
+			passed = false
+		}
+		fmt.Printf("%s %s: %s (%d PRs, %s)\n", status, fixture.Name, fixture.Description, len(fixture.Pulls), elapsed.Round(time.Millisecond))
+		for _, check := range result.Checks {
+			if check.Err != nil {
+				fmt.Printf("  FAIL %s: %v\n", check.Name, check.Err)
+			} else {
+				fmt.Printf("  ok   %s\n", check.Name)
+			}
+		}
+	}
+	return passed, nil
+}
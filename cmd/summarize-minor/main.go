@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+}
+
+func run() error {
+	var (
+		configFile    = flag.String("config", "", "Path to a YAML configuration file (optional, default: $CHANGELOG_CONFIG)")
+		changelogFile = flag.String("changelog", "", "Local CHANGELOG-X.Y.md file to summarize (default: fetched from antrea-io/antrea)")
+		outputFile    = flag.String("output", "", "Output file (default: stdout)")
+		envFile       = flag.String("env-file", config.DefaultEnvFile, "Environment file with the tokens and API keys (optional if the default)")
+		profile       = flag.String("profile", "", "Profile whose environment file (<env-file>.<profile>, e.g. .env.release-infra) takes precedence over --env-file")
+		credHelper    = flag.String("credential-helper", "", "Credential helper for the GOOGLE_API_KEY, MODEL_API_KEY and GITHUB_TOKEN variables which are not set: keychain (OS keychain) or a command run with \"get <NAME>\" (default: $CHANGELOG_CREDENTIAL_HELPER)")
+		workdir       = flag.String("workdir", "", "Working directory in which all relative paths are resolved, e.g. the directory mounted in a container (default: $CHANGELOG_WORKDIR)")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] X.Y\n\nConsolidates all the X.Y.Z sections of CHANGELOG-X.Y.md into a single list.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if dir, err := config.EnterWorkdir(*workdir); err != nil {
+		return err
+	} else if dir != "" {
+		log.Printf("Using working directory %s", dir)
+	}
+
+	if err := config.LoadEnv(*envFile, *profile); err != nil {
+		return err
+	}
+	if err := config.LoadSecrets(context.Background(), config.CredentialHelper(*credHelper), config.SecretEnvVars...); err != nil {
+		return err
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return fmt.Errorf("expected exactly one minor release argument (e.g., 2.4)")
+	}
+	minor := flag.Arg(0)
+
+	cfg := config.Default()
+	if file := config.ConfigFile(*configFile); file != "" {
+		var err error
+		if cfg, err = config.Load(file); err != nil {
+			return err
+		}
+	}
+
+	var content string
+	if *changelogFile != "" {
+		data, err := os.ReadFile(*changelogFile)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", *changelogFile, err)
+		}
+		content = string(data)
+	} else {
+		// GITHUB_TOKEN is optional (improves rate limits if provided)
+		ctx := context.Background()
+		githubClient := github.NewClient(ctx, os.Getenv("GITHUB_TOKEN"))
+		path := fmt.Sprintf("CHANGELOG/CHANGELOG-%s.md", minor)
+		var err error
+		if content, err = githubClient.GetFileContent(ctx, "antrea-io", "antrea", path); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", path, err)
+		}
+	}
+
+	summary, err := changelog.SummarizeMinor(minor, content, cfg.Categories)
+	if err != nil {
+		return err
+	}
+	if err := changelog.Validate(summary); err != nil {
+		log.Printf("Warning: summary has markdown issues: %v", err)
+	}
+
+	if *outputFile == "" {
+		fmt.Print(summary)
+		return nil
+	}
+	if err := artifacts.WriteFile(*outputFile, []byte(summary)); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+	log.Printf("Summary written to %s", *outputFile)
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReleaseSection extracts the section of a release from a CHANGELOG-X.Y.md
+// file, with the link definitions of its authors
+func ReleaseSection(content, release string) (string, error) {
+	links := make(map[string]string)
+	_, sections := splitSections(content, links)
+	for _, s := range sections {
+		if s.version != release {
+			continue
+		}
+		var sb strings.Builder
+		writeBlock(&sb, s.lines)
+		writeLinkDefinitions(&sb, sectionAuthors(s), links)
+		return strings.TrimRight(sb.String(), "\n") + "\n", nil
+	}
+	return "", fmt.Errorf("no %s release section found", release)
+}
+
+// FormatAnnouncement renders the title and body of the announcement post of a
+// release from its CHANGELOG section. The body contains the release notes as
+// published on the GitHub release, i.e. without the release header.
+func FormatAnnouncement(release, section string) (string, string) {
+	title := fmt.Sprintf("Antrea v%s is released", release)
+	notes := section
+	if m := releaseHeaderRegex.FindStringIndex(section); m != nil {
+		notes = strings.TrimLeft(section[m[1]:], "\n")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Antrea v%s has been released! ", release))
+	sb.WriteString(fmt.Sprintf("The release artifacts are available on the [GitHub release page](https://github.com/%s/%s/releases/tag/v%s).\n\n", repoOwner, repoName, release))
+	sb.WriteString(notes)
+	return title, strings.TrimRight(sb.String(), "\n") + "\n"
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAnnouncement(t *testing.T) {
+	content := `# Changelog 2.5
+
+## 2.5.1 - 2025-02-15
+
+### Fixed
+
+- Fix crash. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])
+
+## 2.5.0 - 2025-01-30
+
+### Added
+
+- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+`
+	section, err := ReleaseSection(content, "2.5.0")
+	require.NoError(t, err)
+	assert.Equal(t, `## 2.5.0 - 2025-01-30
+
+### Added
+
+- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+[@alice]: https://github.com/alice
+`, section)
+
+	title, body := FormatAnnouncement("2.5.0", section)
+	assert.Equal(t, "Antrea v2.5.0 is released", title)
+	assert.Equal(t, `Antrea v2.5.0 has been released! The release artifacts are available on the [GitHub release page](https://github.com/antrea-io/antrea/releases/tag/v2.5.0).
+
+### Added
+
+- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+[@alice]: https://github.com/alice
+`, body)
+
+	_, err = ReleaseSection(content, "2.5.2")
+	assert.ErrorContains(t, err, "no 2.5.2 release section found")
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultNameTemplate is the default template for artifact filenames. It
+// produces the historical flat names, e.g. changelog-model-prompt-2.5.0-20250130-143025.txt.
+const DefaultNameTemplate = "changelog-model-{{.Kind}}-{{.Version}}-{{.Timestamp}}.{{.Ext}}"
+
+// TimestampFormat is the format of the timestamps of the artifact names
+const TimestampFormat = "20060102-150405"
+
+// Artifact kinds written for every run
+const (
+	KindPrompt    = "prompt"
+	KindOutput    = "output"
+	KindDetails   = "details"
+	KindSkipped   = "skipped"
+	KindConflicts = "conflicts"
+	KindAudit     = "label-audit"
+	KindDrift     = "drift"
+	KindFullList  = "full-change-list"
+	KindRouting   = "review-routing"
+	KindTrain     = "train-summary"
+	KindReadiness = "pr-readiness"
+	KindTrace     = "trace"
+	KindBundle    = "bundle"
+)
+
+// NameData is the data available to filename templates
+type NameData struct {
+	Version   string
+	Timestamp string
+	Kind      string
+	Ext       string
+}
+
+// Writer writes run artifacts to paths rendered from a filename template
+type Writer struct {
+	tmpl      *template.Template
+	version   string
+	timestamp string
+	storage   Storage
+	// written records every artifact in write order, for bundling
+	written []bundleEntry
+}
+
+type bundleEntry struct {
+	name string
+	data []byte
+}
+
+// WriterOption configures optional behavior of a Writer
+type WriterOption func(*Writer)
+
+// WithStorage writes the artifacts to a storage backend instead of the local
+// file system
+func WithStorage(storage Storage) WriterOption {
+	return func(w *Writer) {
+		w.storage = storage
+	}
+}
+
+// NewWriter creates a new Writer. The dir and nameTemplate are joined and
+// may both contain template actions (e.g. "releases/{{.Version}}").
+func NewWriter(dir, nameTemplate, version, timestamp string, opts ...WriterOption) (*Writer, error) {
+	if nameTemplate == "" {
+		nameTemplate = DefaultNameTemplate
+	}
+	tmpl, err := template.New("artifact").Option("missingkey=error").Parse(filepath.Join(dir, nameTemplate))
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact name template: %w", err)
+	}
+	w := &Writer{
+		tmpl:      tmpl,
+		version:   version,
+		timestamp: timestamp,
+		storage:   LocalStorage{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w, nil
+}
+
+// Path returns the rendered path for an artifact of the given kind
+func (w *Writer) Path(kind, ext string) (string, error) {
+	return render(w.tmpl, NameData{
+		Version:   w.version,
+		Timestamp: w.timestamp,
+		Kind:      kind,
+		Ext:       ext,
+	})
+}
+
+// Latest returns the path of the most recent artifact of the given kind
+// written by a previous run for the same version, or "" if there is none.
+// Timestamps sort chronologically, so the most recent one is the last path
+// matching the template with any timestamp.
+func (w *Writer) Latest(kind, ext string) (string, error) {
+	pattern, err := render(w.tmpl, NameData{
+		Version:   w.version,
+		Timestamp: "*",
+		Kind:      kind,
+		Ext:       ext,
+	})
+	if err != nil {
+		return "", err
+	}
+	matches, err := w.storage.List(context.Background(), pattern)
+	if err != nil {
+		return "", err
+	}
+	if len(matches) == 0 {
+		return "", nil
+	}
+	sort.Strings(matches)
+	return matches[len(matches)-1], nil
+}
+
+// Timestamps returns the timestamps of the runs which wrote an artifact of the
+// given kind for the same version, oldest first, e.g. to list the run history
+// of a release. The paths are matched against the template with the remote
+// backends' slash-separated keys, so the timestamp is found wherever the
+// template puts it.
+func (w *Writer) Timestamps(kind, ext string) ([]string, error) {
+	pattern, err := render(w.tmpl, NameData{
+		Version:   w.version,
+		Timestamp: "*",
+		Kind:      kind,
+		Ext:       ext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches, err := w.storage.List(context.Background(), pattern)
+	if err != nil {
+		return nil, err
+	}
+	timestampRegex, err := regexp.Compile("^" + strings.ReplaceAll(regexp.QuoteMeta(objectKey("", pattern)), `\*`, `(\d{8}-\d{6})`) + "$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact path pattern %s: %w", pattern, err)
+	}
+	var timestamps []string
+	for _, match := range matches {
+		if m := timestampRegex.FindStringSubmatch(objectKey("", match)); m != nil {
+			timestamps = append(timestamps, m[1])
+		}
+	}
+	sort.Strings(timestamps)
+	return timestamps, nil
+}
+
+// Write writes data to the rendered path for the given kind, creating parent
+// directories as needed, and returns the location written
+func (w *Writer) Write(kind, ext string, data []byte) (string, error) {
+	path, err := w.Path(kind, ext)
+	if err != nil {
+		return "", err
+	}
+	if err := w.storage.Put(context.Background(), path, data); err != nil {
+		return "", err
+	}
+	w.Add(filepath.Base(path), data)
+	return w.storage.Location(path), nil
+}
+
+// Read reads an artifact, e.g. the one returned by Latest
+func (w *Writer) Read(path string) ([]byte, error) {
+	return w.storage.Get(context.Background(), path)
+}
+
+// Add records data under the given name for inclusion in the bundle without
+// writing it to disk (e.g. a changelog printed to stdout)
+func (w *Writer) Add(name string, data []byte) {
+	for i := range w.written {
+		if w.written[i].name == name {
+			w.written[i].data = data
+			return
+		}
+	}
+	w.written = append(w.written, bundleEntry{name: name, data: data})
+}
+
+// WriteBundle packages all artifacts recorded so far into a single tar.gz
+// archive, named with the writer's template for KindBundle, and returns its
+// location
+func (w *Writer) WriteBundle() (string, error) {
+	path, err := w.Path(KindBundle, "tar.gz")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	modTime := time.Now()
+	// The timestamp has no time zone, it is read as UTC so that bundles are
+	// reproducible whatever the local time zone
+	if t, err := time.Parse(TimestampFormat, w.timestamp); err == nil {
+		modTime = t
+	}
+	for _, entry := range w.written {
+		hdr := &tar.Header{
+			Name:    entry.name,
+			Mode:    0600,
+			Size:    int64(len(entry.data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return "", fmt.Errorf("failed to write bundle header for %s: %w", entry.name, err)
+		}
+		if _, err := tw.Write(entry.data); err != nil {
+			return "", fmt.Errorf("failed to write bundle entry %s: %w", entry.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return "", fmt.Errorf("failed to finalize bundle: %w", err)
+	}
+	if err := gzw.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress bundle: %w", err)
+	}
+
+	if err := w.storage.Put(context.Background(), path, buf.Bytes()); err != nil {
+		return "", err
+	}
+	return w.storage.Location(path), nil
+}
+
+// RenderName renders a single filename template with the given data
+func RenderName(nameTemplate string, data NameData) (string, error) {
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid name template %q: %w", nameTemplate, err)
+	}
+	return render(tmpl, data)
+}
+
+// WriteFile writes data to path, creating parent directories as needed
+func WriteFile(path string, data []byte) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+func render(tmpl *template.Template, data NameData) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render name template: %w", err)
+	}
+	path := filepath.Clean(sb.String())
+	if path == "." || strings.HasSuffix(sb.String(), "/") {
+		return "", fmt.Errorf("name template rendered to an empty filename: %q", sb.String())
+	}
+	return path, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter_DefaultTemplate(t *testing.T) {
+	w, err := NewWriter("", "", "2.5.0", "20250130-143025")
+	require.NoError(t, err)
+
+	path, err := w.Path(KindPrompt, "txt")
+	require.NoError(t, err)
+	assert.Equal(t, "changelog-model-prompt-2.5.0-20250130-143025.txt", path)
+}
+
+func TestWriter_PerReleaseDirectory(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(filepath.Join(dir, "{{.Version}}"), "{{.Timestamp}}-{{.Kind}}.{{.Ext}}", "2.5.0", "20250130-143025")
+	require.NoError(t, err)
+
+	path, err := w.Write(KindDetails, "json", []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "2.5.0", "20250130-143025-details.json"), path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(data))
+}
+
+func TestRenderName_Errors(t *testing.T) {
+	_, err := RenderName("{{.Unknown}}", NameData{})
+	assert.Error(t, err, "Unknown template fields should be rejected")
+
+	_, err = RenderName("{{.Version}}/", NameData{Version: "2.5.0"})
+	assert.Error(t, err, "Templates rendering to a directory should be rejected")
+}
+
+func TestWriter_WriteBundle(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(dir, "", "2.5.0", "20250130-143025")
+	require.NoError(t, err)
+
+	_, err = w.Write(KindPrompt, "txt", []byte("prompt"))
+	require.NoError(t, err)
+	_, err = w.Write(KindOutput, "json", []byte(`{"changes":[]}`))
+	require.NoError(t, err)
+	w.Add("CHANGELOG-2.5.0.md", []byte("## 2.5.0"))
+
+	path, err := w.WriteBundle()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "changelog-model-bundle-2.5.0-20250130-143025.tar.gz"), path)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+
+	contents := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		contents[hdr.Name] = string(data)
+		// The modification time is the timestamp, whatever the local time zone
+		assert.True(t, time.Date(2025, 1, 30, 14, 30, 25, 0, time.UTC).Equal(hdr.ModTime), hdr.ModTime)
+	}
+
+	assert.Equal(t, map[string]string{
+		"changelog-model-prompt-2.5.0-20250130-143025.txt":  "prompt",
+		"changelog-model-output-2.5.0-20250130-143025.json": `{"changes":[]}`,
+		"CHANGELOG-2.5.0.md": "## 2.5.0",
+	}, contents)
+}
+
+func TestWriter_Latest(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(dir, "", "2.5.0", "20250203-090000")
+	require.NoError(t, err)
+	path, err := w.Latest(KindOutput, "json")
+	require.NoError(t, err)
+	assert.Empty(t, path)
+
+	for _, timestamp := range []string{"20250201-100000", "20250130-143025"} {
+		previous, err := NewWriter(dir, "", "2.5.0", timestamp)
+		require.NoError(t, err)
+		_, err = previous.Write(KindOutput, "json", []byte(`{"changes":[]}`))
+		require.NoError(t, err)
+	}
+	other, err := NewWriter(dir, "", "2.4.3", "20250202-100000")
+	require.NoError(t, err)
+	_, err = other.Write(KindOutput, "json", []byte(`{"changes":[]}`))
+	require.NoError(t, err)
+
+	path, err = w.Latest(KindOutput, "json")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "changelog-model-output-2.5.0-20250201-100000.json"), path)
+}
+
+func TestWriter_Timestamps(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(filepath.Join(dir, "{{.Version}}"), "{{.Timestamp}}/{{.Kind}}.{{.Ext}}", "2.5.0", "")
+	require.NoError(t, err)
+	timestamps, err := w.Timestamps(KindDetails, "json")
+	require.NoError(t, err)
+	assert.Empty(t, timestamps)
+
+	for _, timestamp := range []string{"20250201-100000", "20250130-143025"} {
+		previous, err := NewWriter(filepath.Join(dir, "{{.Version}}"), "{{.Timestamp}}/{{.Kind}}.{{.Ext}}", "2.5.0", timestamp)
+		require.NoError(t, err)
+		_, err = previous.Write(KindDetails, "json", []byte(`{}`))
+		require.NoError(t, err)
+	}
+	// Neither another kind nor a directory which is not a timestamp is a run
+	require.NoError(t, WriteFile(filepath.Join(dir, "2.5.0", "20250202-100000", "output.json"), []byte(`{}`)))
+	require.NoError(t, WriteFile(filepath.Join(dir, "2.5.0", "backup", "details.json"), []byte(`{}`)))
+
+	timestamps, err = w.Timestamps(KindDetails, "json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20250130-143025", "20250201-100000"}, timestamps)
+}
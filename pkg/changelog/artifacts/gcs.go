@@ -0,0 +1,148 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+)
+
+// gcsScope is the OAuth scope needed to read and write objects
+const gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+
+// GCSStorage writes artifacts to a Google Cloud Storage bucket, using the
+// JSON API
+type GCSStorage struct {
+	bucket  string
+	prefix  string
+	baseURL string
+	client  *http.Client
+}
+
+// NewGCSStorage creates a GCSStorage authenticated with the Application
+// Default Credentials (GOOGLE_APPLICATION_CREDENTIALS, gcloud credentials or
+// the metadata server of the runner)
+func NewGCSStorage(ctx context.Context, bucket, prefix string) (*GCSStorage, error) {
+	client, err := google.DefaultClient(ctx, gcsScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Google Cloud credentials for gs artifact storage: %w", err)
+	}
+	client.Timeout = storageTimeout
+	return NewGCSStorageWithClient(bucket, prefix, "https://storage.googleapis.com", client), nil
+}
+
+// NewGCSStorageWithClient creates a GCSStorage sending requests to baseURL
+// with an authenticated client (e.g. for an emulator)
+func NewGCSStorageWithClient(bucket, prefix, baseURL string, client *http.Client) *GCSStorage {
+	return &GCSStorage{bucket: bucket, prefix: prefix, baseURL: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+// Put uploads an object
+func (s *GCSStorage) Put(ctx context.Context, path string, data []byte) error {
+	query := url.Values{"uploadType": {"media"}, "name": {objectKey(s.prefix, path)}}
+	u := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", s.baseURL, url.PathEscape(s.bucket), query.Encode())
+	resp, err := s.do(ctx, http.MethodPost, u, data)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", s.Location(path), err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get downloads an object
+func (s *GCSStorage) Get(ctx context.Context, path string) ([]byte, error) {
+	u := fmt.Sprintf("%s/storage/v1/b/%s/o/%s?alt=media", s.baseURL, url.PathEscape(s.bucket), url.PathEscape(objectKey(s.prefix, path)))
+	resp, err := s.do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", s.Location(path), err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", s.Location(path), err)
+	}
+	return data, nil
+}
+
+// List lists the objects matching the pattern, across all pages
+func (s *GCSStorage) List(ctx context.Context, pattern string) ([]string, error) {
+	keyPattern := objectKey(s.prefix, pattern)
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{"prefix": {listPrefix(keyPattern)}, "fields": {"items(name),nextPageToken"}}
+		if token != "" {
+			query.Set("pageToken", token)
+		}
+		u := fmt.Sprintf("%s/storage/v1/b/%s/o?%s", s.baseURL, url.PathEscape(s.bucket), query.Encode())
+		resp, err := s.do(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list gs://%s/%s: %w", s.bucket, listPrefix(keyPattern), err)
+		}
+		var result struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the GCS object list: %w", err)
+		}
+		for _, item := range result.Items {
+			keys = append(keys, item.Name)
+		}
+		if result.NextPageToken == "" {
+			break
+		}
+		token = result.NextPageToken
+	}
+	return matchKeys(s.prefix, keyPattern, keys)
+}
+
+// Location returns the gs:// URL of the object of an artifact
+func (s *GCSStorage) Location(path string) string {
+	return fmt.Sprintf("gs://%s/%s", s.bucket, objectKey(s.prefix, path))
+}
+
+// do sends a request, and returns an error for non-2xx responses
+func (s *GCSStorage) do(ctx context.Context, method, u string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Credentials are the AWS credentials used to sign S3 requests
+type S3Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set for temporary credentials
+	SessionToken string
+}
+
+// S3Storage writes artifacts to an S3 bucket, or to any S3-compatible object
+// store (e.g. MinIO), with path-style requests signed with AWS Signature
+// Version 4
+type S3Storage struct {
+	bucket      string
+	prefix      string
+	region      string
+	endpoint    string
+	credentials S3Credentials
+	client      *http.Client
+	now         func() time.Time
+}
+
+// NewS3Storage creates an S3Storage. The endpoint defaults to the AWS
+// endpoint of the region.
+func NewS3Storage(bucket, prefix, region, endpoint string, credentials S3Credentials) *S3Storage {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	return &S3Storage{
+		bucket:      bucket,
+		prefix:      prefix,
+		region:      region,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		credentials: credentials,
+		client:      &http.Client{Timeout: storageTimeout},
+		now:         time.Now,
+	}
+}
+
+// NewS3StorageFromEnv creates an S3Storage configured with the standard AWS
+// environment variables: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN for the credentials, AWS_REGION (or AWS_DEFAULT_REGION)
+// and AWS_ENDPOINT_URL_S3 (or AWS_ENDPOINT_URL) for S3-compatible stores
+func NewS3StorageFromEnv(bucket, prefix string) (*S3Storage, error) {
+	credentials := S3Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables are required for s3 artifact storage")
+	}
+	region := firstEnv("AWS_REGION", "AWS_DEFAULT_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+	return NewS3Storage(bucket, prefix, region, firstEnv("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"), credentials), nil
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Put uploads an object
+func (s *S3Storage) Put(ctx context.Context, path string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, s.objectURL(path), nil, data)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", s.Location(path), err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get downloads an object
+func (s *S3Storage) Get(ctx context.Context, path string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(path), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", s.Location(path), err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", s.Location(path), err)
+	}
+	return data, nil
+}
+
+// List lists the objects matching the pattern, across all pages
+func (s *S3Storage) List(ctx context.Context, pattern string) ([]string, error) {
+	keyPattern := objectKey(s.prefix, pattern)
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {listPrefix(keyPattern)}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.do(ctx, http.MethodGet, s.endpoint+"/"+s.bucket, query, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list s3://%s/%s: %w", s.bucket, listPrefix(keyPattern), err)
+		}
+		var result struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the S3 object list: %w", err)
+		}
+		for _, c := range result.Contents {
+			keys = append(keys, c.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	return matchKeys(s.prefix, keyPattern, keys)
+}
+
+// Location returns the s3:// URL of the object of an artifact
+func (s *S3Storage) Location(path string) string {
+	return fmt.Sprintf("s3://%s/%s", s.bucket, objectKey(s.prefix, path))
+}
+
+func (s *S3Storage) objectURL(path string) string {
+	return s.endpoint + "/" + s.bucket + "/" + escapePath(objectKey(s.prefix, path))
+}
+
+// do sends a signed request, and returns an error for non-2xx responses
+func (s *S3Storage) do(ctx context.Context, method, rawURL string, query url.Values, body []byte) (*http.Response, error) {
+	if query != nil {
+		rawURL += "?" + canonicalQuery(query)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
+
+// sign adds the AWS Signature Version 4 headers to a request
+func (s *S3Storage) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.credentials.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(req.Header.Get(name))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.credentials.SecretAccessKey), date)
+	for _, part := range []string{s.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes a query string as required by Signature Version 4:
+// sorted by key, with spaces encoded as %20
+func canonicalQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+// escapePath escapes each segment of an object key
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// storageTimeout bounds each request to a remote storage backend
+const storageTimeout = 2 * time.Minute
+
+// Storage persists the artifacts of the runs. Paths are the rendered artifact
+// paths, with forward slashes for remote backends.
+type Storage interface {
+	// Put writes an artifact, replacing any existing one
+	Put(ctx context.Context, path string, data []byte) error
+	// Get reads an artifact
+	Get(ctx context.Context, path string) ([]byte, error)
+	// List returns the paths of the artifacts matching a glob pattern (as
+	// matched by path.Match), in any order
+	List(ctx context.Context, pattern string) ([]string, error)
+	// Location returns a human-readable location of an artifact, for logs
+	Location(path string) string
+}
+
+// NewStorage returns the storage backend for a location: the local file
+// system if empty, or a bucket with an optional prefix for s3://bucket/prefix
+// and gs://bucket/prefix
+func NewStorage(ctx context.Context, location string) (Storage, error) {
+	if location == "" {
+		return LocalStorage{}, nil
+	}
+	u, err := url.Parse(location)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid artifact storage %q, must be s3://bucket[/prefix] or gs://bucket[/prefix]", location)
+	}
+	prefix := strings.Trim(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		return NewS3StorageFromEnv(u.Host, prefix)
+	case "gs":
+		return NewGCSStorage(ctx, u.Host, prefix)
+	default:
+		return nil, fmt.Errorf("unsupported artifact storage scheme %q, must be s3 or gs", u.Scheme)
+	}
+}
+
+// LocalStorage writes artifacts to the local file system
+type LocalStorage struct{}
+
+// Put writes data to path, creating parent directories as needed
+func (LocalStorage) Put(_ context.Context, path string, data []byte) error {
+	return WriteFile(path, data)
+}
+
+// Get reads the file at path
+func (LocalStorage) Get(_ context.Context, path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// List returns the files matching the pattern
+func (LocalStorage) List(_ context.Context, pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact path pattern %s: %w", pattern, err)
+	}
+	return matches, nil
+}
+
+// Location returns the path of the file
+func (LocalStorage) Location(path string) string {
+	return path
+}
+
+// objectKey returns the key of the object of an artifact path in a bucket:
+// the path below the prefix, with forward slashes
+func objectKey(prefix, p string) string {
+	p = strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(p)), "/")
+	if prefix == "" {
+		return p
+	}
+	return prefix + "/" + p
+}
+
+// listPrefix returns the longest key prefix without glob metacharacters of a
+// pattern, with which remote backends list candidate objects
+func listPrefix(pattern string) string {
+	if i := strings.IndexAny(pattern, `*?[\`); i >= 0 {
+		return pattern[:i]
+	}
+	return pattern
+}
+
+// matchKeys returns the artifact paths of the keys matching the pattern (an
+// object key)
+func matchKeys(prefix, pattern string, keys []string) ([]string, error) {
+	var matches []string
+	for _, key := range keys {
+		ok, err := path.Match(pattern, key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid artifact path pattern %s: %w", pattern, err)
+		}
+		if !ok {
+			continue
+		}
+		if prefix != "" {
+			key = strings.TrimPrefix(key, prefix+"/")
+		}
+		matches = append(matches, key)
+	}
+	return matches, nil
+}
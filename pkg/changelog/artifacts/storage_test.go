@@ -0,0 +1,208 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBucket is an in-memory bucket served by the fake S3 and GCS servers
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (b *fakeBucket) put(key string, data []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.objects[key] = data
+}
+
+func (b *fakeBucket) get(key string) ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	data, ok := b.objects[key]
+	return data, ok
+}
+
+func (b *fakeBucket) list(prefix string) []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var keys []string
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newFakeS3(t *testing.T, bucket string) (*fakeBucket, *httptest.Server) {
+	b := &fakeBucket{objects: make(map[string][]byte)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20250130/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+			http.Error(w, "unsigned request", http.StatusForbidden)
+			return
+		}
+		key, _ := strings.CutPrefix(r.URL.Path, "/"+bucket)
+		key = strings.TrimPrefix(key, "/")
+		switch {
+		case r.Method == http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			if r.Header.Get("X-Amz-Content-Sha256") != sha256Hex(data) {
+				http.Error(w, "bad payload hash", http.StatusBadRequest)
+				return
+			}
+			b.put(key, data)
+		case key != "":
+			data, ok := b.get(key)
+			if !ok {
+				http.Error(w, "NoSuchKey", http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		default:
+			// One key per page, to cover pagination
+			keys := b.list(r.URL.Query().Get("prefix"))
+			start := 0
+			if token := r.URL.Query().Get("continuation-token"); token != "" {
+				start = sort.SearchStrings(keys, token)
+			}
+			type content struct {
+				Key string `xml:"Key"`
+			}
+			result := struct {
+				XMLName               xml.Name  `xml:"ListBucketResult"`
+				Contents              []content `xml:"Contents"`
+				IsTruncated           bool      `xml:"IsTruncated"`
+				NextContinuationToken string    `xml:"NextContinuationToken,omitempty"`
+			}{}
+			if start < len(keys) {
+				result.Contents = []content{{Key: keys[start]}}
+			}
+			if start+1 < len(keys) {
+				result.IsTruncated = true
+				result.NextContinuationToken = keys[start+1]
+			}
+			xml.NewEncoder(w).Encode(result)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return b, server
+}
+
+func newFakeGCS(t *testing.T, bucket string) (*fakeBucket, *httptest.Server) {
+	b := &fakeBucket{objects: make(map[string][]byte)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/"+bucket+"/o":
+			data, _ := io.ReadAll(r.Body)
+			b.put(r.URL.Query().Get("name"), data)
+			w.Write([]byte("{}"))
+		case r.URL.Path == "/storage/v1/b/"+bucket+"/o":
+			var result struct {
+				Items []map[string]string `json:"items"`
+			}
+			for _, key := range b.list(r.URL.Query().Get("prefix")) {
+				result.Items = append(result.Items, map[string]string{"name": key})
+			}
+			json.NewEncoder(w).Encode(result)
+		default:
+			key, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/storage/v1/b/"+bucket+"/o/"))
+			data, ok := b.get(key)
+			if err != nil || !ok {
+				http.Error(w, "Not Found", http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return b, server
+}
+
+func testStorage(t *testing.T, storage Storage, bucket *fakeBucket, keyPrefix string) {
+	w, err := NewWriter("releases/{{.Version}}", "", "2.5.0", "20250130-143025", WithStorage(storage))
+	require.NoError(t, err)
+	location, err := w.Write(KindOutput, "json", []byte(`{"changes": []}`))
+	require.NoError(t, err)
+	assert.Contains(t, location, keyPrefix+"releases/2.5.0/changelog-model-output-2.5.0-20250130-143025.json")
+	data, ok := bucket.get(keyPrefix + "releases/2.5.0/changelog-model-output-2.5.0-20250130-143025.json")
+	require.True(t, ok)
+	assert.Equal(t, `{"changes": []}`, string(data))
+
+	later, err := NewWriter("releases/{{.Version}}", "", "2.5.0", "20250131-090000", WithStorage(storage))
+	require.NoError(t, err)
+	_, err = later.Write(KindOutput, "json", []byte(`{"changes": [{"pr_number": 1}]}`))
+	require.NoError(t, err)
+	_, err = later.Write(KindDetails, "json", []byte(`{}`))
+	require.NoError(t, err)
+
+	latest, err := later.Latest(KindOutput, "json")
+	require.NoError(t, err)
+	assert.Equal(t, "releases/2.5.0/changelog-model-output-2.5.0-20250131-090000.json", latest)
+	data, err = later.Read(latest)
+	require.NoError(t, err)
+	assert.Equal(t, `{"changes": [{"pr_number": 1}]}`, string(data))
+
+	_, err = later.Read("releases/2.5.0/missing.json")
+	assert.Error(t, err)
+}
+
+func TestS3Storage(t *testing.T) {
+	bucket, server := newFakeS3(t, "artifacts")
+	storage := NewS3Storage("artifacts", "antrea", "eu-west-1", server.URL, S3Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	storage.now = func() time.Time { return time.Date(2025, 1, 30, 14, 30, 25, 0, time.UTC) }
+	testStorage(t, storage, bucket, "antrea/")
+	assert.Equal(t, "s3://artifacts/antrea/a/b.json", storage.Location("a/b.json"))
+}
+
+func TestGCSStorage(t *testing.T) {
+	bucket, server := newFakeGCS(t, "artifacts")
+	testStorage(t, NewGCSStorageWithClient("artifacts", "", server.URL, server.Client()), bucket, "")
+}
+
+func TestNewStorage(t *testing.T) {
+	storage, err := NewStorage(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, LocalStorage{}, storage)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "eu-west-1")
+	storage, err = NewStorage(context.Background(), "s3://artifacts/antrea/releases/")
+	require.NoError(t, err)
+	assert.Equal(t, "s3://artifacts/antrea/releases/a.json", storage.Location("a.json"))
+
+	for _, location := range []string{"artifacts", "s3://", "ftp://host/path"} {
+		_, err := NewStorage(context.Background(), location)
+		assert.Error(t, err, location)
+	}
+}
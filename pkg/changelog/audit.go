@@ -0,0 +1,79 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// detectMissingLabels returns the PRs without the release note label whose
+// entry the model wants to include normally (include_score at or above the
+// Include threshold), highest include_score first
+func detectMissingLabels(response *types.ModelResponse, prs []types.PRInfo, categories []config.Category, thresholds config.Thresholds) []types.MissingLabel {
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range prs {
+		byNumber[pr.Number] = pr
+	}
+
+	var missing []types.MissingLabel
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Include || !isKnownCategory(change.Category, categories) {
+			continue
+		}
+		pr, ok := byNumber[change.PRNumber]
+		if !ok || slices.Contains(pr.Labels, releaseNoteLabel) {
+			continue
+		}
+		missing = append(missing, types.MissingLabel{
+			Number:       pr.Number,
+			Title:        pr.Title,
+			Author:       pr.Author,
+			IncludeScore: change.IncludeScore,
+			Category:     strings.ToUpper(change.Category),
+			Description:  change.Description,
+		})
+	}
+	sort.SliceStable(missing, func(i, j int) bool {
+		return missing[i].IncludeScore > missing[j].IncludeScore
+	})
+	return missing
+}
+
+// FormatLabelAuditReport renders the PRs missing the release note label as a
+// markdown report, which maintainers can use to fix labels before the final run
+func FormatLabelAuditReport(release string, missing []types.MissingLabel) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# PRs missing the %s label in the %s CHANGELOG\n\n", releaseNoteLabel, release))
+	if len(missing) == 0 {
+		sb.WriteString("No PRs are missing the label.\n")
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("The model would include the following PRs in the CHANGELOG, but they do not have the %s label. ", releaseNoteLabel))
+	sb.WriteString("Add the label to the ones which deserve an entry before the final CHANGELOG run.\n\n")
+	sb.WriteString("| PR | Title | Author | Score | Category | Description |\n")
+	sb.WriteString("|----|-------|--------|-------|----------|-------------|\n")
+	for _, m := range missing {
+		sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s | %d | %s | %s |\n",
+			m.Number, repoOwner, repoName, m.Number, escapeTableCell(m.Title), escapeTableCell(m.Author), m.IncludeScore, m.Category, escapeTableCell(m.Description)))
+	}
+	return sb.String()
+}
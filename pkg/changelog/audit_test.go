@@ -0,0 +1,51 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDetectMissingLabels(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Add Egress bandwidth limits", Author: "alice", Labels: []string{"action/release-note", "kind/feature"}},
+		{Number: 101, Title: "Fix crash in AntreaProxy", Author: "bob", Labels: []string{"kind/bug"}},
+		{Number: 102, Title: "Support IPv6 in FlowExporter", Author: "carol", Labels: []string{"kind/feature"}},
+		{Number: 103, Title: "Refactor tests", Author: "dave"},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 95, Description: "Add Egress bandwidth limits"},
+			{PRNumber: 101, Category: "FIXED", IncludeScore: 70, Description: "Fix crash in AntreaProxy"},
+			{PRNumber: 102, Category: "added", IncludeScore: 85, Description: "Support IPv6 in FlowExporter"},
+			{PRNumber: 103, Category: "CHANGED", IncludeScore: 30, Description: "Refactor tests"},
+		},
+	}
+
+	missing := detectMissingLabels(response, prs, config.DefaultCategories(), config.DefaultThresholds())
+	require.Len(t, missing, 2)
+	assert.Equal(t, types.MissingLabel{Number: 102, Title: "Support IPv6 in FlowExporter", Author: "carol", IncludeScore: 85, Category: "ADDED", Description: "Support IPv6 in FlowExporter"}, missing[0])
+	assert.Equal(t, 101, missing[1].Number)
+
+	report := FormatLabelAuditReport("2.5.0", missing)
+	assert.Contains(t, report, "| [#102](https://github.com/antrea-io/antrea/pull/102) | Support IPv6 in FlowExporter | carol | 85 | ADDED | Support IPv6 in FlowExporter |\n")
+	assert.Contains(t, FormatLabelAuditReport("2.5.0", nil), "No PRs are missing the label.")
+}
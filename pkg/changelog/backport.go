@@ -0,0 +1,91 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// backportReleases returns the minor releases a PR was backported to, by PR
+// number, from the backport exceptions
+func backportReleases(exceptions []config.BackportException) map[int][]string {
+	if len(exceptions) == 0 {
+		return nil
+	}
+	releases := make(map[int][]string, len(exceptions))
+	for _, e := range exceptions {
+		releases[e.PR] = e.Releases
+	}
+	return releases
+}
+
+// backportSuffix returns the suffix of an entry for an intentionally backported
+// feature, e.g. " (backported to 2.4 and 2.3)", which is rendered the same way
+// in all the CHANGELOGs of the affected branches. A grouped entry lists the
+// releases of all its PRs. The suffix is empty if no PR was backported.
+func backportSuffix(change types.ChangeEntry, backports map[int][]string) string {
+	seen := make(map[string]bool)
+	var releases []*version.Version
+	for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+		for _, r := range backports[number] {
+			if seen[r] {
+				continue
+			}
+			seen[r] = true
+			// Releases are validated when loading the config
+			if v, err := version.Parse(r + ".0"); err == nil {
+				releases = append(releases, v)
+			}
+		}
+	}
+	if len(releases) == 0 {
+		return ""
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].GreaterThan(releases[j])
+	})
+	names := make([]string, len(releases))
+	for i, v := range releases {
+		names[i] = fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	}
+	list := names[0]
+	if len(names) > 1 {
+		list = strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+	return fmt.Sprintf(" (backported to %s)", list)
+}
+
+// backportedTo returns the PRs backported to the minor release of ver, which
+// are acknowledged ADDED entries of its patch releases
+func backportedTo(ver *version.Version, backports map[int][]string) []int {
+	minor := fmt.Sprintf("%d.%d", ver.Major(), ver.Minor())
+	var numbers []int
+	for number, releases := range backports {
+		for _, r := range releases {
+			if r == minor {
+				numbers = append(numbers, number)
+				break
+			}
+		}
+	}
+	sort.Ints(numbers)
+	return numbers
+}
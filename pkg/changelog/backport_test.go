@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestBackportExceptions(t *testing.T) {
+	backports := backportReleases([]config.BackportException{
+		{PR: 100, Releases: []string{"2.3", "2.4"}},
+		{PR: 101, Releases: []string{"2.10"}},
+	})
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, ImportanceScore: 90, Description: "Add feature", Author: "alice"},
+			{PRNumber: 102, Category: "ADDED", IncludeScore: 90, ImportanceScore: 80, Description: "Add other feature", Author: "bob", GroupedWith: []int{101}, GroupedAuthors: []string{"bob"}},
+			{PRNumber: 103, Category: "FIXED", IncludeScore: 90, Description: "Fix crash", Author: "bob"},
+		},
+	}
+
+	// The suffix is the same on all the affected branches
+	for _, ver := range []*version.Version{version.New(2, 5, 0), version.New(2, 4, 3)} {
+		text := formatChangelog(ver, response, formatOptions{thresholds: config.DefaultThresholds(), backports: backports})
+		assert.Contains(t, text, "- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice]) (backported to 2.4 and 2.3)\n")
+		assert.Contains(t, text, "- Add other feature. ([#102](https://github.com/antrea-io/antrea/pull/102) [#101](https://github.com/antrea-io/antrea/pull/101), [@bob]) (backported to 2.10)\n")
+		assert.Contains(t, text, "- Fix crash. ([#103](https://github.com/antrea-io/antrea/pull/103), [@bob])\n")
+		assert.NoError(t, Validate(text))
+	}
+
+	assert.Equal(t, []int{100}, backportedTo(version.New(2, 4, 3), backports))
+	assert.Equal(t, []int{101}, backportedTo(version.New(2, 10, 1), backports))
+	assert.Empty(t, backportedTo(version.New(2, 5, 1), backports))
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"runtime/debug"
+)
+
+// toolVersion can be set at build time with:
+// -ldflags "-X github.com/antrea-io/antrea-releaser/pkg/changelog.toolVersion=<version>"
+var toolVersion string
+
+// ToolVersion returns the version of the releaser tool, falling back to the
+// module version or VCS revision recorded in the binary, or "dev"
+func ToolVersion() string {
+	if toolVersion != "" {
+		return toolVersion
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	if info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && len(setting.Value) >= 12 {
+			return setting.Value[:12]
+		}
+	}
+	return "dev"
+}
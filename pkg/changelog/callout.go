@@ -0,0 +1,102 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// calloutSection is a section repeating some of the rendered entries
+type calloutSection struct {
+	header string
+	// prs contains the PR numbers of the entries to repeat
+	prs map[int]bool
+}
+
+// matchesPathPatterns returns true if filePath matches any of the patterns,
+// either as a whole or through one of its elements
+func matchesPathPatterns(filePath string, patterns []string) bool {
+	elements := append([]string{filePath}, strings.Split(filePath, "/")...)
+	for _, pattern := range patterns {
+		for _, element := range elements {
+			if ok, _ := path.Match(pattern, element); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// calloutPRs returns the PR numbers of the rendered entries selected by the
+// callout. Labels are checked first, and the changed files are only fetched
+// for the PRs which are not selected by their labels.
+func (g *ChangelogGenerator) calloutPRs(ctx context.Context, callout *config.Callout, response *types.ModelResponse, prs []types.PRInfo, thresholds config.Thresholds) (map[int]bool, error) {
+	labels := make(map[string]bool)
+	for _, l := range callout.Labels {
+		labels[l] = true
+	}
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range prs {
+		byNumber[pr.Number] = pr
+	}
+
+	selected := make(map[int]bool)
+	matches := func(number int) (bool, error) {
+		for _, l := range byNumber[number].Labels {
+			if labels[l] {
+				return true, nil
+			}
+		}
+		if len(callout.Paths) == 0 {
+			return false, nil
+		}
+		files, err := g.pullRequestFiles(ctx, number)
+		if err != nil {
+			return false, err
+		}
+		for _, f := range files {
+			if matchesPathPatterns(f, callout.Paths) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional {
+			continue
+		}
+		// A grouped entry is selected if any of its PRs is
+		for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+			ok, err := matches(number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check PR #%d for the %s callout: %w", number, callout.Header, err)
+			}
+			if ok {
+				selected[change.PRNumber] = true
+				break
+			}
+		}
+	}
+	log.Printf("Found %d entries for the %s callout", len(selected), callout.Header)
+	return selected, nil
+}
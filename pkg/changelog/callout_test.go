@@ -0,0 +1,102 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestMatchesPathPatterns(t *testing.T) {
+	patterns := config.DefaultWindowsCallout().Paths
+	assert.True(t, matchesPathPatterns("pkg/agent/route/route_windows.go", patterns))
+	assert.True(t, matchesPathPatterns("build/charts/antrea-windows/values.yaml", patterns))
+	assert.False(t, matchesPathPatterns("pkg/agent/route/route_linux.go", patterns))
+	assert.True(t, matchesPathPatterns("docs/windows.md", []string{"docs/*.md"}), "Patterns should match full paths")
+}
+
+func TestCalloutPRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	prs := []types.PRInfo{
+		{Number: 100, Labels: []string{"area/OS/windows"}},
+		{Number: 101},
+		{Number: 102},
+		{Number: 103},
+		{Number: 104},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, IncludeScore: 80},
+			{PRNumber: 101, IncludeScore: 80},
+			{PRNumber: 102, IncludeScore: 80, GroupedWith: []int{103}},
+			{PRNumber: 104, IncludeScore: 10},
+		},
+	}
+
+	// PR #100 is selected by its label, and PR #104 is not rendered
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 101).
+		Return([]string{"pkg/agent/route/route_linux.go"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 102).
+		Return([]string{"pkg/agent/route/route.go"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 103).
+		Return([]string{"pkg/agent/route/route_windows.go"}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient)
+	selected, err := generator.calloutPRs(context.Background(), config.DefaultWindowsCallout(), response, prs, config.DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, map[int]bool{100: true, 102: true}, selected)
+}
+
+func TestFormatChangelog_Callout(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", Description: "Fix route deletion on Windows Nodes", IncludeScore: 80, ImportanceScore: 50, Author: "alice"},
+			{PRNumber: 101, Category: "ADDED", Description: "Add Egress support for Windows", IncludeScore: 40, ImportanceScore: 70, Author: "bob"},
+			{PRNumber: 102, Category: "FIXED", Description: "Fix Egress IP allocation", IncludeScore: 80, ImportanceScore: 60, Author: "carol"},
+		},
+	}
+
+	text := formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		callout:    &calloutSection{header: "Windows", prs: map[int]bool{100: true, 101: true}},
+	})
+
+	assert.Contains(t, text, "### Fixed\n\n"+
+		"- Fix Egress IP allocation. ([#102](https://github.com/antrea-io/antrea/pull/102), [@carol])\n"+
+		"- Fix route deletion on Windows Nodes. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n"+
+		"### Windows\n\n"+
+		"- *OPTIONAL* Add Egress support for Windows. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])\n"+
+		"- Fix route deletion on Windows Nodes. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n")
+	assert.NoError(t, Validate(text))
+
+	// The callout is not rendered without entries
+	text = formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		callout:    &calloutSection{header: "Windows", prs: map[int]bool{}},
+	})
+	assert.NotContains(t, text, "### Windows")
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// cliFlagFile is a file of the Antrea repository defining the command-line
+// flags of a component
+type cliFlagFile struct {
+	component string
+	path      string
+	// antctl commands are declared with flagInfo structs instead of pflag calls
+	antctl bool
+}
+
+// cliFlagFiles are the files whose flag definitions are compared between the
+// release endpoints
+var cliFlagFiles = []cliFlagFile{
+	{component: "antrea-agent", path: "cmd/antrea-agent/options.go"},
+	{component: "antrea-controller", path: "cmd/antrea-controller/options.go"},
+	{component: "antctl", path: "pkg/antctl/antctl.go", antctl: true},
+}
+
+var (
+	// pflagDefinitionRegex matches pflag calls on a flag set (fs.StringVar(&o.x,
+	// "name", ...), cmd.Flags().BoolP("name", ...)), capturing the method and the
+	// first string argument
+	pflagDefinitionRegex = regexp.MustCompile(`\b(?:fs|flags|Flags\(\)|PersistentFlags\(\))\.(\w+)\(\s*(?:[^"\n]*?,\s*)?"([a-z][a-z0-9-]*)"`)
+	// pflagDeprecatedRegex matches the deprecation of a flag: fs.MarkDeprecated("name", ...)
+	pflagDeprecatedRegex = regexp.MustCompile(`\.MarkDeprecated\(\s*"([a-z][a-z0-9-]*)"`)
+	// antctlFlagRegex matches the name of an antctl flagInfo: name: "namespace"
+	antctlFlagRegex = regexp.MustCompile(`\bname:\s+"([a-z][a-z0-9-]*)"`)
+)
+
+// cliFlagChangeKind is the kind of a change of a command-line flag
+type cliFlagChangeKind string
+
+const (
+	cliFlagAdded      cliFlagChangeKind = "added"
+	cliFlagDeprecated cliFlagChangeKind = "deprecated"
+	cliFlagRemoved    cliFlagChangeKind = "removed"
+)
+
+// cliFlagChange is a flag of a component added, deprecated or removed within
+// the release window
+type cliFlagChange struct {
+	component string
+	flag      string
+	kind      cliFlagChangeKind
+}
+
+// String returns a human-readable description of the change
+func (c cliFlagChange) String() string {
+	return fmt.Sprintf("%s: %s `--%s`", c.component, c.kind, c.flag)
+}
+
+// cliFlags returns the flags defined in a file, and whether each of them is
+// deprecated
+func cliFlags(content string, antctl bool) map[string]bool {
+	flags := make(map[string]bool)
+	if antctl {
+		for _, m := range antctlFlagRegex.FindAllStringSubmatch(content, -1) {
+			flags[m[1]] = false
+		}
+	} else {
+		for _, m := range pflagDefinitionRegex.FindAllStringSubmatch(content, -1) {
+			// Other methods (MarkHidden, Lookup, Set...) refer to existing flags
+			if method := m[1]; strings.HasPrefix(method, "Mark") || method == "Lookup" || method == "Set" || method == "Changed" || strings.HasPrefix(method, "Get") {
+				continue
+			}
+			flags[m[2]] = false
+		}
+	}
+	for _, m := range pflagDeprecatedRegex.FindAllStringSubmatch(content, -1) {
+		if _, ok := flags[m[1]]; ok {
+			flags[m[1]] = true
+		}
+	}
+	return flags
+}
+
+// diffCLIFlags returns the flags of a component added, newly deprecated or
+// removed between two versions of its flag definitions, sorted by flag
+func diffCLIFlags(component string, from, to map[string]bool) []cliFlagChange {
+	var changes []cliFlagChange
+	for flag, deprecated := range to {
+		wasDeprecated, existed := from[flag]
+		switch {
+		case !existed:
+			changes = append(changes, cliFlagChange{component: component, flag: flag, kind: cliFlagAdded})
+		case deprecated && !wasDeprecated:
+			changes = append(changes, cliFlagChange{component: component, flag: flag, kind: cliFlagDeprecated})
+		}
+	}
+	for flag := range from {
+		if _, ok := to[flag]; !ok {
+			changes = append(changes, cliFlagChange{component: component, flag: flag, kind: cliFlagRemoved})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].flag < changes[j].flag
+	})
+	return changes
+}
+
+// detectCLIFlagChanges compares the flag definitions of the Antrea components
+// of the from-release tag and of the release branch
+func (g *ChangelogGenerator) detectCLIFlagChanges(ctx context.Context, fromRelease, branch string) ([]cliFlagChange, error) {
+	var changes []cliFlagChange
+	for _, file := range cliFlagFiles {
+		flags := make([]map[string]bool, 2)
+		for i, ref := range []string{"v" + fromRelease, branch} {
+			content, _, err := g.githubClient.GetFileAtRef(ctx, repoOwner, repoName, file.path, ref)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s at %s: %w", file.path, ref, err)
+			}
+			if content == "" {
+				break
+			}
+			flags[i] = cliFlags(content, file.antctl)
+		}
+		if flags[0] == nil || flags[1] == nil {
+			g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: file.path,
+				Message: fmt.Sprintf("%s not found at both v%s and %s, skipping its CLI flag check", file.path, fromRelease, branch)})
+			continue
+		}
+		changes = append(changes, diffCLIFlags(file.component, flags[0], flags[1])...)
+	}
+	if len(changes) > 0 {
+		log.Printf("%d CLI flags were added, deprecated or removed since v%s", len(changes), fromRelease)
+	}
+	return changes, nil
+}
+
+// cliFlagsPrompt returns the prompt section about the changed CLI flags
+func cliFlagsPrompt(changes []cliFlagChange) string {
+	var sb strings.Builder
+	sb.WriteString(`## CLI Flag Changes
+
+The following command-line flags of the Antrea components were added, deprecated or removed in this release. Past
+CHANGELOGs often omitted them, so each deprecated or removed flag MUST be covered by a CHANGED entry which names the flag
+(e.g. "Deprecate the --foo flag of antctl, use --bar instead"), attached to the PR which changed it if it is listed below.
+Added flags should be mentioned by the entry of the PR which added them:
+
+`)
+	for _, c := range changes {
+		sb.WriteString(fmt.Sprintf("- %s\n", c))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
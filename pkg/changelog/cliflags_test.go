@@ -0,0 +1,119 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+const agentOptionsV24 = `func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.configFile, "config", o.configFile, "The path to the configuration file")
+	fs.BoolVar(&o.enablePrometheus, "enable-prometheus", false, "Enable Prometheus metrics")
+	fs.StringVar(&o.nodeType, "node-type", "k8sNode", "Node type")
+	fs.MarkHidden("node-type")
+}
+`
+
+const agentOptionsV25 = `func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.configFile, "config", o.configFile, "The path to the configuration file")
+	fs.StringVar(&o.nodeType, "node-type", "k8sNode", "Node type")
+	fs.MarkDeprecated("node-type", "use the nodeType config parameter instead")
+	fs.DurationVar(&o.syncPeriod, "sync-period", time.Minute, "Sync period")
+}
+`
+
+func TestCLIFlags(t *testing.T) {
+	assert.Equal(t, map[string]bool{"config": false, "enable-prometheus": false, "node-type": false}, cliFlags(agentOptionsV24, false))
+	assert.Equal(t, map[string]bool{"config": false, "node-type": true, "sync-period": false}, cliFlags(agentOptionsV25, false))
+	assert.Equal(t, map[string]bool{"output": false}, cliFlags(`cmd.Flags().StringP("output", "o", "table", "Output format")`, false))
+
+	antctl := `flags: []flagInfo{
+	{
+		name:         "namespace",
+		supportedOutputFormats: []string{"json"},
+	},
+	{
+		name:      "output",
+		shorthand: "o",
+	},
+},`
+	assert.Equal(t, map[string]bool{"namespace": false, "output": false}, cliFlags(antctl, true))
+}
+
+func TestDiffCLIFlags(t *testing.T) {
+	changes := diffCLIFlags("antrea-agent", cliFlags(agentOptionsV24, false), cliFlags(agentOptionsV25, false))
+	assert.Equal(t, []cliFlagChange{
+		{component: "antrea-agent", flag: "enable-prometheus", kind: cliFlagRemoved},
+		{component: "antrea-agent", flag: "node-type", kind: cliFlagDeprecated},
+		{component: "antrea-agent", flag: "sync-period", kind: cliFlagAdded},
+	}, changes)
+	assert.Equal(t, "antrea-agent: deprecated `--node-type`", changes[1].String())
+}
+
+func TestDetectCLIFlagChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "cmd/antrea-agent/options.go", "v2.4.0").
+		Return(agentOptionsV24, "sha1", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "cmd/antrea-agent/options.go", "main").
+		Return(agentOptionsV25, "sha2", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "cmd/antrea-controller/options.go", gomock.Any()).
+		Return(`fs.StringVar(&o.configFile, "config", "", "")`, "sha3", nil).Times(2)
+	// The antctl definitions are missing at the from-release tag
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/antctl/antctl.go", "v2.4.0").
+		Return("", "", nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithCLIFlagsCheck(true, true))
+	changes, err := generator.detectCLIFlagChanges(context.Background(), "2.4.0", "main")
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	generator.cliFlagChanges = changes
+	promptText := generator.buildPrompt("", nil, nil)
+	assert.Contains(t, promptText, "## CLI Flag Changes")
+	assert.Contains(t, promptText, "- antrea-agent: removed `--enable-prometheus`\n")
+}
+
+func TestFormatChangelog_CLIFlagChanges(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "CHANGED", IncludeScore: 90, Description: "Deprecate the --node-type flag of the agent", Author: "alice"},
+		},
+	}
+	changelogText := formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		cliFlagChanges: []cliFlagChange{
+			{component: "antrea-agent", flag: "node-type", kind: cliFlagDeprecated},
+			{component: "antctl", flag: "sort-by", kind: cliFlagAdded},
+		},
+	})
+	assert.Contains(t, changelogText, "### Fixed\n\n\n### CLI Flag Changes\n\n"+
+		"- antrea-agent: deprecated `--node-type`\n"+
+		"- antctl: added `--sort-by`\n\n")
+	assert.NoError(t, Validate(changelogText))
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// SourceDateEpochVar is the environment variable fixing the clock of a run,
+// following the reproducible builds convention
+const SourceDateEpochVar = "SOURCE_DATE_EPOCH"
+
+// Clock returns the current time. The release date and the timestamps of the
+// artifacts are derived from it, in its time zone, so that they can be fixed
+// (e.g. for golden tests or reproducible runs).
+type Clock func() time.Time
+
+// FixedClock returns a Clock which always returns t
+func FixedClock(t time.Time) Clock {
+	return func() time.Time {
+		return t
+	}
+}
+
+// ClockFromEnv returns a Clock fixed at SOURCE_DATE_EPOCH (seconds since the
+// Unix epoch, in UTC) if it is set, and the system clock otherwise
+func ClockFromEnv() (Clock, error) {
+	value := os.Getenv(SourceDateEpochVar)
+	if value == "" {
+		return time.Now, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", SourceDateEpochVar, value, err)
+	}
+	return FixedClock(time.Unix(seconds, 0).UTC()), nil
+}
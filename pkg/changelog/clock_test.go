@@ -0,0 +1,39 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockFromEnv(t *testing.T) {
+	t.Setenv(SourceDateEpochVar, "")
+	clock, err := ClockFromEnv()
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), clock(), time.Minute)
+
+	t.Setenv(SourceDateEpochVar, "1738247425")
+	clock, err = ClockFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 30, 14, 30, 25, 0, time.UTC), clock())
+
+	t.Setenv(SourceDateEpochVar, "yesterday")
+	_, err = ClockFromEnv()
+	assert.ErrorContains(t, err, "invalid SOURCE_DATE_EPOCH")
+}
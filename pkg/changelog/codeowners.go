@@ -0,0 +1,246 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// CodeOwners maps path patterns to their owners, in the CODEOWNERS format:
+// one pattern per line followed by its owners (@user, @org/team or email),
+// where the last matching pattern wins
+type CodeOwners struct {
+	rules []codeOwnersRule
+}
+
+type codeOwnersRule struct {
+	pattern string
+	owners  []string
+}
+
+// ParseCodeOwners parses a CODEOWNERS file
+func ParseCodeOwners(content string) (*CodeOwners, error) {
+	c := &CodeOwners{}
+	for i, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rule := codeOwnersRule{pattern: fields[0]}
+		for _, owner := range fields[1:] {
+			if !strings.HasPrefix(owner, "@") && !strings.Contains(owner, "@") {
+				return nil, fmt.Errorf("invalid owner %q of %s on line %d, expected @user, @org/team or an email", owner, rule.pattern, i+1)
+			}
+			rule.owners = append(rule.owners, owner)
+		}
+		c.rules = append(c.rules, rule)
+	}
+	return c, nil
+}
+
+// LoadCodeOwners reads a CODEOWNERS file
+func LoadCodeOwners(filePath string) (*CodeOwners, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read code owners: %w", err)
+	}
+	c, err := ParseCodeOwners(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse code owners %s: %w", filePath, err)
+	}
+	return c, nil
+}
+
+// Owners returns the owners of a file, which are empty if no pattern matches
+// or if the last matching pattern has no owners
+func (c *CodeOwners) Owners(file string) []string {
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if codeOwnersMatch(c.rules[i].pattern, file) {
+			return c.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// codeOwnersMatch reports whether a file matches a CODEOWNERS pattern. A
+// pattern matches a file or any of its parent directories; patterns starting
+// with or containing a slash are relative to the repository root, and the
+// others match at any depth. A trailing slash (or /**) only matches
+// directories.
+func codeOwnersMatch(pattern, file string) bool {
+	if pattern == "*" || pattern == "**" {
+		return true
+	}
+	pattern = strings.TrimPrefix(pattern, "**/")
+	dirOnly := false
+	if strings.HasSuffix(pattern, "/**") {
+		pattern, dirOnly = strings.TrimSuffix(pattern, "/**"), true
+	} else if strings.HasSuffix(pattern, "/") {
+		pattern, dirOnly = strings.TrimSuffix(pattern, "/"), true
+	}
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	elements := strings.Split(file, "/")
+	last := len(elements)
+	if dirOnly {
+		// The file itself cannot match
+		last--
+	}
+	for i := 0; i < last; i++ {
+		candidate := elements[i]
+		if anchored {
+			candidate = strings.Join(elements[:i+1], "/")
+		}
+		if ok, _ := path.Match(pattern, candidate); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// routeReviews assigns each rendered entry to the owners of most of the files
+// changed by its PRs (including grouped PRs), so that large releases can be
+// reviewed by the teams owning the changes
+func (g *ChangelogGenerator) routeReviews(ctx context.Context, response *types.ModelResponse, thresholds config.Thresholds) ([]types.ReviewRoute, error) {
+	var routes []types.ReviewRoute
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || !isKnownCategory(change.Category, g.categories) {
+			continue
+		}
+		counts := make(map[string]int)
+		for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+			files, err := g.pullRequestFiles(ctx, number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list files of PR #%d: %w", number, err)
+			}
+			for _, f := range files {
+				for _, owner := range g.codeOwners.Owners(f) {
+					counts[owner]++
+				}
+			}
+		}
+		var owners []string
+		maxCount := 0
+		for owner, count := range counts {
+			if count > maxCount {
+				owners, maxCount = []string{owner}, count
+			} else if count == maxCount {
+				owners = append(owners, owner)
+			}
+		}
+		sort.Strings(owners)
+		routes = append(routes, types.ReviewRoute{
+			PRNumber:    change.PRNumber,
+			Category:    strings.ToUpper(change.Category),
+			Description: change.Description,
+			Owners:      owners,
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].PRNumber < routes[j].PRNumber
+	})
+	return routes, nil
+}
+
+// ReviewOwners returns the owners of the routed entries, sorted
+func ReviewOwners(routes []types.ReviewRoute) []string {
+	seen := make(map[string]bool)
+	var owners []string
+	for _, route := range routes {
+		for _, owner := range route.Owners {
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	sort.Strings(owners)
+	return owners
+}
+
+// splitReviewers splits code owners into the users and the team slugs a
+// review can be requested from. Teams of other organizations and emails are
+// ignored, as GitHub cannot request their review.
+func splitReviewers(owners []string) (users, teams []string) {
+	for _, owner := range owners {
+		if !strings.HasPrefix(owner, "@") {
+			continue
+		}
+		org, team, isTeam := strings.Cut(strings.TrimPrefix(owner, "@"), "/")
+		switch {
+		case !isTeam:
+			users = append(users, org)
+		case strings.EqualFold(org, repoOwner):
+			teams = append(teams, team)
+		}
+	}
+	return users, teams
+}
+
+// FormatReviewRouting renders the entries of the CHANGELOG by owner as a
+// markdown report, so that each team can review the entries of its changes
+func FormatReviewRouting(release string, routes []types.ReviewRoute) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# CHANGELOG review routing for %s\n\n", release))
+	if len(routes) == 0 {
+		sb.WriteString("No entries to review.\n")
+		return sb.String()
+	}
+	sb.WriteString("Each entry is assigned to the code owners of most of the files changed by its PRs.\n")
+
+	writeRoutes := func(title string, routes []types.ReviewRoute) {
+		sb.WriteString(fmt.Sprintf("\n## %s (%d entries)\n\n", title, len(routes)))
+		sb.WriteString("| PR | Category | Description |\n")
+		sb.WriteString("|----|----------|-------------|\n")
+		for _, route := range routes {
+			sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s |\n",
+				route.PRNumber, repoOwner, repoName, route.PRNumber, route.Category, escapeTableCell(route.Description)))
+		}
+	}
+	for _, owner := range ReviewOwners(routes) {
+		var owned []types.ReviewRoute
+		for _, route := range routes {
+			for _, o := range route.Owners {
+				if o == owner {
+					owned = append(owned, route)
+					break
+				}
+			}
+		}
+		writeRoutes(owner, owned)
+	}
+	var unowned []types.ReviewRoute
+	for _, route := range routes {
+		if len(route.Owners) == 0 {
+			unowned = append(unowned, route)
+		}
+	}
+	if len(unowned) > 0 {
+		writeRoutes("No code owner", unowned)
+	}
+	return sb.String()
+}
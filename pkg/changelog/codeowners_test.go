@@ -0,0 +1,103 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const testCodeOwners = `# Default owners
+*                       @antrea-io/maintainers
+
+/pkg/agent/             @antrea-io/agent-maintainers
+/pkg/agent/flowexporter/ @antrea-io/flow-visibility
+*.ps1                   @antrea-io/windows
+docs/                   @alice
+/docs/design/           # No owner
+`
+
+func TestCodeOwners(t *testing.T) {
+	owners, err := ParseCodeOwners(testCodeOwners)
+	require.NoError(t, err)
+	for file, expected := range map[string][]string{
+		"go.mod":                             {"@antrea-io/maintainers"},
+		"pkg/agent/agent.go":                 {"@antrea-io/agent-maintainers"},
+		"pkg/agent/flowexporter/exporter.go": {"@antrea-io/flow-visibility"},
+		"cmd/pkg/agent/main.go":              {"@antrea-io/maintainers"},
+		"hack/windows/Install-OVS.ps1":       {"@antrea-io/windows"},
+		"docs/egress.md":                     {"@alice"},
+		"multicluster/docs/user-guide.md":    {"@alice"},
+		"docs/design/architecture.md":        nil,
+		"pkg/agent":                          {"@antrea-io/maintainers"},
+	} {
+		t.Run(file, func(t *testing.T) {
+			assert.Equal(t, expected, owners.Owners(file))
+		})
+	}
+
+	_, err = ParseCodeOwners("/pkg/ antrea-io/maintainers\n")
+	assert.ErrorContains(t, err, "line 1")
+}
+
+func TestRouteReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owners, err := ParseCodeOwners(testCodeOwners)
+	require.NoError(t, err)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 100).
+		Return([]string{"pkg/agent/flowexporter/exporter.go", "pkg/agent/flowexporter/conn.go", "pkg/agent/agent.go"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 101).
+		Return([]string{"docs/design/architecture.md"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 102).
+		Return([]string{"docs/egress.md"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 103).
+		Return([]string{"go.mod"}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithCodeOwners(owners))
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "ADDED", Description: "Add flow export", IncludeScore: 80},
+		{PRNumber: 101, Category: "CHANGED", Description: "Document the architecture", IncludeScore: 80},
+		{PRNumber: 102, Category: "FIXED", Description: "Fix Egress docs", IncludeScore: 80, GroupedWith: []int{103}},
+		{PRNumber: 104, Category: "FIXED", Description: "Not rendered", IncludeScore: 0},
+	}}
+	routes, err := generator.routeReviews(context.Background(), response, config.DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, []types.ReviewRoute{
+		{PRNumber: 100, Category: "ADDED", Description: "Add flow export", Owners: []string{"@antrea-io/flow-visibility"}},
+		{PRNumber: 101, Category: "CHANGED", Description: "Document the architecture"},
+		{PRNumber: 102, Category: "FIXED", Description: "Fix Egress docs", Owners: []string{"@alice", "@antrea-io/maintainers"}},
+	}, routes)
+	assert.Equal(t, []string{"@alice", "@antrea-io/flow-visibility", "@antrea-io/maintainers"}, ReviewOwners(routes))
+
+	report := FormatReviewRouting("2.5.0", routes)
+	assert.Contains(t, report, "## @antrea-io/flow-visibility (1 entries)\n\n| PR | Category | Description |\n|----|----------|-------------|\n"+
+		"| [#100](https://github.com/antrea-io/antrea/pull/100) | ADDED | Add flow export |\n")
+	assert.Contains(t, report, "## No code owner (1 entries)\n")
+
+	generator.reviewRoutes = routes
+	assert.Contains(t, generator.ReviewSummary(response), "#### Review routing (entries by code owner)\n\n"+
+		"- @alice: #102\n- @antrea-io/flow-visibility: #100\n- @antrea-io/maintainers: #102\n")
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// PRSource selects how the PRs of a release are discovered
+type PRSource string
+
+const (
+	// PRSourceList lists closed PRs against the release branch, merged after the from-release (default)
+	PRSourceList PRSource = "list"
+	// PRSourceCompare compares the from-release tag with the release branch and maps each commit to its PR
+	PRSourceCompare PRSource = "compare"
+)
+
+// ParsePRSource parses a PR source name
+func ParsePRSource(s string) (PRSource, error) {
+	switch p := PRSource(s); p {
+	case PRSourceList, PRSourceCompare:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid PR source %q, must be one of: list, compare", s)
+}
+
+var (
+	// squashCommitRegex matches the PR number GitHub appends to squash commit titles: "Title (#1234)"
+	squashCommitRegex = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+	// mergeCommitRegex matches the title GitHub uses for merge commits: "Merge pull request #1234 from ..."
+	mergeCommitRegex = regexp.MustCompile(`^Merge pull request #(\d+) from `)
+)
+
+// commitPRs contains the PRs of the commits between a from-release tag and a branch
+type commitPRs struct {
+	// numbers are the PR numbers, in commit order
+	numbers []int
+	// associated are the commits without a PR number in their title, mapped
+	// with the commit association API
+	associated []types.DriftCommit
+	// unmapped are the commits which could not be mapped to a merged PR
+	unmapped []types.DriftCommit
+	// squashed are the commits mapped from a "Title (#1234)" title, by PR
+	// number. The PR may have been merged into another branch (e.g. a commit
+	// cherry-picked from main), in which case the commit is the change on the
+	// branch.
+	squashed map[int]*gogithub.RepositoryCommit
+}
+
+// mergedCommits returns the SHAs of the commits which are only reachable from
+// the merge commits of PRs mapped from their title, i.e. the commits of these
+// PRs (including the commits they cherry-picked). The branch head is the last
+// commit, and the first-parent chain from it is the history of the branch.
+func mergedCommits(commits []*gogithub.RepositoryCommit) map[string]bool {
+	merged := make(map[string]bool)
+	if len(commits) == 0 {
+		return merged
+	}
+	bySHA := make(map[string]*gogithub.RepositoryCommit, len(commits))
+	for _, commit := range commits {
+		bySHA[commit.GetSHA()] = commit
+	}
+	mainline := make(map[string]bool)
+	for commit := commits[len(commits)-1]; commit != nil && !mainline[commit.GetSHA()]; {
+		mainline[commit.GetSHA()] = true
+		if len(commit.Parents) == 0 {
+			break
+		}
+		commit = bySHA[commit.Parents[0].GetSHA()]
+	}
+
+	for _, commit := range commits {
+		title, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+		if !mergeCommitRegex.MatchString(title) || len(commit.Parents) < 2 {
+			continue
+		}
+		var pending []string
+		for _, parent := range commit.Parents[1:] {
+			pending = append(pending, parent.GetSHA())
+		}
+		for len(pending) > 0 {
+			sha := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			c, ok := bySHA[sha]
+			if !ok || mainline[sha] || merged[sha] {
+				continue
+			}
+			merged[sha] = true
+			for _, parent := range c.Parents {
+				pending = append(pending, parent.GetSHA())
+			}
+		}
+	}
+	return merged
+}
+
+// mapCommitsToPRs maps the commits which are on the branch but not in the
+// from-release to PRs. Squash and merge commits are mapped to PRs from their
+// title, and the commits of the PRs of merge commits are skipped; other
+// commits (e.g. from PRs merged with merge commits using a custom message, or
+// rebased) are mapped with the commit association API.
+func (g *ChangelogGenerator) mapCommitsToPRs(ctx context.Context, branch, fromRelease string) (*commitPRs, error) {
+	commits, err := g.githubClient.CompareCommits(ctx, repoOwner, repoName, "v"+fromRelease, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare v%s with %s: %w", fromRelease, branch, err)
+	}
+	log.Printf("Found %d commits between v%s and %s", len(commits), fromRelease, branch)
+
+	result := &commitPRs{squashed: make(map[int]*gogithub.RepositoryCommit)}
+	merged := mergedCommits(commits)
+	seen := make(map[int]bool)
+	addNumber := func(number int) {
+		if !seen[number] {
+			seen[number] = true
+			result.numbers = append(result.numbers, number)
+		}
+	}
+
+	for _, commit := range commits {
+		if merged[commit.GetSHA()] {
+			continue
+		}
+		title, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+		if m := mergeCommitRegex.FindStringSubmatch(title); m != nil {
+			if number, err := strconv.Atoi(m[1]); err == nil {
+				addNumber(number)
+				continue
+			}
+		}
+		if m := squashCommitRegex.FindStringSubmatch(title); m != nil {
+			if number, err := strconv.Atoi(m[1]); err == nil {
+				addNumber(number)
+				if _, ok := result.squashed[number]; !ok {
+					result.squashed[number] = commit
+				}
+				continue
+			}
+		}
+
+		driftCommit := types.DriftCommit{SHA: commit.GetSHA(), Title: title}
+		pulls, err := g.githubClient.ListPullRequestsWithCommit(ctx, repoOwner, repoName, commit.GetSHA())
+		if err != nil {
+			g.warn(types.Warning{Kind: types.WarningKindUnreachablePR,
+				Message: fmt.Sprintf("failed to find PR for commit %s: %v", commit.GetSHA(), err)})
+			result.unmapped = append(result.unmapped, driftCommit)
+			continue
+		}
+		for _, pull := range pulls {
+			if pull.MergedAt != nil {
+				addNumber(pull.GetNumber())
+				driftCommit.PRs = append(driftCommit.PRs, pull.GetNumber())
+			}
+		}
+		if len(driftCommit.PRs) == 0 {
+			result.unmapped = append(result.unmapped, driftCommit)
+		} else {
+			result.associated = append(result.associated, driftCommit)
+		}
+	}
+	if len(merged) > 0 {
+		log.Printf("Skipped %d commits of PRs merged with a merge commit", len(merged))
+	}
+	if len(result.associated) > 0 {
+		log.Printf("Mapped %d commits without a PR number in their title using the commit association API", len(result.associated))
+	}
+	return result, nil
+}
+
+// fetchPRsFromCommits lists the PRs whose commits are on the branch but not in
+// the from-release
+func (g *ChangelogGenerator) fetchPRsFromCommits(ctx context.Context, branch, fromRelease string) ([]types.PRInfo, error) {
+	mapped, err := g.mapCommitsToPRs(ctx, branch, fromRelease)
+	if err != nil {
+		return nil, err
+	}
+	numbers := mapped.numbers
+	if len(numbers) == 0 {
+		return nil, nil
+	}
+
+	pulls, err := g.githubClient.GetPullRequests(ctx, repoOwner, repoName, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	var prs []types.PRInfo
+	for _, number := range numbers {
+		pull, ok := pulls[number]
+		if !ok {
+			g.warn(types.Warning{Kind: types.WarningKindUnreachablePR, PRNumber: number,
+				Message: fmt.Sprintf("PR #%d referenced by a commit was not found", number)})
+			continue
+		}
+		if pull.MergedAt == nil {
+			continue
+		}
+
+		var labels []string
+		hasReleaseNote, hasCherryPick := false, false
+		for _, l := range pull.Labels {
+			labels = append(labels, l.GetName())
+			switch l.GetName() {
+			case releaseNoteLabel:
+				hasReleaseNote = true
+			case "kind/cherry-pick":
+				hasCherryPick = true
+			}
+		}
+		// Cherry-pick PRs are handled separately
+		if hasCherryPick || (!g.all && !hasReleaseNote) {
+			continue
+		}
+
+		// A PR merged into another branch is dated and identified by its
+		// commit on the branch
+		mergedAt, mergeCommitSHA := pull.MergedAt.Time, pull.GetMergeCommitSHA()
+		if commit, ok := mapped.squashed[number]; ok && commit.GetSHA() != mergeCommitSHA {
+			mergeCommitSHA = commit.GetSHA()
+			if date := commit.GetCommit().GetCommitter().GetDate(); !date.IsZero() {
+				mergedAt = date.Time
+			}
+		}
+
+		prs = append(prs, types.PRInfo{
+			Number:         pull.GetNumber(),
+			Title:          pull.GetTitle(),
+			Body:           pull.GetBody(),
+			Author:         prAuthor(pull),
+			Labels:         labels,
+			MergedAt:       mergedAt,
+			MergeCommitSHA: mergeCommitSHA,
+		})
+	}
+	return prs, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+)
+
+func newRepositoryCommit(sha, message string) *gogithub.RepositoryCommit {
+	return &gogithub.RepositoryCommit{
+		SHA:    gogithub.Ptr(sha),
+		Commit: &gogithub.Commit{Message: gogithub.Ptr(message)},
+	}
+}
+
+func newMergedPR(number int, labels ...string) *gogithub.PullRequest {
+	pr := &gogithub.PullRequest{
+		Number:   gogithub.Ptr(number),
+		Title:    gogithub.Ptr("PR title"),
+		User:     &gogithub.User{Login: gogithub.Ptr("author")},
+		MergedAt: &gogithub.Timestamp{Time: time.Now()},
+	}
+	for _, l := range labels {
+		pr.Labels = append(pr.Labels, &gogithub.Label{Name: gogithub.Ptr(l)})
+	}
+	return pr
+}
+
+func TestFetchPRsFromCommits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().
+		CompareCommits(gomock.Any(), "antrea-io", "antrea", "v2.4.0", "main").
+		Return([]*gogithub.RepositoryCommit{
+			newRepositoryCommit("a1", "Add feature X (#100)\n\nSigned-off-by: alice"),
+			newRepositoryCommit("b2", "Merge pull request #101 from bob/fix\n\nFix bug Y"),
+			newRepositoryCommit("c3", "Fix typo in bug Y fix"),
+			newRepositoryCommit("d4", "Merge branch 'feature-z'"),
+			newRepositoryCommit("e5", "Cherry pick of #90 (#102)"),
+		}, nil)
+	// Commits without a PR number in their title are mapped with the commit association API
+	mockGitHubClient.EXPECT().
+		ListPullRequestsWithCommit(gomock.Any(), "antrea-io", "antrea", "c3").
+		Return([]*gogithub.PullRequest{newMergedPR(101)}, nil)
+	mockGitHubClient.EXPECT().
+		ListPullRequestsWithCommit(gomock.Any(), "antrea-io", "antrea", "d4").
+		Return([]*gogithub.PullRequest{newMergedPR(103)}, nil)
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{100, 101, 103, 102}).
+		Return(map[int]*gogithub.PullRequest{
+			100: newMergedPR(100, "action/release-note"),
+			101: newMergedPR(101, "action/release-note"),
+			102: newMergedPR(102, "action/release-note", "kind/cherry-pick"),
+			103: newMergedPR(103),
+		}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithPRSource(PRSourceCompare))
+	prs, err := generator.fetchPRsFromCommits(context.Background(), "main", "2.4.0")
+	require.NoError(t, err)
+
+	var numbers []int
+	for _, pr := range prs {
+		numbers = append(numbers, pr.Number)
+	}
+	// #102 is a cherry-pick (handled separately) and #103 has no release-note label
+	assert.Equal(t, []int{100, 101}, numbers)
+}
+
+func TestFetchPRsFromCommits_ReleaseBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	withParents := func(commit *gogithub.RepositoryCommit, parents ...string) *gogithub.RepositoryCommit {
+		for _, sha := range parents {
+			commit.Parents = append(commit.Parents, &gogithub.Commit{SHA: gogithub.Ptr(sha)})
+		}
+		return commit
+	}
+	committedAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	cherryPick := withParents(newRepositoryCommit("s1", "Fix Z (#95)"), "m1")
+	cherryPick.Commit.Committer = &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: committedAt}}
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().
+		CompareCommits(gomock.Any(), "antrea-io", "antrea", "v2.4.0", "release-2.4").
+		Return([]*gogithub.RepositoryCommit{
+			withParents(newRepositoryCommit("a1", "Add feature X (#100)")),
+			// Commit of the cherry-pick PR #101, titled after the original PR
+			withParents(newRepositoryCommit("c3", "Fix bug Y (#90)"), "a1"),
+			withParents(newRepositoryCommit("m1", "Merge pull request #101 from bob/cherry-pick"), "a1", "c3"),
+			cherryPick,
+		}, nil)
+	// The commits of #101 are neither mapped from their title nor with the commit association API
+	main95 := newMergedPR(95, "action/release-note")
+	main95.MergeCommitSHA = gogithub.Ptr("main95")
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{100, 101, 95}).
+		Return(map[int]*gogithub.PullRequest{
+			100: newMergedPR(100, "action/release-note"),
+			101: newMergedPR(101, "action/release-note", "kind/cherry-pick"),
+			95:  main95,
+		}, nil)
+
+	generator := NewChangelogGenerator("2.4.1", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithPRSource(PRSourceCompare))
+	prs, err := generator.fetchPRsFromCommits(context.Background(), "release-2.4", "2.4.0")
+	require.NoError(t, err)
+
+	require.Len(t, prs, 2)
+	assert.Equal(t, 100, prs[0].Number)
+	// #95 was merged into main, and cherry-picked onto the release branch as s1
+	assert.Equal(t, 95, prs[1].Number)
+	assert.Equal(t, "s1", prs[1].MergeCommitSHA)
+	assert.Equal(t, committedAt, prs[1].MergedAt)
+}
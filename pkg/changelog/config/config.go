@@ -0,0 +1,585 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// ModelCategories are the categories the model classifies PRs into
+var ModelCategories = []string{"ADDED", "CHANGED", "FIXED"}
+
+// Category configures how a CHANGELOG category is rendered
+type Category struct {
+	// Name is the model category (ADDED, CHANGED or FIXED)
+	Name string `yaml:"name"`
+	// Header is the section header text (default: Title case of Name)
+	Header string `yaml:"header,omitempty"`
+	// MaxEntries is the maximum number of entries rendered in the CHANGELOG,
+	// by importance; the others go to the full change list (0 for no limit)
+	MaxEntries int `yaml:"max_entries,omitempty"`
+	// Subcategories split the category into subsections, in rendering order.
+	// The model assigns each entry of the category to one of them.
+	Subcategories []Subcategory `yaml:"subcategories,omitempty"`
+}
+
+// Subcategory is a subsection of a category, e.g. Networking in Added
+type Subcategory struct {
+	// Name is the subsection header text, and the name the model assigns
+	Name string `yaml:"name"`
+	// Description tells the model which entries belong to the subcategory
+	Description string `yaml:"description,omitempty"`
+}
+
+// OtherSubcategory is the subsection of the entries of a category with
+// subcategories which the model did not assign to any of them
+const OtherSubcategory = "Other"
+
+// Callout configures a section repeating the entries relevant to a specific
+// audience, in addition to their category
+type Callout struct {
+	// Header is the section header text
+	Header string `yaml:"header"`
+	// Labels selects PRs with any of these labels
+	Labels []string `yaml:"labels,omitempty"`
+	// Paths selects PRs changing a file matching any of these patterns. Each
+	// pattern is matched against the full path and against each path element
+	// (e.g. *windows* matches build/charts/antrea-windows/values.yaml).
+	Paths []string `yaml:"paths,omitempty"`
+}
+
+// NotableDependency is a dependency whose upgrades matter to users (e.g. Open
+// vSwitch), so that bot PRs upgrading it get a CHANGED entry
+type NotableDependency struct {
+	// Name is the name of the dependency, as mentioned in the CHANGELOG
+	Name string `yaml:"name"`
+	// Keywords selects PRs whose title contains any of these words
+	// (case-insensitive)
+	Keywords []string `yaml:"keywords,omitempty"`
+	// Paths selects PRs changing a file matching any of these patterns,
+	// matched like callout paths
+	Paths []string `yaml:"paths,omitempty"`
+}
+
+// LinkTemplates configures the URLs of the links rendered in the CHANGELOG, as
+// Go templates. PR and Issue templates get the {{.Number}} field, and the Author
+// template gets the {{.Author}} field.
+type LinkTemplates struct {
+	PR     string `yaml:"pr"`
+	Issue  string `yaml:"issue"`
+	Author string `yaml:"author"`
+	// DeletedAuthor is the plain text rendered instead of the author link of
+	// the PRs whose author account was deleted
+	DeletedAuthor string `yaml:"deleted_author"`
+}
+
+// DefaultDeletedAuthor is the default placeholder of deleted author accounts,
+// named after the GitHub user which deleted accounts are replaced with
+const DefaultDeletedAuthor = "@ghost"
+
+// linkData contains the fields available to link templates
+type linkData struct {
+	Number int
+	Author string
+}
+
+// DefaultLinkTemplates returns the link templates for antrea-io/antrea on GitHub
+func DefaultLinkTemplates() LinkTemplates {
+	return LinkTemplates{
+		PR:            "https://github.com/antrea-io/antrea/pull/{{.Number}}",
+		Issue:         "https://github.com/antrea-io/antrea/issues/{{.Number}}",
+		Author:        "https://github.com/{{.Author}}",
+		DeletedAuthor: DefaultDeletedAuthor,
+	}
+}
+
+// PRURL returns the URL of a PR, using the default template if none is set
+func (l LinkTemplates) PRURL(number int) string {
+	return renderLink(l.PR, DefaultLinkTemplates().PR, linkData{Number: number})
+}
+
+// IssueURL returns the URL of an issue, using the default template if none is set
+func (l LinkTemplates) IssueURL(number int) string {
+	return renderLink(l.Issue, DefaultLinkTemplates().Issue, linkData{Number: number})
+}
+
+// AuthorURL returns the URL of an author, using the default template if none is set
+func (l LinkTemplates) AuthorURL(author string) string {
+	return renderLink(l.Author, DefaultLinkTemplates().Author, linkData{Author: author})
+}
+
+// DeletedAuthorPlaceholder returns the placeholder of deleted author
+// accounts, using the default if none is set
+func (l LinkTemplates) DeletedAuthorPlaceholder() string {
+	if l.DeletedAuthor == "" {
+		return DefaultDeletedAuthor
+	}
+	return l.DeletedAuthor
+}
+
+// Validate checks that the link templates can be rendered
+func (l LinkTemplates) Validate() error {
+	for _, link := range []struct{ name, tmpl string }{{"pr", l.PR}, {"issue", l.Issue}, {"author", l.Author}} {
+		if _, err := executeLink(link.tmpl, linkData{Number: 1, Author: "author"}); err != nil {
+			return fmt.Errorf("invalid %s link template: %w", link.name, err)
+		}
+	}
+	if strings.ContainsAny(l.DeletedAuthor, "[]()\n") {
+		return fmt.Errorf("the deleted_author placeholder must be plain text on a single line, got: %q", l.DeletedAuthor)
+	}
+	return nil
+}
+
+func renderLink(tmpl, def string, data linkData) string {
+	if tmpl == "" {
+		tmpl = def
+	}
+	url, err := executeLink(tmpl, data)
+	if err != nil {
+		// Templates are validated when loading the config
+		url, _ = executeLink(def, data)
+	}
+	return url
+}
+
+func executeLink(tmpl string, data linkData) (string, error) {
+	t, err := template.New("link").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := t.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// Thresholds configures which entries are included based on their include_score
+type Thresholds struct {
+	// Include is the include_score from which entries are included normally
+	Include int `yaml:"include"`
+	// Optional is the include_score from which entries are included with the *OPTIONAL* prefix
+	Optional int `yaml:"optional"`
+}
+
+// Validate checks that the thresholds are within [0, 100] and consistent
+func (t Thresholds) Validate() error {
+	if t.Optional < 0 || t.Include > 100 {
+		return fmt.Errorf("score thresholds must be between 0 and 100")
+	}
+	if t.Optional > t.Include {
+		return fmt.Errorf("optional score threshold (%d) must not be greater than include score threshold (%d)", t.Optional, t.Include)
+	}
+	return nil
+}
+
+// GuardrailAction is what happens when the number of entries of a category
+// violates a guardrail
+type GuardrailAction string
+
+const (
+	// GuardrailActionWarn logs a warning (the default)
+	GuardrailActionWarn GuardrailAction = "warn"
+	// GuardrailActionFail fails the run before merging or publishing the CHANGELOG
+	GuardrailActionFail GuardrailAction = "fail"
+)
+
+// EntryLimits are the expected minimum and maximum numbers of entries of a
+// category (nil for no limit)
+type EntryLimits struct {
+	Min *int `yaml:"min,omitempty"`
+	Max *int `yaml:"max,omitempty"`
+}
+
+// StyleProfile selects the wording conventions of the entry descriptions, so
+// that sub-projects with different release-note styles can share the engine
+type StyleProfile string
+
+const (
+	// StyleAntreaClassic is the style of the Antrea CHANGELOGs: descriptions
+	// in the imperative mood ("Fix ...") (the default)
+	StyleAntreaClassic StyleProfile = "antrea-classic"
+	// StyleKubernetes is the style of the Kubernetes release notes:
+	// descriptions in the past tense ("Fixed ...") and inline links
+	StyleKubernetes StyleProfile = "kubernetes-style"
+)
+
+// ParseStyleProfile parses a style profile name
+func ParseStyleProfile(s string) (StyleProfile, error) {
+	switch style := StyleProfile(s); style {
+	case StyleAntreaClassic, StyleKubernetes:
+		return style, nil
+	}
+	return "", fmt.Errorf("invalid style %q, must be one of: %s, %s", s, StyleAntreaClassic, StyleKubernetes)
+}
+
+// Guardrails configures the expected numbers of entries per category, to catch
+// classification drift or scoping mistakes (e.g. ADDED entries in a patch
+// release)
+type Guardrails struct {
+	// Action is warn or fail
+	Action GuardrailAction `yaml:"action,omitempty"`
+	// Minor sets the limits of minor releases (X.Y.0), by category name
+	Minor map[string]EntryLimits `yaml:"minor,omitempty"`
+	// Patch sets the limits of patch releases (X.Y.Z with Z > 0), by category name
+	Patch map[string]EntryLimits `yaml:"patch,omitempty"`
+}
+
+// BackportException records a feature which was intentionally backported to
+// older release branches, despite features not landing in patch releases
+type BackportException struct {
+	// PR is the number of the original PR (cherry-picks are mapped to it)
+	PR int `yaml:"pr"`
+	// Releases are the minor releases (X.Y) the feature was backported to
+	Releases []string `yaml:"releases"`
+}
+
+// SignOff is an area whose owners sign off a release on the release tracking
+// issue
+type SignOff struct {
+	// Area is the name of the area, e.g. network-policy
+	Area string `yaml:"area"`
+	// Owners are the owners of the area (@user or @org/team), any of whom can
+	// sign it off
+	Owners []string `yaml:"owners"`
+}
+
+// Config is the optional configuration file for the releaser
+type Config struct {
+	// Categories lists the categories in rendering order. Categories which
+	// are omitted are not rendered.
+	Categories []Category `yaml:"categories,omitempty"`
+	// Thresholds sets the include_score thresholds
+	Thresholds Thresholds `yaml:"thresholds"`
+	// MaxDescriptionLength is the maximum length of single-sentence entry
+	// descriptions (0 for no limit)
+	MaxDescriptionLength int `yaml:"max_description_length"`
+	// MinDescriptionConfidence is the description_confidence below which the
+	// PR title is used instead of the model's description (0 to disable)
+	MinDescriptionConfidence int `yaml:"min_description_confidence"`
+	// YankedReleases lists yanked releases (X.Y.Z). They are skipped when
+	// calculating the from-release, so that their changes are folded into the
+	// next release, and their section is annotated with [YANKED] when merging.
+	YankedReleases []string `yaml:"yanked_releases,omitempty"`
+	// BuildPaths are the patterns of build files (build matrices, Dockerfiles)
+	// listed in the prompt with --platform-hints, matched like callout paths
+	BuildPaths []string `yaml:"build_paths,omitempty"`
+	// DocsPaths are the patterns of documentation files, matched like callout
+	// paths. PRs which only change such files are handled with --docs-only.
+	DocsPaths []string `yaml:"docs_paths,omitempty"`
+	// NotableDependencies are the dependencies whose bot upgrade PRs are kept
+	// with --notable-deps
+	NotableDependencies []NotableDependency `yaml:"notable_dependencies,omitempty"`
+	// Windows enables a callout for Windows-specific entries (default: disabled)
+	Windows *Callout `yaml:"windows,omitempty"`
+	// Links sets the URL templates of PR, issue and author links, for mirrors
+	// of Antrea which are not hosted on GitHub
+	Links LinkTemplates `yaml:"links"`
+	// Guardrails sets the expected numbers of entries per category
+	Guardrails Guardrails `yaml:"guardrails"`
+	// BackportExceptions lists the features which were intentionally
+	// backported. Their entries are rendered with a "(backported to X.Y)"
+	// suffix, and are acknowledged ADDED entries in patch releases of these
+	// branches.
+	BackportExceptions []BackportException `yaml:"backport_exceptions,omitempty"`
+	// Polite sets the limits of --polite, for runs using a shared GitHub token
+	Polite Polite `yaml:"polite"`
+	// Style is the style profile of the descriptions, which sets both the
+	// prompt instructions and the post-processing of the model's descriptions
+	Style StyleProfile `yaml:"style"`
+	// TelemetryEndpoint is the URL the anonymized metrics of each run are
+	// posted to. Telemetry is disabled when empty (the default).
+	TelemetryEndpoint string `yaml:"telemetry_endpoint,omitempty"`
+	// SignOffs lists the areas whose owners sign off each release, in the
+	// order of the sign-off checklist
+	SignOffs []SignOff `yaml:"sign_offs,omitempty"`
+}
+
+// DefaultCategories returns the default categories, in the order used by Antrea CHANGELOGs
+func DefaultCategories() []Category {
+	return []Category{
+		{Name: "ADDED", Header: "Added"},
+		{Name: "CHANGED", Header: "Changed"},
+		{Name: "FIXED", Header: "Fixed"},
+	}
+}
+
+// DefaultWindowsCallout returns the default callout for Windows-specific entries
+func DefaultWindowsCallout() *Callout {
+	return &Callout{
+		Header: "Windows",
+		Labels: []string{"area/OS/windows"},
+		Paths:  []string{"*windows*"},
+	}
+}
+
+// DefaultBuildPaths returns the default patterns of build files
+func DefaultBuildPaths() []string {
+	return []string{"Dockerfile*", "*.Dockerfile", ".github/workflows/*.yml", ".github/workflows/*.yaml"}
+}
+
+// DefaultDocsPaths returns the default patterns of documentation files
+func DefaultDocsPaths() []string {
+	return []string{"docs", "*.md", "*.png", "*.svg"}
+}
+
+// DefaultNotableDependencies returns the default dependencies whose upgrades
+// matter to users
+func DefaultNotableDependencies() []NotableDependency {
+	return []NotableDependency{
+		{Name: "Open vSwitch", Keywords: []string{"Open vSwitch", "OVS"}, Paths: []string{"build/images/deps/ovs-version"}},
+		{Name: "CNI plugins", Keywords: []string{"CNI plugins", "containernetworking/plugins"}, Paths: []string{"build/images/deps/cni-binaries-version"}},
+		{Name: "Go", Keywords: []string{"Go version", "golang Docker tag", "dependency go"}, Paths: []string{"build/images/deps/go-version"}},
+	}
+}
+
+// DefaultMinDescriptionConfidence is the default description_confidence below
+// which the PR title is used instead of the model's description
+const DefaultMinDescriptionConfidence = 30
+
+// DefaultThresholds returns the default include_score thresholds
+func DefaultThresholds() Thresholds {
+	return Thresholds{Include: 50, Optional: 25}
+}
+
+// Default returns the default configuration
+func Default() *Config {
+	return &Config{
+		Categories:               DefaultCategories(),
+		Thresholds:               DefaultThresholds(),
+		MinDescriptionConfidence: DefaultMinDescriptionConfidence,
+		BuildPaths:               DefaultBuildPaths(),
+		DocsPaths:                DefaultDocsPaths(),
+		NotableDependencies:      DefaultNotableDependencies(),
+		Links:                    DefaultLinkTemplates(),
+		Guardrails:               Guardrails{Action: GuardrailActionWarn},
+		Polite:                   DefaultPolite(),
+		Style:                    StyleAntreaClassic,
+	}
+}
+
+// Load reads a configuration file, applying defaults for omitted settings
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses configuration data, applying defaults for omitted settings
+func Parse(data []byte) (*Config, error) {
+	// Decode on top of the defaults so that omitted scalar settings keep their default value
+	cfg := Default()
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if err := cfg.complete(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+func (c *Config) complete() error {
+	if len(c.Categories) == 0 {
+		c.Categories = DefaultCategories()
+	}
+	seen := make(map[string]bool)
+	for i := range c.Categories {
+		cat := &c.Categories[i]
+		cat.Name = strings.ToUpper(strings.TrimSpace(cat.Name))
+		if !isModelCategory(cat.Name) {
+			return fmt.Errorf("unknown category %q, must be one of: %s", cat.Name, strings.Join(ModelCategories, ", "))
+		}
+		if seen[cat.Name] {
+			return fmt.Errorf("duplicate category %q", cat.Name)
+		}
+		seen[cat.Name] = true
+		if cat.Header == "" {
+			cat.Header = strings.ToUpper(cat.Name[:1]) + strings.ToLower(cat.Name[1:])
+		}
+		if cat.MaxEntries < 0 {
+			return fmt.Errorf("max_entries of category %s must not be negative", cat.Name)
+		}
+		seenSubcategories := make(map[string]bool)
+		for i := range cat.Subcategories {
+			sub := &cat.Subcategories[i]
+			sub.Name = strings.TrimSpace(sub.Name)
+			if sub.Name == "" {
+				return fmt.Errorf("subcategory without a name in category %s", cat.Name)
+			}
+			if seenSubcategories[strings.ToLower(sub.Name)] {
+				return fmt.Errorf("duplicate subcategory %q in category %s", sub.Name, cat.Name)
+			}
+			seenSubcategories[strings.ToLower(sub.Name)] = true
+		}
+	}
+	if c.Windows != nil {
+		def := DefaultWindowsCallout()
+		if c.Windows.Header == "" {
+			c.Windows.Header = def.Header
+		}
+		if len(c.Windows.Labels) == 0 && len(c.Windows.Paths) == 0 {
+			c.Windows.Labels = def.Labels
+			c.Windows.Paths = def.Paths
+		}
+		for _, cat := range c.Categories {
+			if strings.EqualFold(cat.Header, c.Windows.Header) {
+				return fmt.Errorf("windows callout header %q conflicts with category %s", c.Windows.Header, cat.Name)
+			}
+		}
+	}
+	for _, v := range c.YankedReleases {
+		if _, err := semver.StrictNewVersion(v); err != nil {
+			return fmt.Errorf("invalid yanked release %q, must be X.Y.Z", v)
+		}
+	}
+	seenPRs := make(map[int]bool)
+	for _, b := range c.BackportExceptions {
+		if b.PR <= 0 {
+			return fmt.Errorf("invalid backport exception PR number %d", b.PR)
+		}
+		if seenPRs[b.PR] {
+			return fmt.Errorf("duplicate backport exception for PR #%d", b.PR)
+		}
+		seenPRs[b.PR] = true
+		if len(b.Releases) == 0 {
+			return fmt.Errorf("backport exception for PR #%d has no releases", b.PR)
+		}
+		for _, r := range b.Releases {
+			if _, err := semver.StrictNewVersion(r + ".0"); err != nil {
+				return fmt.Errorf("invalid backport exception release %q for PR #%d, must be X.Y", r, b.PR)
+			}
+		}
+	}
+	for _, d := range c.NotableDependencies {
+		if d.Name == "" {
+			return fmt.Errorf("notable dependency without a name")
+		}
+		if len(d.Keywords) == 0 && len(d.Paths) == 0 {
+			return fmt.Errorf("notable dependency %s has no keywords or paths", d.Name)
+		}
+	}
+	seenAreas := make(map[string]bool)
+	for _, so := range c.SignOffs {
+		if strings.TrimSpace(so.Area) == "" {
+			return fmt.Errorf("sign-off without an area")
+		}
+		if seenAreas[strings.ToLower(so.Area)] {
+			return fmt.Errorf("duplicate sign-off area %q", so.Area)
+		}
+		seenAreas[strings.ToLower(so.Area)] = true
+		if len(so.Owners) == 0 {
+			return fmt.Errorf("sign-off area %s has no owners", so.Area)
+		}
+		for _, owner := range so.Owners {
+			if len(owner) < 2 || !strings.HasPrefix(owner, "@") || strings.ContainsAny(owner, " \t") {
+				return fmt.Errorf("invalid owner %q of sign-off area %s, expected @user or @org/team", owner, so.Area)
+			}
+		}
+	}
+	if c.MaxDescriptionLength < 0 {
+		return fmt.Errorf("max_description_length must not be negative")
+	}
+	if c.MinDescriptionConfidence < 0 || c.MinDescriptionConfidence > 100 {
+		return fmt.Errorf("min_description_confidence must be between 0 and 100")
+	}
+	if err := c.Links.Validate(); err != nil {
+		return err
+	}
+	if err := c.Guardrails.complete(); err != nil {
+		return err
+	}
+	if err := c.Polite.Validate(); err != nil {
+		return err
+	}
+	if _, err := ParseStyleProfile(string(c.Style)); err != nil {
+		return err
+	}
+	if err := ValidateTelemetryEndpoint(c.TelemetryEndpoint); err != nil {
+		return err
+	}
+	return c.Thresholds.Validate()
+}
+
+// ValidateTelemetryEndpoint checks that a telemetry endpoint is empty
+// (telemetry disabled) or an http(s) URL
+func ValidateTelemetryEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid telemetry endpoint %q, must be an http(s) URL", endpoint)
+	}
+	return nil
+}
+
+func (g *Guardrails) complete() error {
+	switch g.Action {
+	case "":
+		g.Action = GuardrailActionWarn
+	case GuardrailActionWarn, GuardrailActionFail:
+	default:
+		return fmt.Errorf("invalid guardrails action %q, must be one of: %s, %s", g.Action, GuardrailActionWarn, GuardrailActionFail)
+	}
+	for _, kind := range []struct {
+		name   string
+		limits *map[string]EntryLimits
+	}{{"minor", &g.Minor}, {"patch", &g.Patch}} {
+		if *kind.limits == nil {
+			continue
+		}
+		normalized := make(map[string]EntryLimits)
+		for name, l := range *kind.limits {
+			category := strings.ToUpper(strings.TrimSpace(name))
+			if !isModelCategory(category) {
+				return fmt.Errorf("unknown category %q in %s guardrails, must be one of: %s", name, kind.name, strings.Join(ModelCategories, ", "))
+			}
+			if _, ok := normalized[category]; ok {
+				return fmt.Errorf("duplicate category %q in %s guardrails", category, kind.name)
+			}
+			if (l.Min != nil && *l.Min < 0) || (l.Max != nil && *l.Max < 0) {
+				return fmt.Errorf("%s guardrails of %s must not be negative", kind.name, category)
+			}
+			if l.Min != nil && l.Max != nil && *l.Min > *l.Max {
+				return fmt.Errorf("%s guardrails of %s: min (%d) must not be greater than max (%d)", kind.name, category, *l.Min, *l.Max)
+			}
+			normalized[category] = l
+		}
+		*kind.limits = normalized
+	}
+	return nil
+}
+
+func isModelCategory(name string) bool {
+	for _, c := range ModelCategories {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
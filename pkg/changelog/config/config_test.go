@@ -0,0 +1,192 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Defaults(t *testing.T) {
+	cfg, err := Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+}
+
+func TestParse_Categories(t *testing.T) {
+	cfg, err := Parse([]byte(`
+categories:
+  - name: fixed
+    header: Bug Fixes
+  - name: ADDED
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []Category{
+		{Name: "FIXED", Header: "Bug Fixes"},
+		{Name: "ADDED", Header: "Added"},
+	}, cfg.Categories)
+}
+
+func TestParse_Subcategories(t *testing.T) {
+	cfg, err := Parse([]byte(`
+categories:
+  - name: ADDED
+    subcategories:
+      - name: Networking
+        description: Pod networking, Services and Egress
+      - name: " Windows "
+  - name: FIXED
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []Subcategory{
+		{Name: "Networking", Description: "Pod networking, Services and Egress"},
+		{Name: "Windows"},
+	}, cfg.Categories[0].Subcategories)
+	assert.Empty(t, cfg.Categories[1].Subcategories)
+}
+
+func TestParse_Thresholds(t *testing.T) {
+	cfg, err := Parse([]byte(`
+thresholds:
+  include: 70
+`))
+	require.NoError(t, err)
+	assert.Equal(t, Thresholds{Include: 70, Optional: 25}, cfg.Thresholds, "Omitted threshold should keep its default")
+	assert.Equal(t, DefaultCategories(), cfg.Categories)
+}
+
+func TestParse_WindowsCallout(t *testing.T) {
+	cfg, err := Parse([]byte("windows: {}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultWindowsCallout(), cfg.Windows, "Empty callout should use the defaults")
+
+	cfg, err = Parse([]byte(`
+windows:
+  header: Windows Nodes
+  labels: [area/OS/windows, area/windows]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &Callout{Header: "Windows Nodes", Labels: []string{"area/OS/windows", "area/windows"}}, cfg.Windows)
+}
+
+func TestParse_LinkTemplates(t *testing.T) {
+	cfg, err := Parse([]byte(`
+links:
+  pr: https://gitlab.example.com/antrea/antrea/-/merge_requests/{{.Number}}
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.example.com/antrea/antrea/-/merge_requests/12", cfg.Links.PRURL(12))
+	assert.Equal(t, "https://github.com/antrea-io/antrea/issues/34", cfg.Links.IssueURL(34), "Omitted template should keep its default")
+	assert.Equal(t, "https://github.com/alice", cfg.Links.AuthorURL("alice"))
+
+	assert.Equal(t, "https://github.com/antrea-io/antrea/pull/12", LinkTemplates{}.PRURL(12), "Empty template should use the default")
+}
+
+func TestParse_Guardrails(t *testing.T) {
+	cfg, err := Parse([]byte(`
+guardrails:
+  action: fail
+  minor:
+    added: {min: 5}
+  patch:
+    ADDED: {max: 0}
+`))
+	require.NoError(t, err)
+	minAdded, maxAdded := 5, 0
+	assert.Equal(t, Guardrails{
+		Action: GuardrailActionFail,
+		Minor:  map[string]EntryLimits{"ADDED": {Min: &minAdded}},
+		Patch:  map[string]EntryLimits{"ADDED": {Max: &maxAdded}},
+	}, cfg.Guardrails)
+}
+
+func TestParse_BackportExceptions(t *testing.T) {
+	cfg, err := Parse([]byte(`
+backport_exceptions:
+  - pr: 7123
+    releases: ["2.4", "2.3"]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []BackportException{{PR: 7123, Releases: []string{"2.4", "2.3"}}}, cfg.BackportExceptions)
+}
+
+func TestParse_TelemetryEndpoint(t *testing.T) {
+	cfg, err := Parse([]byte("telemetry_endpoint: https://telemetry.example.com/runs\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://telemetry.example.com/runs", cfg.TelemetryEndpoint)
+	assert.NoError(t, ValidateTelemetryEndpoint(""))
+}
+
+func TestParse_SignOffs(t *testing.T) {
+	cfg, err := Parse([]byte(`
+sign_offs:
+  - area: network-policy
+    owners: ["@alice", "@antrea-io/network-policy-maintainers"]
+  - area: egress
+    owners: ["@bob"]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []SignOff{
+		{Area: "network-policy", Owners: []string{"@alice", "@antrea-io/network-policy-maintainers"}},
+		{Area: "egress", Owners: []string{"@bob"}},
+	}, cfg.SignOffs)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"unknown category":      "categories:\n  - name: REMOVED\n",
+		"duplicate":             "categories:\n  - name: ADDED\n  - name: added\n",
+		"negative max entries":  "categories:\n  - name: FIXED\n    max_entries: -1\n",
+		"unnamed subcategory":   "categories:\n  - name: ADDED\n    subcategories:\n      - description: Pod networking\n",
+		"duplicate subcategory": "categories:\n  - name: ADDED\n    subcategories:\n      - name: Windows\n      - name: windows\n",
+		"unknown field":         "categorys: []\n",
+		"inverted scores":       "thresholds:\n  include: 20\n  optional: 40\n",
+		"score too high":        "thresholds:\n  include: 120\n",
+		"negative length":       "max_description_length: -1\n",
+		"confidence too high":   "min_description_confidence: 101\n",
+		"callout conflict":      "windows:\n  header: Fixed\n",
+		"invalid yanked":        "yanked_releases: [v2.4.1]\n",
+		"invalid link":          "links:\n  pr: https://example.com/{{.Number\n",
+		"unknown field in link": "links:\n  author: https://example.com/{{.Name}}\n",
+		"linked deleted author": "links:\n  deleted_author: \"[@ghost]\"\n",
+		"guardrail action":      "guardrails:\n  action: abort\n",
+		"guardrail category":    "guardrails:\n  minor:\n    REMOVED: {min: 1}\n",
+		"inverted guardrail":    "guardrails:\n  patch:\n    FIXED: {min: 5, max: 1}\n",
+		"negative guardrail":    "guardrails:\n  patch:\n    FIXED: {max: -1}\n",
+		"backport release":      "backport_exceptions:\n  - pr: 1\n    releases: [2.4.1]\n",
+		"backport no release":   "backport_exceptions:\n  - pr: 1\n",
+		"duplicate backport":    "backport_exceptions:\n  - pr: 1\n    releases: [\"2.4\"]\n  - pr: 1\n    releases: [\"2.3\"]\n",
+		"unnamed dependency":    "notable_dependencies:\n  - keywords: [OVS]\n",
+		"dependency no match":   "notable_dependencies:\n  - name: OVS\n",
+		"polite rate":           "polite:\n  requests_per_minute: 0\n",
+		"polite reserve":        "polite:\n  rate_limit_reserve: 100\n",
+		"polite off-peak":       "polite:\n  off_peak: \"22:00\"\n",
+		"unknown style":         "style: gnome-style\n",
+		"telemetry scheme":      "telemetry_endpoint: ftp://telemetry.example.com\n",
+		"telemetry no host":     "telemetry_endpoint: /metrics\n",
+		"sign-off no area":      "sign_offs:\n  - owners: [\"@alice\"]\n",
+		"sign-off no owners":    "sign_offs:\n  - area: egress\n",
+		"sign-off owner":        "sign_offs:\n  - area: egress\n    owners: [alice]\n",
+		"duplicate sign-off":    "sign_offs:\n  - area: egress\n    owners: [\"@alice\"]\n  - area: Egress\n    owners: [\"@bob\"]\n",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
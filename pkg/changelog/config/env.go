@@ -0,0 +1,83 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+
+	"github.com/joho/godotenv"
+)
+
+const (
+	// DefaultEnvFile is the environment file loaded by default, if it exists
+	DefaultEnvFile = ".env"
+	// ConfigEnvVar sets the default configuration file, e.g. in a profile
+	ConfigEnvVar = "CHANGELOG_CONFIG"
+)
+
+// profileRegex matches valid profile names
+var profileRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ProfileEnvFile returns the environment file of a profile, e.g. .env.release-infra
+func ProfileEnvFile(envFile, profile string) string {
+	return envFile + "." + profile
+}
+
+// LoadEnv loads the environment variables (tokens, API keys and defaults such
+// as CHANGELOG_CONFIG) of an environment file and of a profile, so that
+// release managers can switch between e.g. personal and bot credentials. The
+// profile's file takes precedence over the environment file, and variables
+// which are already set take precedence over both. The default environment
+// file is optional, other files must exist.
+func LoadEnv(envFile, profile string) error {
+	if profile != "" {
+		if !profileRegex.MatchString(profile) {
+			return fmt.Errorf("invalid profile name %q", profile)
+		}
+		profileFile := ProfileEnvFile(envFile, profile)
+		if err := godotenv.Load(profileFile); err != nil {
+			return fmt.Errorf("failed to load profile %s from %s: %w", profile, profileFile, err)
+		}
+	}
+	if err := godotenv.Load(envFile); err != nil {
+		if envFile == DefaultEnvFile && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to load environment file %s: %w", envFile, err)
+	}
+	return nil
+}
+
+// ConfigFile returns the configuration file to use: the flag value if set,
+// otherwise the value of CHANGELOG_CONFIG, otherwise config.yaml in the
+// working directory (see EnterWorkdir), if any
+func ConfigFile(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	if file := os.Getenv(ConfigEnvVar); file != "" {
+		return file
+	}
+	if os.Getenv(WorkdirEnvVar) != "" {
+		if _, err := os.Stat(WorkdirConfigFile); err == nil {
+			return WorkdirConfigFile
+		}
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadEnv(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, ".env")
+	require.NoError(t, os.WriteFile(envFile, []byte("TEST_TOKEN=default\nTEST_KEY=default\nTEST_SET=default\n"), 0o644))
+	require.NoError(t, os.WriteFile(ProfileEnvFile(envFile, "release-infra"), []byte("TEST_TOKEN=profile\n"), 0o644))
+
+	for _, name := range []string{"TEST_TOKEN", "TEST_KEY"} {
+		t.Setenv(name, "")
+		require.NoError(t, os.Unsetenv(name))
+	}
+	t.Setenv("TEST_SET", "environment")
+
+	require.NoError(t, LoadEnv(envFile, "release-infra"))
+	assert.Equal(t, "profile", os.Getenv("TEST_TOKEN"))
+	assert.Equal(t, "default", os.Getenv("TEST_KEY"))
+	assert.Equal(t, "environment", os.Getenv("TEST_SET"))
+}
+
+func TestLoadEnv_Errors(t *testing.T) {
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, ".env")
+	require.NoError(t, os.WriteFile(envFile, nil, 0o644))
+
+	assert.ErrorContains(t, LoadEnv(filepath.Join(dir, "missing.env"), ""), "failed to load environment file")
+	assert.ErrorContains(t, LoadEnv(envFile, "personal"), "failed to load profile personal")
+	assert.ErrorContains(t, LoadEnv(envFile, "../personal"), "invalid profile name")
+}
+
+func TestConfigFile(t *testing.T) {
+	t.Setenv(ConfigEnvVar, "release.yaml")
+	assert.Equal(t, "flag.yaml", ConfigFile("flag.yaml"))
+	assert.Equal(t, "release.yaml", ConfigFile(""))
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// Polite configures --polite, which limits the load put on a GitHub token
+// shared with other automation
+type Polite struct {
+	// RequestsPerMinute caps the rate of GitHub requests
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	// MaxConcurrency caps the number of GitHub requests in flight
+	MaxConcurrency int `yaml:"max_concurrency"`
+	// RateLimitReserve is the percentage of the rate limit of the token left
+	// to other automation: requests pause until the rate limit resets when
+	// fewer requests remain
+	RateLimitReserve int `yaml:"rate_limit_reserve"`
+	// OffPeak is the off-peak window (HH:MM-HH:MM, UTC) the run waits for
+	// before fetching from GitHub (empty to start right away)
+	OffPeak string `yaml:"off_peak,omitempty"`
+}
+
+// DefaultPolite returns the default settings of --polite
+func DefaultPolite() Polite {
+	return Polite{RequestsPerMinute: 30, MaxConcurrency: 1, RateLimitReserve: 25}
+}
+
+// Validate checks that the polite settings are consistent
+func (p Polite) Validate() error {
+	if p.RequestsPerMinute <= 0 {
+		return fmt.Errorf("polite requests_per_minute must be positive")
+	}
+	if p.MaxConcurrency <= 0 {
+		return fmt.Errorf("polite max_concurrency must be positive")
+	}
+	if p.RateLimitReserve < 0 || p.RateLimitReserve >= 100 {
+		return fmt.Errorf("polite rate_limit_reserve must be between 0 and 99")
+	}
+	if p.OffPeak != "" {
+		if _, err := ParseOffPeak(p.OffPeak); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OffPeakWindow is a daily time window in UTC, which may span midnight
+type OffPeakWindow struct {
+	// Start and End are offsets from midnight
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseOffPeak parses an off-peak window: HH:MM-HH:MM, in UTC
+func ParseOffPeak(s string) (OffPeakWindow, error) {
+	var startH, startM, endH, endM int
+	if n, err := fmt.Sscanf(s, "%d:%d-%d:%d", &startH, &startM, &endH, &endM); err != nil || n != 4 ||
+		startH < 0 || startH > 23 || endH < 0 || endH > 23 || startM < 0 || startM > 59 || endM < 0 || endM > 59 {
+		return OffPeakWindow{}, fmt.Errorf("invalid off-peak window %q, must be HH:MM-HH:MM", s)
+	}
+	w := OffPeakWindow{
+		Start: time.Duration(startH)*time.Hour + time.Duration(startM)*time.Minute,
+		End:   time.Duration(endH)*time.Hour + time.Duration(endM)*time.Minute,
+	}
+	if w.Start == w.End {
+		return OffPeakWindow{}, fmt.Errorf("invalid off-peak window %q, start and end must differ", s)
+	}
+	return w, nil
+}
+
+// Next returns now if it is within the window, or the next start of the window
+func (w OffPeakWindow) Next(now time.Time) time.Time {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	offset := now.Sub(midnight)
+	inWindow := offset >= w.Start && offset < w.End
+	if w.Start > w.End {
+		// The window spans midnight
+		inWindow = offset >= w.Start || offset < w.End
+	}
+	if inWindow {
+		return now
+	}
+	start := midnight.Add(w.Start)
+	if !start.After(now) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
+}
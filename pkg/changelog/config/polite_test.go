@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Polite(t *testing.T) {
+	cfg, err := Parse([]byte("polite:\n  requests_per_minute: 10\n  off_peak: \"22:00-06:30\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, Polite{RequestsPerMinute: 10, MaxConcurrency: 1, RateLimitReserve: 25, OffPeak: "22:00-06:30"}, cfg.Polite)
+}
+
+func TestOffPeakWindow_Next(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 30, hour, minute, 0, 0, time.UTC)
+	}
+
+	night, err := ParseOffPeak("22:00-06:30")
+	require.NoError(t, err)
+	assert.Equal(t, at(23, 0), night.Next(at(23, 0)), "within the window before midnight")
+	assert.Equal(t, at(5, 0), night.Next(at(5, 0)), "within the window after midnight")
+	assert.Equal(t, at(22, 0), night.Next(at(12, 0)))
+
+	day, err := ParseOffPeak("01:00-03:00")
+	require.NoError(t, err)
+	assert.Equal(t, at(2, 0), day.Next(at(2, 0)))
+	assert.Equal(t, at(1, 0), day.Next(at(0, 15)))
+	assert.Equal(t, at(1, 0).AddDate(0, 0, 1), day.Next(at(3, 0)), "the end of the window is excluded")
+
+	for _, s := range []string{"22:00", "25:00-06:00", "22:00-22:00", "night"} {
+		_, err := ParseOffPeak(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const (
+	// CredentialHelperEnvVar sets the default credential helper, e.g. in a profile
+	CredentialHelperEnvVar = "CHANGELOG_CREDENTIAL_HELPER"
+	// CredentialHelperKeychain selects the OS keychain as credential helper
+	CredentialHelperKeychain = "keychain"
+	// KeychainService is the keychain service the secrets are stored under
+	KeychainService = "antrea-releaser"
+)
+
+// SecretEnvVars are the environment variables which may be read from a
+// credential helper
+var SecretEnvVars = []string{"GOOGLE_API_KEY", "MODEL_API_KEY", "GITHUB_TOKEN"}
+
+// CredentialHelper returns the credential helper to use: the flag value if
+// set, otherwise the value of CHANGELOG_CREDENTIAL_HELPER, if any
+func CredentialHelper(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	return os.Getenv(CredentialHelperEnvVar)
+}
+
+// LoadSecrets sets the secret environment variables which are not already set
+// (e.g. from an environment file) from a credential helper, so that they do not
+// need to be stored in plaintext. The helper is either "keychain", for the macOS
+// Keychain (security) or the Secret Service (secret-tool) on Linux, or a command
+// which is run with the "get <NAME>" arguments and prints the secret to stdout,
+// or nothing if it does not have it.
+func LoadSecrets(ctx context.Context, helper string, names ...string) error {
+	if helper == "" {
+		return nil
+	}
+	for _, name := range names {
+		if os.Getenv(name) != "" {
+			continue
+		}
+		var value string
+		var err error
+		if helper == CredentialHelperKeychain {
+			value, err = keychainSecret(ctx, runtime.GOOS, name)
+		} else {
+			value, err = helperSecret(ctx, helper, name)
+		}
+		if err != nil {
+			return err
+		}
+		if value == "" {
+			continue
+		}
+		if err := os.Setenv(name, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// helperSecret runs a credential helper command to get a secret
+func helperSecret(ctx context.Context, helper, name string) (string, error) {
+	args := strings.Fields(helper)
+	if len(args) == 0 {
+		return "", fmt.Errorf("invalid credential helper %q: no command", helper)
+	}
+	cmd := exec.CommandContext(ctx, args[0], append(args[1:], "get", name)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s from credential helper %s: %w: %s", name, args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// keychainCommand returns the command reading a secret from the OS keychain
+func keychainCommand(goos, name string) ([]string, error) {
+	switch goos {
+	case "darwin":
+		return []string{"security", "find-generic-password", "-s", KeychainService, "-a", name, "-w"}, nil
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return []string{"secret-tool", "lookup", "service", KeychainService, "account", name}, nil
+	default:
+		return nil, fmt.Errorf("keychain credential helper is not supported on %s, use a credential helper command instead", goos)
+	}
+}
+
+// keychainSecret reads a secret from the OS keychain, a missing secret is not
+// an error
+func keychainSecret(ctx context.Context, goos, name string) (string, error) {
+	args, err := keychainCommand(goos, name)
+	if err != nil {
+		return "", err
+	}
+	out, err := exec.CommandContext(ctx, args[0], args[1:]...).Output()
+	if err != nil {
+		// Both tools exit with a non-zero status when the secret is missing
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get %s from the keychain: %w", name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSecrets(t *testing.T) {
+	helper := filepath.Join(t.TempDir(), "helper.sh")
+	require.NoError(t, os.WriteFile(helper, []byte(`#!/bin/sh
+[ "$1" = get ] || exit 2
+case "$2" in
+GITHUB_TOKEN) echo helper-token ;;
+GOOGLE_API_KEY) echo helper-key ;;
+esac
+`), 0o755))
+
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GOOGLE_API_KEY", "env-key")
+	t.Setenv("MODEL_API_KEY", "")
+
+	require.NoError(t, LoadSecrets(context.Background(), helper, SecretEnvVars...))
+	assert.Equal(t, "helper-token", os.Getenv("GITHUB_TOKEN"))
+	assert.Equal(t, "env-key", os.Getenv("GOOGLE_API_KEY"), "variables already set take precedence")
+	assert.Empty(t, os.Getenv("MODEL_API_KEY"))
+}
+
+func TestLoadSecrets_HelperError(t *testing.T) {
+	helper := filepath.Join(t.TempDir(), "helper.sh")
+	require.NoError(t, os.WriteFile(helper, []byte("#!/bin/sh\necho locked >&2\nexit 1\n"), 0o755))
+	t.Setenv("GITHUB_TOKEN", "")
+
+	err := LoadSecrets(context.Background(), helper, "GITHUB_TOKEN")
+	assert.ErrorContains(t, err, "failed to get GITHUB_TOKEN from credential helper")
+	assert.ErrorContains(t, err, "locked")
+}
+
+func TestLoadSecrets_BlankHelper(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+
+	err := LoadSecrets(context.Background(), " \t", "GITHUB_TOKEN")
+	assert.ErrorContains(t, err, "invalid credential helper")
+}
+
+func TestKeychainCommand(t *testing.T) {
+	args, err := keychainCommand("darwin", "GITHUB_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"security", "find-generic-password", "-s", "antrea-releaser", "-a", "GITHUB_TOKEN", "-w"}, args)
+
+	args, err = keychainCommand("linux", "GITHUB_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secret-tool", "lookup", "service", "antrea-releaser", "account", "GITHUB_TOKEN"}, args)
+
+	_, err = keychainCommand("windows", "GITHUB_TOKEN")
+	assert.ErrorContains(t, err, "not supported on windows")
+}
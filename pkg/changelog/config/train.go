@@ -0,0 +1,96 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Masterminds/semver/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Train lists the releases cut together, e.g. a minor release and the patch
+// releases of the maintained branches, which are generated in a single run
+type Train struct {
+	// Name identifies the train in the name of the summary report (default:
+	// the first release)
+	Name string `yaml:"name,omitempty"`
+	// Releases are generated in order
+	Releases []TrainRelease `yaml:"releases"`
+}
+
+// TrainRelease is a release of a train
+type TrainRelease struct {
+	// Release is the version of the release (X.Y.Z)
+	Release string `yaml:"release"`
+	// FromRelease is the previous release (default: auto-calculated)
+	FromRelease string `yaml:"from_release,omitempty"`
+	// PRBranch is the name of the --create-pr branch (default: changelog-vX.Y.Z)
+	PRBranch string `yaml:"pr_branch,omitempty"`
+}
+
+// LoadTrain reads a release train file
+func LoadTrain(path string) (*Train, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release train file: %w", err)
+	}
+	return ParseTrain(data)
+}
+
+// ParseTrain parses release train data
+func ParseTrain(data []byte) (*Train, error) {
+	var train Train
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&train); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse release train: %w", err)
+	}
+	if err := train.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid release train: %w", err)
+	}
+	if train.Name == "" {
+		train.Name = train.Releases[0].Release
+	}
+	return &train, nil
+}
+
+// Validate checks that the train has releases, which are X.Y.Z versions
+// listed once
+func (t *Train) Validate() error {
+	if len(t.Releases) == 0 {
+		return fmt.Errorf("no releases")
+	}
+	seen := make(map[string]bool)
+	for _, r := range t.Releases {
+		if _, err := semver.StrictNewVersion(r.Release); err != nil {
+			return fmt.Errorf("invalid release %q, must be X.Y.Z", r.Release)
+		}
+		if r.FromRelease != "" {
+			if _, err := semver.StrictNewVersion(r.FromRelease); err != nil {
+				return fmt.Errorf("invalid from_release %q of release %s, must be X.Y.Z", r.FromRelease, r.Release)
+			}
+		}
+		if seen[r.Release] {
+			return fmt.Errorf("release %s is listed more than once", r.Release)
+		}
+		seen[r.Release] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrain(t *testing.T) {
+	train, err := ParseTrain([]byte(`releases:
+  - release: 2.5.0
+  - release: 2.4.3
+    pr_branch: changelog-2.4.3
+  - release: 2.3.5
+    from_release: 2.3.3
+`))
+	require.NoError(t, err)
+	assert.Equal(t, &Train{
+		Name: "2.5.0",
+		Releases: []TrainRelease{
+			{Release: "2.5.0"},
+			{Release: "2.4.3", PRBranch: "changelog-2.4.3"},
+			{Release: "2.3.5", FromRelease: "2.3.3"},
+		},
+	}, train)
+
+	for name, data := range map[string]string{
+		"empty":             "",
+		"unknown field":     "releases:\n  - version: 2.5.0\n",
+		"invalid release":   "releases:\n  - release: v2.5.0\n",
+		"invalid from":      "releases:\n  - release: 2.5.0\n    from_release: \"2.4\"\n",
+		"duplicate release": "releases:\n  - release: 2.5.0\n  - release: 2.5.0\n",
+	} {
+		_, err := ParseTrain([]byte(data))
+		assert.Error(t, err, name)
+	}
+}
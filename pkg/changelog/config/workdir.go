@@ -0,0 +1,60 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// WorkdirEnvVar sets the default working directory, e.g. in a container image
+	WorkdirEnvVar = "CHANGELOG_WORKDIR"
+	// WorkdirConfigFile is the configuration file used by default in a
+	// working directory, if it exists
+	WorkdirConfigFile = "config.yaml"
+)
+
+// EnterWorkdir changes the current directory to the working directory: the
+// flag value if set, otherwise the value of CHANGELOG_WORKDIR, if any. All
+// the relative paths (environment file, configuration file, corrections
+// dataset, output and artifacts) are then resolved in it, so that a container
+// only needs a single mounted directory. It returns the absolute path of the
+// working directory, or "" if none is set.
+func EnterWorkdir(flagValue string) (string, error) {
+	dir := flagValue
+	if dir == "" {
+		dir = os.Getenv(WorkdirEnvVar)
+	}
+	if dir == "" {
+		return "", nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("invalid working directory %s: %w", dir, err)
+	}
+	if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("working directory %s is not a directory, it must be created (or mounted) before the run", dir)
+	}
+	if err := os.Chdir(abs); err != nil {
+		return "", fmt.Errorf("failed to enter working directory %s: %w", dir, err)
+	}
+	// Recorded for ConfigFile
+	if err := os.Setenv(WorkdirEnvVar, abs); err != nil {
+		return "", fmt.Errorf("failed to set %s: %w", WorkdirEnvVar, err)
+	}
+	return abs, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnterWorkdir(t *testing.T) {
+	// Restores the current directory at the end of the test
+	t.Chdir(".")
+	t.Setenv(WorkdirEnvVar, "")
+	t.Setenv(ConfigEnvVar, "")
+
+	dir, err := EnterWorkdir("")
+	require.NoError(t, err)
+	assert.Empty(t, dir, "no working directory by default")
+	assert.Empty(t, ConfigFile(""))
+
+	_, err = EnterWorkdir(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+
+	// Symbolic links (e.g. /tmp on macOS) are not resolved
+	workdir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(workdir, WorkdirConfigFile), []byte("max_description_length: 100\n"), 0644))
+	t.Setenv(WorkdirEnvVar, workdir)
+	dir, err = EnterWorkdir("")
+	require.NoError(t, err)
+	assert.Equal(t, workdir, dir)
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	assert.Equal(t, workdir, cwd)
+
+	assert.Equal(t, WorkdirConfigFile, ConfigFile(""), "config.yaml of the working directory is used by default")
+	t.Setenv(ConfigEnvVar, "other.yaml")
+	assert.Equal(t, "other.yaml", ConfigFile(""))
+	assert.Equal(t, "flag.yaml", ConfigFile("flag.yaml"))
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const (
+	// helmValuesPath is the path of the Helm values of the Antrea chart
+	helmValuesPath = "build/charts/antrea/values.yaml"
+	// agentConfPath is the path of the antrea-agent config template
+	agentConfPath = "build/charts/antrea/conf/antrea-agent.conf"
+	// controllerConfPath is the path of the antrea-controller config template
+	controllerConfPath = "build/charts/antrea/conf/antrea-controller.conf"
+)
+
+// featureGateDefaultRegex extracts the feature gate defaults of a config
+// template: include "featureGate" (dict ... "name" "Multicast" "default" false)
+var featureGateDefaultRegex = regexp.MustCompile(`"name"\s+"(\w+)"\s+"default"\s+(true|false)`)
+
+// configDefaultChange is a changed default of the Helm values or of the
+// agent/controller config templates within the release window
+type configDefaultChange struct {
+	file string
+	key  string
+	from string
+	to   string
+	// mentioned is true if a rendered CHANGED entry mentions the parameter
+	mentioned bool
+}
+
+// String returns a human-readable description of the change
+func (c configDefaultChange) String() string {
+	return fmt.Sprintf("%s: %s changed from %s to %s", c.file, c.key, c.from, c.to)
+}
+
+// parameter returns the name of the changed parameter, i.e. the last element
+// of its key
+func (c configDefaultChange) parameter() string {
+	return c.key[strings.LastIndex(c.key, ".")+1:]
+}
+
+// helmValueDefaults flattens the Helm values into the default of each leaf,
+// by dotted key (e.g. egress.maxEgressIPsPerNode)
+func helmValueDefaults(values string) (map[string]string, error) {
+	var root map[string]any
+	if err := yaml.Unmarshal([]byte(values), &root); err != nil {
+		return nil, fmt.Errorf("failed to parse Helm values: %w", err)
+	}
+	defaults := make(map[string]string)
+	var flatten func(prefix string, m map[string]any)
+	flatten = func(prefix string, m map[string]any) {
+		for k, v := range m {
+			key := prefix + k
+			if child, ok := v.(map[string]any); ok && len(child) > 0 {
+				flatten(key+".", child)
+				continue
+			}
+			defaults[key] = formatDefault(v)
+		}
+	}
+	flatten("", root)
+	return defaults, nil
+}
+
+// formatDefault formats a default value for the prompt and the reports
+func formatDefault(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	case map[string]any:
+		return "{}"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// featureGateDefaults returns the feature gate defaults of a config template,
+// by featureGates.<name> key
+func featureGateDefaults(template string) map[string]string {
+	defaults := make(map[string]string)
+	for _, m := range featureGateDefaultRegex.FindAllStringSubmatch(template, -1) {
+		defaults["featureGates."+m[1]] = m[2]
+	}
+	return defaults
+}
+
+// diffDefaults returns the keys present in both sets of defaults with a
+// different value, sorted by key. Added and removed parameters are new or
+// removed features rather than changed defaults.
+func diffDefaults(file string, from, to map[string]string) []configDefaultChange {
+	var changes []configDefaultChange
+	for key, oldValue := range from {
+		if newValue, ok := to[key]; ok && newValue != oldValue {
+			changes = append(changes, configDefaultChange{file: file, key: key, from: oldValue, to: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].key < changes[j].key
+	})
+	return changes
+}
+
+// detectConfigDefaultChanges compares the Helm values and the feature gate
+// defaults of the agent and controller config templates of the from-release
+// tag and of the release branch
+func (g *ChangelogGenerator) detectConfigDefaultChanges(ctx context.Context, fromRelease, branch string) ([]configDefaultChange, error) {
+	files := []struct {
+		path     string
+		defaults func(string) (map[string]string, error)
+	}{
+		{helmValuesPath, helmValueDefaults},
+		{agentConfPath, func(s string) (map[string]string, error) { return featureGateDefaults(s), nil }},
+		{controllerConfPath, func(s string) (map[string]string, error) { return featureGateDefaults(s), nil }},
+	}
+	var changes []configDefaultChange
+	for _, file := range files {
+		defaults := make([]map[string]string, 2)
+		for i, ref := range []string{"v" + fromRelease, branch} {
+			content, _, err := g.githubClient.GetFileAtRef(ctx, repoOwner, repoName, file.path, ref)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s at %s: %w", file.path, ref, err)
+			}
+			if content == "" {
+				break
+			}
+			if defaults[i], err = file.defaults(content); err != nil {
+				g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: file.path,
+					Message: fmt.Sprintf("%s at %s: %v", file.path, ref, err)})
+				break
+			}
+		}
+		if defaults[0] == nil || defaults[1] == nil {
+			g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: file.path,
+				Message: fmt.Sprintf("%s not found at both v%s and %s, skipping its default configuration check", file.path, fromRelease, branch)})
+			continue
+		}
+		changes = append(changes, diffDefaults(file.path[strings.LastIndex(file.path, "/")+1:], defaults[0], defaults[1])...)
+	}
+	if len(changes) > 0 {
+		log.Printf("%d configuration defaults were changed since v%s", len(changes), fromRelease)
+	}
+	return changes, nil
+}
+
+// configDefaultsPrompt returns the prompt section asking for CHANGED entries
+// about the changed configuration defaults
+func configDefaultsPrompt(changes []configDefaultChange) string {
+	var sb strings.Builder
+	sb.WriteString(`## Changed Configuration Defaults
+
+The following defaults of the Helm values and antrea-agent / antrea-controller configuration changed in this release.
+Users upgrading with their existing configuration are affected, and past CHANGELOGs often omitted them, so each of them
+MUST be covered by a CHANGED entry which names the parameter (e.g. "Enable the Multicast feature gate by default"),
+attached to the PR which changed the default if it is listed below:
+
+`)
+	for _, c := range changes {
+		sb.WriteString(fmt.Sprintf("- %s\n", c))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// checkConfigDefaultEntries records which changed defaults are mentioned by a
+// rendered CHANGED entry, and logs a warning for the others
+func checkConfigDefaultEntries(changes []configDefaultChange, response *types.ModelResponse, thresholds config.Thresholds) {
+	var descriptions []string
+	for _, change := range response.Changes {
+		if change.IncludeScore >= thresholds.Optional && strings.EqualFold(change.Category, "CHANGED") {
+			descriptions = append(descriptions, normalizeParameter(change.Description))
+		}
+	}
+	for i := range changes {
+		parameter := normalizeParameter(changes[i].parameter())
+		for _, description := range descriptions {
+			if strings.Contains(description, parameter) {
+				changes[i].mentioned = true
+				break
+			}
+		}
+		if !changes[i].mentioned {
+			log.Printf("Warning: %s, but no CHANGED entry mentions it; add one before releasing", changes[i])
+		}
+	}
+}
+
+// normalizeParameter lower-cases text and removes everything but letters and
+// digits, so that e.g. "max Egress IPs per Node" matches maxEgressIPsPerNode
+func normalizeParameter(text string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return -1
+	}, text)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func agentConfWithFeatureGates(multicast, egress string) string {
+	return `# FeatureGates is a map of feature names to bools that enable or disable experimental features.
+featureGates:
+{{- include "featureGate" (dict "featureGates" .Values.featureGates "name" "Multicast" "default" ` + multicast + `) }}
+{{- include "featureGate" (dict "featureGates" .Values.featureGates "name" "Egress" "default" ` + egress + `) }}
+
+ovsBridge: {{ .Values.ovs.bridgeName | quote }}
+`
+}
+
+func TestHelmValueDefaults(t *testing.T) {
+	defaults, err := helmValueDefaults(`
+trafficEncapMode: "encap"
+egress:
+  exceptCIDRs: []
+  maxEgressIPsPerNode: 255
+nodeIPAM:
+  enable: false
+  clusterCIDRs: []
+annotations: {}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"trafficEncapMode":           `"encap"`,
+		"egress.exceptCIDRs":         "[]",
+		"egress.maxEgressIPsPerNode": "255",
+		"nodeIPAM.enable":            "false",
+		"nodeIPAM.clusterCIDRs":      "[]",
+		"annotations":                "{}",
+	}, defaults)
+
+	_, err = helmValueDefaults("egress: [")
+	assert.Error(t, err)
+}
+
+func TestDiffDefaults(t *testing.T) {
+	changes := diffDefaults("values.yaml",
+		map[string]string{"b.enable": "false", "a.size": "1", "removed": "true", "same": `""`},
+		map[string]string{"b.enable": "true", "a.size": "2", "added": "true", "same": `""`})
+	assert.Equal(t, []configDefaultChange{
+		{file: "values.yaml", key: "a.size", from: "1", to: "2"},
+		{file: "values.yaml", key: "b.enable", from: "false", to: "true"},
+	}, changes)
+}
+
+func TestDetectConfigDefaultChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", helmValuesPath, "v2.4.0").
+		Return("egress:\n  maxEgressIPsPerNode: 255\nlogVerbosity: 0\n", "sha1", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", helmValuesPath, "main").
+		Return("egress:\n  maxEgressIPsPerNode: 300\nlogVerbosity: 0\n", "sha2", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", agentConfPath, "v2.4.0").
+		Return(agentConfWithFeatureGates("false", "true"), "sha3", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", agentConfPath, "main").
+		Return(agentConfWithFeatureGates("true", "true"), "sha4", nil)
+	// The controller config template is missing at the from-release tag
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", controllerConfPath, "v2.4.0").
+		Return("", "", nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithConfigDefaultsCheck(true))
+	changes, err := generator.detectConfigDefaultChanges(context.Background(), "2.4.0", "main")
+	require.NoError(t, err)
+	assert.Equal(t, []configDefaultChange{
+		{file: "values.yaml", key: "egress.maxEgressIPsPerNode", from: "255", to: "300"},
+		{file: "antrea-agent.conf", key: "featureGates.Multicast", from: "false", to: "true"},
+	}, changes)
+
+	generator.configDefaultChanges = changes
+	promptText := generator.buildPrompt("", nil, nil)
+	assert.Contains(t, promptText, "## Changed Configuration Defaults")
+	assert.Contains(t, promptText, "- antrea-agent.conf: featureGates.Multicast changed from false to true\n")
+
+	checkConfigDefaultEntries(changes, &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "CHANGED", IncludeScore: 90, Description: "Enable the Multicast feature by default"},
+		{PRNumber: 101, Category: "FIXED", IncludeScore: 90, Description: "Fix max Egress IPs per Node"},
+	}}, config.DefaultThresholds())
+	assert.False(t, changes[0].mentioned, "Only CHANGED entries count")
+	assert.True(t, changes[1].mentioned)
+	assert.Equal(t, []string{"unexplained configuration change: values.yaml: egress.maxEgressIPsPerNode changed from 255 to 300"}, generator.Warnings())
+	summary := generator.ReviewSummary(&types.ModelResponse{})
+	assert.Contains(t, summary, "#### Configuration defaults changed without a CHANGED entry")
+	assert.NotContains(t, summary, "featureGates.Multicast")
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// labelCategories maps PR labels to the category they strongly indicate
+var labelCategories = map[string]string{
+	"kind/bug":     "FIXED",
+	"kind/feature": "ADDED",
+}
+
+// detectHistoryConflicts returns the PRs with a historical entry whose
+// category disagrees with their labels, or with the model's category for
+// entries above the include threshold. The model alone is a weak signal, so it
+// is ignored for optional and excluded entries. Neither side is preferred: the
+// model's entry is rendered as usual, and conflicts are reported for a human
+// to decide.
+func detectHistoryConflicts(response *types.ModelResponse, prs []types.PRInfo, prCache map[int]types.HistoricalPR, thresholds config.Thresholds) []types.HistoryConflict {
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range prs {
+		byNumber[pr.Number] = pr
+	}
+
+	var conflicts []types.HistoryConflict
+	for _, change := range response.Changes {
+		historical, ok := prCache[change.PRNumber]
+		if !ok {
+			continue
+		}
+		pr := byNumber[change.PRNumber]
+		conflict := types.HistoryConflict{
+			Number:             change.PRNumber,
+			Title:              pr.Title,
+			HistoricalCategory: historical.Category,
+			ModelCategory:      strings.ToUpper(change.Category),
+		}
+		for _, l := range pr.Labels {
+			if category, ok := labelCategories[l]; ok && category != historical.Category {
+				conflict.Label = l
+				conflict.LabelCategory = category
+				break
+			}
+		}
+		modelConflict := conflict.ModelCategory != historical.Category && change.IncludeScore >= thresholds.Include
+		if modelConflict || conflict.Label != "" {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
+
+// FormatConflictReport renders the historical category conflicts as a markdown report for reviewers
+func FormatConflictReport(release string, conflicts []types.HistoryConflict) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Historical category conflicts in the %s CHANGELOG\n\n", release))
+	if len(conflicts) == 0 {
+		sb.WriteString("No conflicts were found.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("The following PRs already appear in a historical CHANGELOG with a different category than the one ")
+	sb.WriteString("returned by the model or implied by their labels. Check which category is correct before releasing.\n\n")
+	sb.WriteString("| PR | Title | Historical | Model | Label |\n")
+	sb.WriteString("|----|-------|------------|-------|-------|\n")
+	for _, c := range conflicts {
+		label := ""
+		if c.Label != "" {
+			label = fmt.Sprintf("%s (%s)", c.Label, c.LabelCategory)
+		}
+		sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s | %s | %s |\n",
+			c.Number, repoOwner, repoName, c.Number, escapeTableCell(c.Title), c.HistoricalCategory, c.ModelCategory, escapeTableCell(label)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDetectHistoryConflicts(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Fix Egress IP allocation"},
+		{Number: 101, Title: "Add Egress support for Windows", Labels: []string{"kind/feature"}},
+		{Number: 102, Title: "Improve antctl output", Labels: []string{"kind/bug"}},
+		{Number: 103, Title: "Add BGP policy"},
+		{Number: 104, Title: "Fix typo in antctl help"},
+	}
+	prCache := map[int]types.HistoricalPR{
+		100: {Category: "FIXED", Description: "Fix Egress IP allocation"},
+		101: {Category: "ADDED", Description: "Add Egress support for Windows"},
+		102: {Category: "CHANGED", Description: "Improve antctl output"},
+		104: {Category: "FIXED", Description: "Fix typo in antctl help"},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "changed", IncludeScore: 80},
+			{PRNumber: 101, Category: "ADDED", IncludeScore: 80},
+			{PRNumber: 102, Category: "CHANGED", IncludeScore: 80},
+			{PRNumber: 103, Category: "ADDED", IncludeScore: 80},
+			{PRNumber: 104, Category: "CHANGED", IncludeScore: 30},
+		},
+	}
+
+	conflicts := detectHistoryConflicts(response, prs, prCache, config.DefaultThresholds())
+
+	assert.Equal(t, []types.HistoryConflict{
+		{Number: 100, Title: "Fix Egress IP allocation", HistoricalCategory: "FIXED", ModelCategory: "CHANGED"},
+		{Number: 102, Title: "Improve antctl output", HistoricalCategory: "CHANGED", ModelCategory: "CHANGED", Label: "kind/bug", LabelCategory: "FIXED"},
+	}, conflicts)
+
+	report := FormatConflictReport("2.5.0", conflicts)
+	assert.Contains(t, report, "| [#100](https://github.com/antrea-io/antrea/pull/100) | Fix Egress IP allocation | FIXED | CHANGED |  |\n")
+	assert.Contains(t, report, "| [#102](https://github.com/antrea-io/antrea/pull/102) | Improve antctl output | CHANGED | CHANGED | kind/bug (FIXED) |\n")
+}
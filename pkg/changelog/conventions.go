@@ -0,0 +1,121 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// defaultReleaseLevel and defaultCategoryLevel are the heading levels of
+	// the release sections and categories rendered by formatChangelog
+	defaultReleaseLevel  = 2
+	defaultCategoryLevel = 3
+	// defaultOptionalPrefix prefixes the entries below the include threshold
+	defaultOptionalPrefix = "*OPTIONAL* "
+)
+
+var (
+	// headingRegex matches markdown headings: ### Fixed
+	headingRegex = regexp.MustCompile(`^(#{1,6})\s+(\S.*)$`)
+	// releaseHeadingRegex matches release headings at any level, with an
+	// optional v prefix and brackets: ## X.Y.Z - YYYY-MM-DD, ### [v2.4.1], ## Unreleased
+	releaseHeadingRegex = regexp.MustCompile(`^(#{1,6})\s+\[?v?(\d+\.\d+\.\d+[^\s\]]*|Unreleased)\]?`)
+	// optionalMarkerRegex matches the optional marker at the start of an
+	// entry: *OPTIONAL*, **Optional**, _optional_, [Optional], (optional) or Optional:
+	optionalMarkerRegex = regexp.MustCompile(`(?i)^([*_]{1,2}optional[*_]{1,2}|\[optional\]|\(optional\)|optional:)\s+`)
+	// generatedOptionalRegex matches the optional prefix of a generated entry,
+	// after its anchor if any
+	generatedOptionalRegex = regexp.MustCompile(`^(- (?:<a id="[^"]*"></a>)?)\*OPTIONAL\* `)
+)
+
+// changelogConventions are the formatting conventions of a CHANGELOG file,
+// which new release sections are conformed to when merged into it
+type changelogConventions struct {
+	releaseLevel   int
+	categoryLevel  int
+	optionalPrefix string
+	// linkStyle is empty if the file has no entries with links
+	linkStyle LinkStyle
+}
+
+// releaseLevel returns the heading level of the release sections of a
+// CHANGELOG, or defaultReleaseLevel if it has none
+func releaseLevel(content string) int {
+	for _, line := range strings.Split(content, "\n") {
+		if m := releaseHeadingRegex.FindStringSubmatch(line); m != nil {
+			return len(m[1])
+		}
+	}
+	return defaultReleaseLevel
+}
+
+// detectConventions returns the conventions of a CHANGELOG file, using the
+// formatter defaults for what the file does not tell (e.g. the optional
+// prefix of a file without optional entries)
+func detectConventions(content string) changelogConventions {
+	c := changelogConventions{
+		releaseLevel:   releaseLevel(content),
+		optionalPrefix: defaultOptionalPrefix,
+		linkStyle:      detectLinkStyle(content),
+	}
+	inRelease := false
+	for _, line := range strings.Split(content, "\n") {
+		if m := headingRegex.FindStringSubmatch(line); m != nil {
+			level := len(m[1])
+			if level == c.releaseLevel {
+				inRelease = true
+			} else if inRelease && level > c.releaseLevel && c.categoryLevel == 0 {
+				c.categoryLevel = level
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "- ") || c.optionalPrefix != defaultOptionalPrefix {
+			continue
+		}
+		entry := entryAnchorRegex.ReplaceAllString(strings.TrimPrefix(line, "- "), "")
+		if m := optionalMarkerRegex.FindStringSubmatch(entry); m != nil {
+			c.optionalPrefix = m[1] + " "
+		}
+	}
+	if c.categoryLevel == 0 {
+		c.categoryLevel = c.releaseLevel + 1
+	}
+	return c
+}
+
+// conform rewrites the heading levels and optional prefixes of a release
+// section rendered by formatChangelog according to the conventions
+func (c changelogConventions) conform(lines []string) []string {
+	conformed := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if m := headingRegex.FindStringSubmatch(line); m != nil {
+			switch len(m[1]) {
+			case defaultReleaseLevel:
+				line = strings.Repeat("#", c.releaseLevel) + " " + m[2]
+			case defaultCategoryLevel:
+				line = strings.Repeat("#", c.categoryLevel) + " " + m[2]
+			case defaultCategoryLevel + 1:
+				// Subcategories
+				line = strings.Repeat("#", c.categoryLevel+1) + " " + m[2]
+			}
+		} else if m := generatedOptionalRegex.FindStringSubmatch(line); m != nil {
+			line = m[1] + c.optionalPrefix + line[len(m[0]):]
+		}
+		conformed = append(conformed, line)
+	}
+	return conformed
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// existingCustom uses level 3 release headings, level 4 categories and a
+// **Optional** prefix
+const existingCustom = `# Changelog 2.4
+
+## Releases
+
+### [v2.4.1] - 2025-02-01
+
+#### Fixed
+
+- **Optional** Fix bug A. ([#2](https://github.com/antrea-io/antrea/pull/2), [@bob])
+
+[@bob]: https://github.com/bob
+`
+
+func TestDetectConventions(t *testing.T) {
+	assert.Equal(t, changelogConventions{
+		releaseLevel:   3,
+		categoryLevel:  4,
+		optionalPrefix: "**Optional** ",
+		linkStyle:      LinkStyleMixed,
+	}, detectConventions(existingCustom))
+
+	// The formatter defaults are used for new files
+	assert.Equal(t, changelogConventions{
+		releaseLevel:   defaultReleaseLevel,
+		categoryLevel:  defaultCategoryLevel,
+		optionalPrefix: defaultOptionalPrefix,
+	}, detectConventions(""))
+}
+
+func TestMergeChangelog_Conventions(t *testing.T) {
+	section := `## 2.4.2 - 2025-03-01
+
+### Fixed
+
+- <a id="pr-3"></a>*OPTIONAL* Fix bug B. ([#3](https://github.com/antrea-io/antrea/pull/3), [@alice])
+
+[@alice]: https://github.com/alice
+`
+	merged, err := MergeChangelog(existingCustom, section, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+	assert.Equal(t, `# Changelog 2.4
+
+## Releases
+
+### 2.4.2 - 2025-03-01
+
+#### Fixed
+
+- <a id="pr-3"></a>**Optional** Fix bug B. ([#3](https://github.com/antrea-io/antrea/pull/3), [@alice])
+
+### [v2.4.1] - 2025-02-01
+
+#### Fixed
+
+- **Optional** Fix bug A. ([#2](https://github.com/antrea-io/antrea/pull/2), [@bob])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+`, merged)
+
+	// Regenerating a release replaces its section whatever the version format
+	merged, err = MergeChangelog(existingCustom, strings.ReplaceAll(section, "2.4.2", "2.4.1"), LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+	assert.NotContains(t, merged, "[v2.4.1]")
+	assert.Equal(t, "### 2.4.1 - 2025-03-01 [YANKED]", strings.Split(MarkYanked(merged, []string{"2.4.1"}), "\n")[4])
+}
+
+func TestParseCHANGELOGEntries_Conventions(t *testing.T) {
+	prCache := make(map[int]types.HistoricalPR)
+	parseCHANGELOGEntries(existingCustom, config.DefaultCategories(), prCache)
+	assert.Equal(t, map[int]types.HistoricalPR{
+		2: {Description: "Fix bug A", Category: "FIXED", Release: "2.4.1"},
+	}, prCache)
+}
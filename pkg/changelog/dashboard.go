@@ -0,0 +1,109 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// DashboardUnavailable is the release dashboard data the releaser does not
+// produce, listed in the exported document
+var DashboardUnavailable = []string{
+	"release asset verification: the assets of the GitHub releases are not checked",
+	"backport gaps across branches: only the drift of the release branch of each release is audited",
+}
+
+// RunOutput is the model output artifact of a run: the model response, with
+// the warnings and the quality score of the run
+type RunOutput struct {
+	*types.ModelResponse
+	Warnings []types.Warning     `json:"warnings,omitempty"`
+	Quality  *types.QualityScore `json:"quality,omitempty"`
+}
+
+// RunHistory reads the prepare-changelog runs of a release from their
+// artifacts, oldest first: every run which called the model saved its model
+// details, and most of them also saved their model output
+func RunHistory(dir, nameTemplate, release string, storage artifacts.Storage) ([]types.DashboardRun, error) {
+	w, err := artifacts.NewWriter(dir, nameTemplate, release, "", artifacts.WithStorage(storage))
+	if err != nil {
+		return nil, err
+	}
+	detailsTimestamps, err := w.Timestamps(artifacts.KindDetails, "json")
+	if err != nil {
+		return nil, err
+	}
+	outputTimestamps, err := w.Timestamps(artifacts.KindOutput, "json")
+	if err != nil {
+		return nil, err
+	}
+	hasOutput := make(map[string]bool, len(outputTimestamps))
+	for _, timestamp := range outputTimestamps {
+		hasOutput[timestamp] = true
+	}
+
+	runs := make([]types.DashboardRun, 0, len(detailsTimestamps))
+	for _, timestamp := range detailsTimestamps {
+		runWriter, err := artifacts.NewWriter(dir, nameTemplate, release, timestamp, artifacts.WithStorage(storage))
+		if err != nil {
+			return nil, err
+		}
+		var details types.ModelDetails
+		if err := readArtifact(runWriter, artifacts.KindDetails, &details); err != nil {
+			return nil, err
+		}
+		run := types.DashboardRun{
+			Timestamp:        timestamp,
+			Model:            details.Model,
+			Calls:            details.Calls,
+			TotalTokens:      details.TotalTokens,
+			EstimatedCostUSD: details.EstimatedCostUSD,
+		}
+		if hasOutput[timestamp] {
+			var output RunOutput
+			if err := readArtifact(runWriter, artifacts.KindOutput, &output); err != nil {
+				return nil, err
+			}
+			run.ModelOutput = true
+			if output.ModelResponse != nil {
+				run.Entries = len(output.Changes)
+			}
+			run.Warnings = len(output.Warnings)
+			run.Quality = output.Quality
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// readArtifact reads the JSON artifact of a kind of the run of w
+func readArtifact(w *artifacts.Writer, kind string, v any) error {
+	path, err := w.Path(kind, "json")
+	if err != nil {
+		return err
+	}
+	data, err := w.Read(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
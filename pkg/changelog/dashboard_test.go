@@ -0,0 +1,72 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestRunHistory(t *testing.T) {
+	dir := t.TempDir()
+	write := func(release, timestamp, kind, data string) {
+		w, err := artifacts.NewWriter(dir, "", release, timestamp)
+		require.NoError(t, err)
+		_, err = w.Write(kind, "json", []byte(data))
+		require.NoError(t, err)
+	}
+	// A completed run, a run whose model call failed after a retry, and a run
+	// of another release
+	write("2.5.0", "20250130-143025", artifacts.KindDetails, `{"model":"gemini-2.5-flash","total_tokens":48500,"estimated_cost_usd":0.004,"calls":2}`)
+	write("2.5.0", "20250130-143025", artifacts.KindOutput, `{"changes":[{"pr_number":7200},{"pr_number":7201}],"warnings":[{"kind":"skipped file","message":"go.mod not found"}],"quality":{"score":88,"coverage":0.9}}`)
+	write("2.5.0", "20250201-100000", artifacts.KindDetails, `{"model":"gemini-2.5-pro","total_tokens":1000,"estimated_cost_usd":0.001,"calls":1}`)
+	write("2.4.3", "20250131-090000", artifacts.KindDetails, `{"model":"gemini-2.5-flash","calls":1}`)
+
+	runs, err := RunHistory(dir, "", "2.5.0", artifacts.LocalStorage{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.DashboardRun{
+		{
+			Timestamp:        "20250130-143025",
+			Model:            "gemini-2.5-flash",
+			Calls:            2,
+			TotalTokens:      48500,
+			EstimatedCostUSD: 0.004,
+			ModelOutput:      true,
+			Entries:          2,
+			Warnings:         1,
+			Quality:          &types.QualityScore{Score: 88, Coverage: 0.9},
+		},
+		{
+			Timestamp:        "20250201-100000",
+			Model:            "gemini-2.5-pro",
+			Calls:            1,
+			TotalTokens:      1000,
+			EstimatedCostUSD: 0.001,
+		},
+	}, runs)
+
+	runs, err = RunHistory(dir, "", "2.6.0", artifacts.LocalStorage{})
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	write("2.5.0", "20250202-100000", artifacts.KindDetails, `{`)
+	_, err = RunHistory(dir, "", "2.5.0", artifacts.LocalStorage{})
+	assert.ErrorContains(t, err, "failed to parse")
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"log"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// minDuplicateSimilarity is the word-set similarity from which two descriptions
+// are considered to describe the same change
+const minDuplicateSimilarity = 0.8
+
+// dedupeEntries merges entries of the same category with near-identical
+// descriptions into a single grouped entry. The entry with the highest
+// importance_score is kept, and the others are recorded in its GroupedWith and
+// GroupedAuthors fields. Entries which would not be rendered are left alone.
+func dedupeEntries(response *types.ModelResponse, thresholds config.Thresholds) {
+	kept := make([]types.ChangeEntry, 0, len(response.Changes))
+	// Index in kept of each rendered entry, used to find duplicates
+	var candidates []int
+	words := make(map[int]map[string]bool)
+
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional {
+			kept = append(kept, change)
+			continue
+		}
+		changeWords := descriptionWords(change.Description)
+		merged := false
+		for _, idx := range candidates {
+			primary := &kept[idx]
+			if !strings.EqualFold(primary.Category, change.Category) {
+				continue
+			}
+			if jaccard(words[idx], changeWords) < minDuplicateSimilarity {
+				continue
+			}
+			log.Printf("Grouping PR #%d with PR #%d (duplicate description)", change.PRNumber, primary.PRNumber)
+			if change.ImportanceScore > primary.ImportanceScore {
+				// The new entry becomes the primary one
+				change.GroupedWith = append(append([]int{primary.PRNumber}, primary.GroupedWith...), change.GroupedWith...)
+				change.GroupedAuthors = append(append([]string{primary.Author}, primary.GroupedAuthors...), change.GroupedAuthors...)
+				change.IncludeScore = max(change.IncludeScore, primary.IncludeScore)
+				*primary = change
+				words[idx] = changeWords
+			} else {
+				primary.GroupedWith = append(primary.GroupedWith, change.PRNumber)
+				primary.GroupedAuthors = append(primary.GroupedAuthors, change.Author)
+				primary.IncludeScore = max(primary.IncludeScore, change.IncludeScore)
+			}
+			merged = true
+			break
+		}
+		if !merged {
+			kept = append(kept, change)
+			candidates = append(candidates, len(kept)-1)
+			words[len(kept)-1] = changeWords
+		}
+	}
+
+	response.Changes = kept
+}
+
+// minHistoricalDuplicateSimilarity is the word-set similarity from which a new
+// description is considered to describe an already released change. It is
+// lower than minDuplicateSimilarity, as re-opened PRs are often described
+// with slightly different words.
+const minHistoricalDuplicateSimilarity = 0.7
+
+// detectHistoricalDuplicates returns the rendered entries without a historical
+// entry of their own whose description is near-identical to the historical
+// entry of another PR, e.g. because a PR was renumbered or re-opened. Such
+// entries are only flagged, as the same fix is sometimes legitimately released
+// again (e.g. for a regression).
+func detectHistoricalDuplicates(response *types.ModelResponse, prCache map[int]types.HistoricalPR, thresholds config.Thresholds) []types.HistoricalDuplicate {
+	historicalWords := make(map[int]map[string]bool, len(prCache))
+	for number, pr := range prCache {
+		historicalWords[number] = descriptionWords(pr.Description)
+	}
+
+	var duplicates []types.HistoricalDuplicate
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional {
+			continue
+		}
+		numbers := append([]int{change.PRNumber}, change.GroupedWith...)
+		if slices.ContainsFunc(numbers, func(n int) bool { _, ok := prCache[n]; return ok }) {
+			// Checked for consistency by detectHistoryConflicts instead
+			continue
+		}
+		changeWords := descriptionWords(change.Description)
+		best := types.HistoricalDuplicate{}
+		for number, words := range historicalWords {
+			similarity := jaccard(changeWords, words)
+			// Ties are broken by PR number for deterministic results
+			if similarity < minHistoricalDuplicateSimilarity || similarity < best.Similarity ||
+				(similarity == best.Similarity && number > best.HistoricalNumber) {
+				continue
+			}
+			best = types.HistoricalDuplicate{
+				Number:                change.PRNumber,
+				Description:           change.Description,
+				HistoricalNumber:      number,
+				HistoricalDescription: prCache[number].Description,
+				HistoricalRelease:     prCache[number].Release,
+				Similarity:            similarity,
+			}
+		}
+		if best.HistoricalNumber != 0 {
+			duplicates = append(duplicates, best)
+		}
+	}
+	return duplicates
+}
+
+// descriptionWords returns the set of lower-cased words in a description
+func descriptionWords(description string) map[string]bool {
+	fields := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+	return set
+}
+
+// jaccard returns the Jaccard similarity of two word sets
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	common := 0
+	for w := range a {
+		if b[w] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
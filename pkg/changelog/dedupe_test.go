@@ -0,0 +1,92 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestDedupeEntries(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", Description: "Fix NetworkPolicy status update for removed Nodes", IncludeScore: 80, ImportanceScore: 50, Author: "alice"},
+			{PRNumber: 101, Category: "FIXED", Description: "Fix NetworkPolicy status update for removed Nodes.", IncludeScore: 60, ImportanceScore: 70, Author: "bob"},
+			{PRNumber: 102, Category: "ADDED", Description: "Fix NetworkPolicy status update for removed Nodes", IncludeScore: 80, ImportanceScore: 50, Author: "carol"},
+			{PRNumber: 103, Category: "FIXED", Description: "Fix Egress IP allocation", IncludeScore: 80, ImportanceScore: 40, Author: "dave"},
+			{PRNumber: 104, Category: "FIXED", Description: "Fix Egress IP allocation", IncludeScore: 10, ImportanceScore: 40, Author: "erin"},
+		},
+	}
+
+	dedupeEntries(response, config.DefaultThresholds())
+
+	require.Len(t, response.Changes, 4)
+	grouped := response.Changes[0]
+	assert.Equal(t, 101, grouped.PRNumber, "Entry with the highest importance_score should be kept")
+	assert.Equal(t, []int{100}, grouped.GroupedWith)
+	assert.Equal(t, []string{"alice"}, grouped.GroupedAuthors)
+	assert.Equal(t, 80, grouped.IncludeScore)
+	assert.Equal(t, 102, response.Changes[1].PRNumber, "Entries from different categories should not be grouped")
+	assert.Equal(t, 103, response.Changes[2].PRNumber)
+	assert.Equal(t, 104, response.Changes[3].PRNumber, "Excluded entries should not be grouped")
+	assert.Empty(t, response.Changes[2].GroupedWith)
+}
+
+func TestFormatChangelog_GroupedEntry(t *testing.T) {
+	ver, err := version.Parse("2.5.1")
+	require.NoError(t, err)
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 101, Category: "FIXED", Description: "Fix NetworkPolicy status update", IncludeScore: 80, ImportanceScore: 70, Author: "bob", GroupedWith: []int{100, 105}, GroupedAuthors: []string{"alice", "bob"}},
+		},
+	}
+
+	changelog := formatChangelog(ver, response, formatOptions{thresholds: config.DefaultThresholds()})
+
+	assert.Contains(t, changelog, "- Fix NetworkPolicy status update. ([#101](https://github.com/antrea-io/antrea/pull/101) [#100](https://github.com/antrea-io/antrea/pull/100) [#105](https://github.com/antrea-io/antrea/pull/105), [@bob] [@alice])\n")
+	assert.Contains(t, changelog, "[@alice]: https://github.com/alice\n")
+	assert.NoError(t, Validate(changelog))
+}
+
+func TestDetectHistoricalDuplicates(t *testing.T) {
+	prCache := map[int]types.HistoricalPR{
+		100: {Description: "Fix Antrea Agent crash when the Egress IP is updated", Category: "FIXED", Release: "2.3.1"},
+		101: {Description: "Add support for IPv6 in FlowExporter", Category: "ADDED", Release: "2.3.0"},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			// Re-opened PR for an already released fix
+			{PRNumber: 200, Category: "FIXED", IncludeScore: 80, Description: "Fix Antrea Agent crash when an Egress IP is updated"},
+			// Historical entry of its own, handled by detectHistoryConflicts
+			{PRNumber: 101, Category: "ADDED", IncludeScore: 80, Description: "Add support for IPv6 in FlowExporter"},
+			{PRNumber: 201, Category: "ADDED", IncludeScore: 80, Description: "Add NodeLatencyMonitor CRD"},
+			// Not rendered
+			{PRNumber: 202, Category: "FIXED", IncludeScore: 10, Description: "Fix Antrea Agent crash when the Egress IP is updated"},
+		},
+	}
+
+	duplicates := detectHistoricalDuplicates(response, prCache, config.DefaultThresholds())
+	require.Len(t, duplicates, 1)
+	assert.Equal(t, 200, duplicates[0].Number)
+	assert.Equal(t, 100, duplicates[0].HistoricalNumber)
+	assert.Equal(t, "2.3.1", duplicates[0].HistoricalRelease)
+	assert.InDelta(t, 0.82, duplicates[0].Similarity, 0.01)
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// metricFiles are the files of the Antrea repository defining the Prometheus
+// metrics
+var metricFiles = []string{
+	"pkg/agent/metrics/prometheus.go",
+	"pkg/controller/metrics/prometheus.go",
+}
+
+// crdTypeFiles are the files of the Antrea repository defining the CRD API
+// types, by API version
+var crdTypeFiles = map[string]string{
+	"crd.antrea.io/v1alpha1": "pkg/apis/crd/v1alpha1/types.go",
+	"crd.antrea.io/v1alpha2": "pkg/apis/crd/v1alpha2/types.go",
+	"crd.antrea.io/v1beta1":  "pkg/apis/crd/v1beta1/types.go",
+}
+
+var (
+	// metricOptsRegex matches the options of a metric: metrics.GaugeOpts{...}
+	metricOptsRegex = regexp.MustCompile(`(?s)Opts\{(.*?)\n\s*\}`)
+	// metricFieldRegex matches a string field of metric options: Name: "flow_count"
+	metricFieldRegex = regexp.MustCompile(`\b(Namespace|Subsystem|Name|DeprecatedVersion):\s+"([^"]*)"`)
+	// structTypeRegex matches a struct type declaration: type EgressSpec struct {
+	structTypeRegex = regexp.MustCompile(`^type\s+(\w+)\s+struct\s*\{`)
+	// jsonFieldRegex matches the JSON name of a struct field: `json:"egressIP,omitempty"`
+	jsonFieldRegex = regexp.MustCompile("^\\w+\\s+[^`]+`[^`]*json:\"([A-Za-z0-9]+)[,\"]")
+)
+
+// deprecation is a Prometheus metric or a CRD field deprecated or removed
+// within the release window
+type deprecation struct {
+	// subject is what is deprecated: "Prometheus metric" or "CRD field"
+	subject string
+	name    string
+	// apiVersion is the API version of a CRD field
+	apiVersion string
+	removed    bool
+}
+
+// String returns a human-readable description of the deprecation
+func (d deprecation) String() string {
+	what := fmt.Sprintf("%s `%s`", d.subject, d.name)
+	if d.apiVersion != "" {
+		what += fmt.Sprintf(" (%s)", d.apiVersion)
+	}
+	if d.removed {
+		return what + " was removed"
+	}
+	return what + " is deprecated"
+}
+
+// prometheusMetrics returns the metrics defined in a file, by full name, and
+// whether each of them is deprecated. The namespace and subsystem are only part
+// of the name when they are string literals.
+func prometheusMetrics(content string) map[string]bool {
+	metrics := make(map[string]bool)
+	for _, opts := range metricOptsRegex.FindAllStringSubmatch(content, -1) {
+		fields := make(map[string]string)
+		for _, m := range metricFieldRegex.FindAllStringSubmatch(opts[1], -1) {
+			fields[m[1]] = m[2]
+		}
+		if fields["Name"] == "" {
+			continue
+		}
+		var parts []string
+		for _, field := range []string{"Namespace", "Subsystem", "Name"} {
+			if fields[field] != "" {
+				parts = append(parts, fields[field])
+			}
+		}
+		metrics[strings.Join(parts, "_")] = fields["DeprecatedVersion"] != ""
+	}
+	return metrics
+}
+
+// crdFields returns the fields of the API types defined in a file, by
+// Type.jsonName, and whether each of them is deprecated, i.e. has a doc
+// comment containing "Deprecated"
+func crdFields(content string) map[string]bool {
+	fields := make(map[string]bool)
+	typeName := ""
+	deprecated := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "//"):
+			if strings.Contains(line, "Deprecated") || strings.Contains(line, "deprecated") {
+				deprecated = true
+			}
+			continue
+		case structTypeRegex.MatchString(line):
+			typeName = structTypeRegex.FindStringSubmatch(line)[1]
+		case line == "}":
+			typeName = ""
+		case typeName != "":
+			if m := jsonFieldRegex.FindStringSubmatch(line); m != nil {
+				fields[typeName+"."+m[1]] = deprecated
+			}
+		}
+		deprecated = false
+	}
+	return fields
+}
+
+// diffDeprecations returns the items newly deprecated or removed between two
+// versions, sorted by name. Renamed items are reported as removed.
+func diffDeprecations(subject string, from, to map[string]bool) []deprecation {
+	var deprecations []deprecation
+	for name, wasDeprecated := range from {
+		deprecated, ok := to[name]
+		switch {
+		case !ok:
+			deprecations = append(deprecations, deprecation{subject: subject, name: name, removed: true})
+		case deprecated && !wasDeprecated:
+			deprecations = append(deprecations, deprecation{subject: subject, name: name})
+		}
+	}
+	sort.Slice(deprecations, func(i, j int) bool {
+		return deprecations[i].name < deprecations[j].name
+	})
+	return deprecations
+}
+
+// detectDeprecations compares the Prometheus metrics and the CRD fields of the
+// from-release tag and of the release branch
+func (g *ChangelogGenerator) detectDeprecations(ctx context.Context, fromRelease, branch string) ([]deprecation, error) {
+	// fetch returns the content of a file at both refs, or nil if it is
+	// missing at either of them
+	fetch := func(path string) ([]string, error) {
+		contents := make([]string, 2)
+		for i, ref := range []string{"v" + fromRelease, branch} {
+			content, _, err := g.githubClient.GetFileAtRef(ctx, repoOwner, repoName, path, ref)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get %s at %s: %w", path, ref, err)
+			}
+			if content == "" {
+				g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: path,
+					Message: fmt.Sprintf("%s not found at %s, skipping its deprecation check", path, ref)})
+				return nil, nil
+			}
+			contents[i] = content
+		}
+		return contents, nil
+	}
+
+	var deprecations []deprecation
+	for _, path := range metricFiles {
+		contents, err := fetch(path)
+		if err != nil {
+			return nil, err
+		}
+		if contents != nil {
+			deprecations = append(deprecations, diffDeprecations("Prometheus metric", prometheusMetrics(contents[0]), prometheusMetrics(contents[1]))...)
+		}
+	}
+	apiVersions := make([]string, 0, len(crdTypeFiles))
+	for apiVersion := range crdTypeFiles {
+		apiVersions = append(apiVersions, apiVersion)
+	}
+	sort.Strings(apiVersions)
+	for _, apiVersion := range apiVersions {
+		contents, err := fetch(crdTypeFiles[apiVersion])
+		if err != nil {
+			return nil, err
+		}
+		if contents == nil {
+			continue
+		}
+		for _, d := range diffDeprecations("CRD field", crdFields(contents[0]), crdFields(contents[1])) {
+			d.apiVersion = apiVersion
+			deprecations = append(deprecations, d)
+		}
+	}
+	if len(deprecations) > 0 {
+		log.Printf("%d Prometheus metrics and CRD fields were deprecated or removed since v%s", len(deprecations), fromRelease)
+	}
+	return deprecations, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+const agentMetricsV24 = `var (
+	OVSTotalFlowCount = metrics.NewGauge(&metrics.GaugeOpts{
+		Name:           "ovs_total_flow_count",
+		Help:           "Total flow count of all OVS flow tables.",
+		StabilityLevel: metrics.STABLE,
+	})
+	PodCount = metrics.NewGauge(&metrics.GaugeOpts{
+		Namespace: "antrea",
+		Subsystem: "agent",
+		Name:      "local_pod_count",
+	})
+	EgressCount = metrics.NewGauge(&metrics.GaugeOpts{
+		Name: "egress_count",
+	})
+)
+`
+
+const agentMetricsV25 = `var (
+	OVSTotalFlowCount = metrics.NewGauge(&metrics.GaugeOpts{
+		Name:              "ovs_total_flow_count",
+		Help:              "Total flow count of all OVS flow tables.",
+		StabilityLevel:    metrics.STABLE,
+		DeprecatedVersion: "2.5.0",
+	})
+	PodCount = metrics.NewGauge(&metrics.GaugeOpts{
+		Namespace: "antrea",
+		Subsystem: "agent",
+		Name:      "local_pod_count",
+	})
+)
+`
+
+const egressTypesV24 = `package v1beta1
+
+// EgressSpec defines the desired state for Egress.
+type EgressSpec struct {
+	// EgressIP specifies the SNAT IP address for the selected workloads.
+	EgressIP string ` + "`json:\"egressIP,omitempty\"`" + `
+	// ExternalIPPool specifies the IP Pool that the EgressIP should be allocated from.
+	ExternalIPPool string ` + "`json:\"externalIPPool,omitempty\"`" + `
+	Bandwidth *Bandwidth ` + "`json:\"bandwidth,omitempty\"`" + `
+}
+`
+
+const egressTypesV25 = `package v1beta1
+
+// EgressSpec defines the desired state for Egress.
+type EgressSpec struct {
+	// EgressIP specifies the SNAT IP address for the selected workloads.
+	// Deprecated: use EgressIPs instead.
+	EgressIP string ` + "`json:\"egressIP,omitempty\"`" + `
+	EgressIPs []string ` + "`json:\"egressIPs,omitempty\"`" + `
+	// ExternalIPPool specifies the IP Pool that the EgressIP should be allocated from.
+	ExternalIPPool string ` + "`json:\"externalIPPool,omitempty\"`" + `
+}
+`
+
+func TestPrometheusMetrics(t *testing.T) {
+	assert.Equal(t, map[string]bool{"ovs_total_flow_count": false, "antrea_agent_local_pod_count": false, "egress_count": false}, prometheusMetrics(agentMetricsV24))
+	assert.Equal(t, map[string]bool{"ovs_total_flow_count": true, "antrea_agent_local_pod_count": false}, prometheusMetrics(agentMetricsV25))
+}
+
+func TestCRDFields(t *testing.T) {
+	assert.Equal(t, map[string]bool{"EgressSpec.egressIP": false, "EgressSpec.externalIPPool": false, "EgressSpec.bandwidth": false}, crdFields(egressTypesV24))
+	assert.Equal(t, map[string]bool{"EgressSpec.egressIP": true, "EgressSpec.egressIPs": false, "EgressSpec.externalIPPool": false}, crdFields(egressTypesV25))
+}
+
+func TestDetectDeprecations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/agent/metrics/prometheus.go", "v2.4.0").
+		Return(agentMetricsV24, "sha1", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/agent/metrics/prometheus.go", "main").
+		Return(agentMetricsV25, "sha2", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/controller/metrics/prometheus.go", "v2.4.0").
+		Return("", "", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/apis/crd/v1alpha1/types.go", gomock.Any()).
+		Return("package v1alpha1\n", "sha3", nil).Times(2)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/apis/crd/v1alpha2/types.go", "v2.4.0").
+		Return("", "", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/apis/crd/v1beta1/types.go", "v2.4.0").
+		Return(egressTypesV24, "sha4", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "pkg/apis/crd/v1beta1/types.go", "main").
+		Return(egressTypesV25, "sha5", nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithDeprecationsCheck(true))
+	deprecations, err := generator.detectDeprecations(context.Background(), "2.4.0", "main")
+	require.NoError(t, err)
+	var descriptions []string
+	for _, d := range deprecations {
+		descriptions = append(descriptions, d.String())
+	}
+	assert.Equal(t, []string{
+		"Prometheus metric `egress_count` was removed",
+		"Prometheus metric `ovs_total_flow_count` is deprecated",
+		"CRD field `EgressSpec.bandwidth` (crd.antrea.io/v1beta1) was removed",
+		"CRD field `EgressSpec.egressIP` (crd.antrea.io/v1beta1) is deprecated",
+	}, descriptions)
+}
+
+func TestFormatChangelog_Deprecations(t *testing.T) {
+	changelogText := formatChangelog(version.New(2, 5, 0), &types.ModelResponse{}, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		deprecations: []deprecation{
+			{subject: "Prometheus metric", name: "ovs_total_flow_count"},
+			{subject: "CRD field", name: "EgressSpec.bandwidth", apiVersion: "crd.antrea.io/v1beta1", removed: true},
+		},
+	})
+	assert.Contains(t, changelogText, "### Fixed\n\n\n### Deprecated\n\n"+
+		"- Prometheus metric `ovs_total_flow_count` is deprecated.\n"+
+		"- CRD field `EgressSpec.bandwidth` (crd.antrea.io/v1beta1) was removed.\n\n")
+	assert.NoError(t, Validate(changelogText))
+}
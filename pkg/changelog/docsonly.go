@@ -0,0 +1,108 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// DocsOnlyMode controls how the PRs which only change documentation are handled
+type DocsOnlyMode string
+
+const (
+	// DocsOnlyOff does not detect documentation-only PRs
+	DocsOnlyOff DocsOnlyMode = "off"
+	// DocsOnlyMark marks documentation-only PRs in the prompt, and asks the
+	// model for a low include_score
+	DocsOnlyMark DocsOnlyMode = "mark"
+	// DocsOnlyExclude skips documentation-only PRs before calling the model
+	DocsOnlyExclude DocsOnlyMode = "exclude"
+)
+
+// ParseDocsOnlyMode parses the name of a documentation-only PR mode
+func ParseDocsOnlyMode(s string) (DocsOnlyMode, error) {
+	switch mode := DocsOnlyMode(s); mode {
+	case DocsOnlyOff, DocsOnlyMark, DocsOnlyExclude:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid documentation-only mode %q, must be one of: off, mark, exclude", s)
+}
+
+// docsOnlyPrompt is added to the prompt when some PRs only change documentation
+const docsOnlyPrompt = `## Documentation-Only PRs
+
+Some PRs below only change documentation; they are marked with **Documentation only**. Users read the CHANGELOG to learn
+what changed in the software they upgrade, so these PRs should get a low include_score even if they have the release note
+label. Only include one if it documents a significant user-facing change which no other PR of this release describes.
+
+`
+
+// isDocsOnly returns true if all the files changed by a PR match the
+// documentation paths
+func isDocsOnly(files []string, docsPaths []string) bool {
+	if len(files) == 0 {
+		return false
+	}
+	for _, f := range files {
+		if !matchesPathPatterns(f, docsPaths) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectDocsOnlyPRs finds the PRs which only change documentation, and either
+// skips them or marks them for the model, depending on the mode
+func (g *ChangelogGenerator) detectDocsOnlyPRs(ctx context.Context, prs []types.PRInfo) ([]types.PRInfo, []types.SkippedPR, error) {
+	var filtered []types.PRInfo
+	var skipped []types.SkippedPR
+	for _, pr := range prs {
+		files, err := g.pullRequestFiles(ctx, pr.Number)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list files of PR #%d: %w", pr.Number, err)
+		}
+		if !isDocsOnly(files, g.docsPaths) {
+			filtered = append(filtered, pr)
+			continue
+		}
+		if g.docsOnly == DocsOnlyExclude {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonDocsOnly, fmt.Sprintf("%d documentation files", len(files))))
+			continue
+		}
+		pr.DocsOnly = true
+		filtered = append(filtered, pr)
+	}
+	if g.docsOnly == DocsOnlyExclude {
+		log.Printf("Skipped %d documentation-only PRs", len(skipped))
+	} else {
+		log.Printf("Marked %d documentation-only PRs", countDocsOnly(filtered))
+	}
+	return filtered, skipped, nil
+}
+
+// countDocsOnly returns the number of PRs marked as documentation-only
+func countDocsOnly(prs []types.PRInfo) int {
+	var count int
+	for _, pr := range prs {
+		if pr.DocsOnly {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestIsDocsOnly(t *testing.T) {
+	docsPaths := config.DefaultDocsPaths()
+	assert.True(t, isDocsOnly([]string{"docs/egress.md", "docs/assets/egress.svg"}, docsPaths))
+	assert.True(t, isDocsOnly([]string{"README.md"}, docsPaths))
+	assert.False(t, isDocsOnly([]string{"docs/egress.md", "pkg/agent/egress.go"}, docsPaths))
+	assert.False(t, isDocsOnly(nil, docsPaths))
+}
+
+func TestDetectDocsOnlyPRs(t *testing.T) {
+	prs := []types.PRInfo{{Number: 100, Title: "Document Egress"}, {Number: 101, Title: "Fix agent"}}
+
+	for _, tc := range []struct {
+		mode            DocsOnlyMode
+		expectedNumbers []int
+		expectedSkipped int
+	}{
+		{mode: DocsOnlyMark, expectedNumbers: []int{100, 101}},
+		{mode: DocsOnlyExclude, expectedNumbers: []int{101}, expectedSkipped: 1},
+	} {
+		t.Run(string(tc.mode), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+			mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 100).
+				Return([]string{"docs/egress.md"}, nil)
+			mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 101).
+				Return([]string{"pkg/agent/agent.go", "docs/design/architecture.md"}, nil)
+
+			generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+				WithDocsOnlyPRs(tc.mode, config.DefaultDocsPaths()))
+			filtered, skipped, err := generator.detectDocsOnlyPRs(context.Background(), append([]types.PRInfo(nil), prs...))
+			require.NoError(t, err)
+
+			var numbers []int
+			for _, pr := range filtered {
+				numbers = append(numbers, pr.Number)
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			require.Len(t, skipped, tc.expectedSkipped)
+
+			promptText := generator.buildPrompt("", filtered, nil)
+			if tc.mode == DocsOnlyMark {
+				assert.True(t, filtered[0].DocsOnly)
+				assert.False(t, filtered[1].DocsOnly)
+				assert.Contains(t, promptText, "## Documentation-Only PRs")
+				assert.Contains(t, promptText, "## PR #100\n**Title:** Document Egress\n")
+				assert.Contains(t, promptText, "**Documentation only:** yes\n")
+			} else {
+				assert.Equal(t, types.SkipReasonDocsOnly, skipped[0].Reason)
+				assert.Equal(t, 100, skipped[0].Number)
+				assert.NotContains(t, promptText, "Documentation only")
+			}
+		})
+	}
+}
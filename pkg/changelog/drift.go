@@ -0,0 +1,188 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// detectReleaseDrift compares the PRs of the commits on the branch since the
+// from-release tag with the PRs selected by the generator (before filtering),
+// to catch missed cherry-picks and PRs merged with unusual titles before the
+// model is called. Cherry-pick PRs on the branch stand for their original PRs.
+func (g *ChangelogGenerator) detectReleaseDrift(ctx context.Context, branch, fromRelease string, selected []types.PRInfo) (*types.ReleaseDrift, error) {
+	mapped, err := g.mapCommitsToPRs(ctx, branch, fromRelease)
+	if err != nil {
+		return nil, err
+	}
+	drift := &types.ReleaseDrift{
+		Branch:        branch,
+		FromRelease:   fromRelease,
+		UnusualTitles: mapped.associated,
+		Unmapped:      mapped.unmapped,
+	}
+
+	pulls := make(map[int]*gogithub.PullRequest)
+	fetch := func(numbers []int) error {
+		if len(numbers) == 0 {
+			return nil
+		}
+		fetched, err := g.githubClient.GetPullRequests(ctx, repoOwner, repoName, numbers)
+		if err != nil {
+			return fmt.Errorf("failed to fetch PRs of the release branch: %w", err)
+		}
+		for number, pull := range fetched {
+			pulls[number] = pull
+		}
+		return nil
+	}
+	if err := fetch(mapped.numbers); err != nil {
+		return nil, err
+	}
+
+	// PRs on the branch, with cherry-picks replaced by their original PRs
+	onBranch := make(map[int]bool)
+	var numbers, originals []int
+	for _, number := range mapped.numbers {
+		pull, ok := pulls[number]
+		if !ok {
+			continue
+		}
+		if !hasLabel(pull, "kind/cherry-pick") {
+			onBranch[number] = true
+			numbers = append(numbers, number)
+			continue
+		}
+		for _, m := range cherryPickRegex.FindAllStringSubmatch(pull.GetBody(), -1) {
+			original, err := strconv.Atoi(m[1])
+			if err != nil || onBranch[original] {
+				continue
+			}
+			onBranch[original] = true
+			numbers = append(numbers, original)
+			if _, ok := pulls[original]; !ok {
+				originals = append(originals, original)
+			}
+		}
+	}
+	if err := fetch(originals); err != nil {
+		return nil, err
+	}
+
+	isSelected := make(map[int]bool)
+	for _, pr := range selected {
+		isSelected[pr.Number] = true
+		if !onBranch[pr.Number] {
+			drift.NotOnBranch = append(drift.NotOnBranch, types.DriftPR{Number: pr.Number, Title: pr.Title, Author: pr.Author})
+		}
+	}
+	for _, number := range numbers {
+		pull, ok := pulls[number]
+		if !ok || isSelected[number] || (!g.all && !hasLabel(pull, releaseNoteLabel)) {
+			continue
+		}
+		drift.NotSelected = append(drift.NotSelected, types.DriftPR{Number: number, Title: pull.GetTitle(), Author: prAuthor(pull)})
+	}
+	sort.Slice(drift.NotOnBranch, func(i, j int) bool {
+		return drift.NotOnBranch[i].Number < drift.NotOnBranch[j].Number
+	})
+	sort.Slice(drift.NotSelected, func(i, j int) bool {
+		return drift.NotSelected[i].Number < drift.NotSelected[j].Number
+	})
+
+	for _, pr := range drift.NotSelected {
+		log.Printf("Warning: PR #%d is on %s since v%s but was not selected: %s", pr.Number, branch, fromRelease, pr.Title)
+	}
+	for _, pr := range drift.NotOnBranch {
+		log.Printf("Warning: PR #%d was selected but is not on %s since v%s: %s", pr.Number, branch, fromRelease, pr.Title)
+	}
+	for _, c := range drift.Unmapped {
+		log.Printf("Warning: commit %s on %s could not be mapped to a merged PR: %s", c.SHA, branch, c.Title)
+	}
+	return drift, nil
+}
+
+func hasLabel(pull *gogithub.PullRequest, name string) bool {
+	for _, l := range pull.Labels {
+		if l.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatDriftReport renders the release drift audit as a markdown report for
+// the release manager
+func FormatDriftReport(release string, drift *types.ReleaseDrift) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Release drift of the %s CHANGELOG\n\n", release))
+	sb.WriteString(fmt.Sprintf("Comparison of the PRs of the commits on `%s` since `v%s` with the PRs selected for the CHANGELOG.\n\n", drift.Branch, drift.FromRelease))
+	if drift.Discrepancies() == 0 && len(drift.UnusualTitles) == 0 {
+		sb.WriteString("No discrepancies were found.\n")
+		return sb.String()
+	}
+
+	writePRs := func(title, explanation string, prs []types.DriftPR) {
+		if len(prs) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("## %s\n\n%s\n\n", title, explanation))
+		sb.WriteString("| PR | Title | Author |\n")
+		sb.WriteString("|----|-------|--------|\n")
+		for _, pr := range prs {
+			sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s |\n",
+				pr.Number, repoOwner, repoName, pr.Number, escapeTableCell(pr.Title), escapeTableCell(pr.Author)))
+		}
+		sb.WriteString("\n")
+	}
+	writeCommits := func(title, explanation string, commits []types.DriftCommit) {
+		if len(commits) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("## %s\n\n%s\n\n", title, explanation))
+		sb.WriteString("| Commit | Title | PRs |\n")
+		sb.WriteString("|--------|-------|-----|\n")
+		for _, c := range commits {
+			var prs []string
+			for _, number := range c.PRs {
+				prs = append(prs, fmt.Sprintf("#%d", number))
+			}
+			sb.WriteString(fmt.Sprintf("| %.12s | %s | %s |\n", c.SHA, escapeTableCell(c.Title), strings.Join(prs, " ")))
+		}
+		sb.WriteString("\n")
+	}
+	writePRs("On the branch but not selected",
+		"These PRs are on the branch, but were not selected for the CHANGELOG, e.g. cherry-picks without the kind/cherry-pick label or PRs merged before the from-release was tagged.",
+		drift.NotSelected)
+	writePRs("Selected but not on the branch",
+		"These PRs were selected for the CHANGELOG, but are not on the branch since the from-release, e.g. missed or reverted cherry-picks.",
+		drift.NotOnBranch)
+	writeCommits("Commits without a PR",
+		"These commits could not be mapped to a merged PR, e.g. because they were pushed directly to the branch.",
+		drift.Unmapped)
+	writeCommits("Commits with unusual titles",
+		"These commits have no PR number in their title and were mapped with the commit association API.",
+		drift.UnusualTitles)
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDetectReleaseDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cherryPick := newMergedPR(201, "kind/cherry-pick")
+	cherryPick.Body = gogithub.Ptr("Cherry pick of #100 on release-2.4.")
+	unlabeledCherryPick := newMergedPR(202, "action/release-note")
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().
+		CompareCommits(gomock.Any(), "antrea-io", "antrea", "v2.4.0", "release-2.4").
+		Return([]*gogithub.RepositoryCommit{
+			newRepositoryCommit("a1", "Automated cherry pick of #100 (#201)"),
+			newRepositoryCommit("b2", "Cherry pick of #101 (#202)"),
+			newRepositoryCommit("c3", "Update version file"),
+			newRepositoryCommit("d4", "Fix flaky test"),
+		}, nil)
+	mockGitHubClient.EXPECT().
+		ListPullRequestsWithCommit(gomock.Any(), "antrea-io", "antrea", "c3").
+		Return(nil, nil)
+	mockGitHubClient.EXPECT().
+		ListPullRequestsWithCommit(gomock.Any(), "antrea-io", "antrea", "d4").
+		Return([]*gogithub.PullRequest{newMergedPR(203)}, nil)
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{201, 202, 203}).
+		Return(map[int]*gogithub.PullRequest{201: cherryPick, 202: unlabeledCherryPick, 203: newMergedPR(203)}, nil)
+	// Original PRs of the cherry-picks are fetched for their labels
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{100}).
+		Return(map[int]*gogithub.PullRequest{100: newMergedPR(100, "action/release-note")}, nil)
+
+	generator := NewChangelogGenerator("2.4.1", "", false, "gemini-2.5-flash", nil, mockGitHubClient)
+	selected := []types.PRInfo{
+		{Number: 100, Title: "Fix crash"},
+		{Number: 110, Title: "Fix leak"},
+	}
+	drift, err := generator.detectReleaseDrift(context.Background(), "release-2.4", "2.4.0", selected)
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.DriftPR{{Number: 202, Title: "PR title", Author: "author"}}, drift.NotSelected, "The cherry-pick without the kind/cherry-pick label should be reported")
+	assert.Equal(t, []types.DriftPR{{Number: 110, Title: "Fix leak"}}, drift.NotOnBranch, "The selected PR without a cherry-pick on the branch should be reported")
+	assert.Equal(t, []types.DriftCommit{{SHA: "c3", Title: "Update version file"}}, drift.Unmapped)
+	assert.Equal(t, []types.DriftCommit{{SHA: "d4", Title: "Fix flaky test", PRs: []int{203}}}, drift.UnusualTitles)
+	assert.Equal(t, 3, drift.Discrepancies())
+
+	report := FormatDriftReport("2.4.1", drift)
+	assert.Contains(t, report, "Comparison of the PRs of the commits on `release-2.4` since `v2.4.0`")
+	assert.Contains(t, report, "| [#110](https://github.com/antrea-io/antrea/pull/110) | Fix leak |  |\n")
+	assert.Contains(t, report, "| d4 | Fix flaky test | #203 |\n")
+	assert.Equal(t, "# Release drift of the 2.4.1 CHANGELOG\n\nComparison of the PRs of the commits on `main` since `v2.4.0` with the PRs selected for the CHANGELOG.\n\nNo discrepancies were found.\n",
+		FormatDriftReport("2.4.1", &types.ReleaseDrift{Branch: "main", FromRelease: "2.4.0"}))
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/testsupport"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// TestGenerate_FakeGitHub generates a minor release changelog with the real
+// GitHub client, against a fake server paginating PRs 2 by 2
+func TestGenerate_FakeGitHub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+
+	fake := testsupport.NewFakeGitHub(t, "antrea-io", "antrea")
+	fake.MaxPerPage = 2
+	releaseTime := time.Now().Add(-30 * 24 * time.Hour)
+	fake.AddTag("v2.3.0", &gogithub.Commit{SHA: gogithub.Ptr("sha230"), Committer: &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: releaseTime.Add(-60 * 24 * time.Hour)}}})
+	fake.AddTag("v2.4.0", &gogithub.Commit{SHA: gogithub.Ptr("sha240"), Committer: &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: releaseTime}}})
+	fake.AddFile("", "CHANGELOG/CHANGELOG-2.4.md", "## 2.4.0 - 2025-01-01\n\n### Fixed\n\n- Fix bug Z. ([#2222](https://github.com/antrea-io/antrea/pull/2222), [@oldauthor])\n")
+
+	// PRs 1001 to 1005 are merged after the from-release, 1000 before
+	for i := 0; i <= 5; i++ {
+		mergedAt := releaseTime.Add(time.Duration(i) * time.Hour)
+		if i == 0 {
+			mergedAt = releaseTime.Add(-time.Hour)
+		}
+		fake.AddPullRequest(&gogithub.PullRequest{
+			Number:         gogithub.Ptr(1000 + i),
+			Title:          gogithub.Ptr(fmt.Sprintf("Change %d", i)),
+			State:          gogithub.Ptr("closed"),
+			User:           &gogithub.User{Login: gogithub.Ptr("alice")},
+			Base:           &gogithub.PullRequestBranch{Ref: gogithub.Ptr("main")},
+			Labels:         []*gogithub.Label{{Name: gogithub.Ptr("action/release-note")}},
+			MergedAt:       &gogithub.Timestamp{Time: mergedAt},
+			MergeCommitSHA: gogithub.Ptr(fmt.Sprintf("merge%d", i)),
+		})
+	}
+
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		DoAndReturn(func(_ context.Context, prompt, _, _ string) (*types.ModelResponse, *types.ModelDetails, error) {
+			assert.Contains(t, prompt, "Change 5")
+			assert.NotContains(t, prompt, "Change 0")
+			return &types.ModelResponse{Changes: []types.ChangeEntry{
+				{PRNumber: 1005, Category: "ADDED", Description: "Add change 5", IncludeScore: 90, ImportanceScore: 90},
+			}}, &types.ModelDetails{Version: "2.5.0", Model: "gemini-2.5-flash"}, nil
+		})
+
+	githubClient := github.NewClient(context.Background(), "", github.WithBaseURL(fake.URL()))
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, githubClient)
+	changelogText, promptData, _, _, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+
+	assert.Contains(t, changelogText, "- Add change 5. ([#1005](https://github.com/antrea-io/antrea/pull/1005), [@alice])\n")
+	assert.Contains(t, promptData.Text, "Fix bug Z")
+	var pullListings int
+	for _, request := range fake.Requests() {
+		if strings.HasPrefix(request, "GET /repos/antrea-io/antrea/pulls?") {
+			pullListings++
+		}
+	}
+	assert.Equal(t, 3, pullListings, "PRs should be listed in pages of 2 until the from-release")
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// titleFallbackComment marks the entries using the PR title, so that
+// reviewers rewrite them
+const titleFallbackComment = "<!-- TODO: PR title, the generated description had a very low confidence -->"
+
+// applyTitleFallbacks replaces the descriptions whose description_confidence
+// is below minConfidence with the title of their PR: reviewers prefer editing
+// accurate but plain text over plausible but wrong text. Entries reused from
+// history and entries without a description_confidence are kept. It returns
+// the PRs whose description was replaced.
+func applyTitleFallbacks(response *types.ModelResponse, prs []types.PRInfo, minConfidence int) []int {
+	titles := make(map[int]string, len(prs))
+	for _, pr := range prs {
+		titles[pr.Number] = pr.Title
+	}
+
+	var replaced []int
+	for i := range response.Changes {
+		change := &response.Changes[i]
+		if change.ReusedFromHistory || change.TitleFallback || change.DescriptionConfidence == nil || *change.DescriptionConfidence >= minConfidence {
+			continue
+		}
+		title := plainTitle(titles[change.PRNumber])
+		if title == "" {
+			continue
+		}
+		change.Description = title
+		change.TitleFallback = true
+		replaced = append(replaced, change.PRNumber)
+	}
+	return replaced
+}
+
+// plainTitle returns a PR title usable as a description: without the
+// cherry-pick prefix and without the trailing period, which is added when
+// formatting
+func plainTitle(title string) string {
+	title = strings.TrimSpace(title)
+	if loc := cherryPickTitleRegex.FindStringIndex(title); loc != nil {
+		if i := strings.Index(title[loc[1]:], ":"); i >= 0 {
+			title = strings.TrimSpace(title[loc[1]+i+1:])
+		}
+	}
+	return strings.TrimSpace(strings.TrimSuffix(title, "."))
+}
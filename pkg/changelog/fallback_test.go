@@ -0,0 +1,72 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestApplyTitleFallbacks(t *testing.T) {
+	confidence := func(c int) *int { return &c }
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Fix Egress IP leak on Node restart."},
+		{Number: 101, Title: "Automated cherry pick of #90 #91: Support IPv6 in FlowExporter"},
+		{Number: 102, Title: "Add NodeLatencyMonitor"},
+		{Number: 103, Title: "Bump OVS"},
+		{Number: 104, Title: "Add L7 logging"},
+	}
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Description: "Fix Egress IP allocation when the Node has many interfaces", DescriptionConfidence: confidence(10)},
+		{PRNumber: 101, Description: "Add IPv6 to everything", DescriptionConfidence: confidence(29)},
+		{PRNumber: 102, Description: "Add NodeLatencyMonitor to measure latency between Nodes", DescriptionConfidence: confidence(30)},
+		{PRNumber: 103, Description: "Upgrade OVS to v3.0", ReusedFromHistory: true, DescriptionConfidence: confidence(5)},
+		{PRNumber: 104, Description: "Add L7 NetworkPolicy logging"},
+	}}
+
+	replaced := applyTitleFallbacks(response, prs, 30)
+	assert.Equal(t, []int{100, 101}, replaced)
+	assert.Equal(t, "Fix Egress IP leak on Node restart", response.Changes[0].Description)
+	assert.True(t, response.Changes[0].TitleFallback)
+	assert.Equal(t, "Support IPv6 in FlowExporter", response.Changes[1].Description)
+	for _, change := range response.Changes[2:] {
+		assert.False(t, change.TitleFallback, "PR #%d", change.PRNumber)
+	}
+	assert.Equal(t, "Upgrade OVS to v3.0", response.Changes[3].Description)
+}
+
+func TestFormatChangelog_TitleFallback(t *testing.T) {
+	ver, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "FIXED", Description: "Fix Egress IP leak on Node restart", IncludeScore: 80, Author: "alice", TitleFallback: true},
+	}}
+	opts := formatOptions{
+		categories: config.DefaultCategories(),
+		thresholds: config.DefaultThresholds(),
+		date:       time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	changelogText := formatChangelog(ver, response, opts)
+	require.NoError(t, Validate(changelogText))
+	assert.Contains(t, changelogText, "- Fix Egress IP leak on Node restart. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice]) "+titleFallbackComment+"\n")
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// maxPromptCorrections is the number of most recent corrections included in
+// the prompt
+const maxPromptCorrections = 30
+
+// CollectCorrections compares the entries of a generated draft with the
+// entries of the merged CHANGELOG for a release, and returns the entries which
+// were edited, added or removed by hand, sorted by PR number
+func CollectCorrections(release, draft, merged string, categories []config.Category) []types.Correction {
+	entries := func(content string) map[int]types.HistoricalPR {
+		all := make(map[int]types.HistoricalPR)
+		parseCHANGELOGEntries(content, categories, all)
+		for number, entry := range all {
+			if entry.Release != release {
+				delete(all, number)
+			}
+		}
+		return all
+	}
+	before := entries(draft)
+	after := entries(merged)
+
+	var corrections []types.Correction
+	for number, b := range before {
+		a, ok := after[number]
+		switch {
+		case !ok:
+			corrections = append(corrections, types.Correction{Release: release, PRNumber: number, Before: correctedEntry(b)})
+		case a.Category != b.Category || a.Description != b.Description:
+			corrections = append(corrections, types.Correction{Release: release, PRNumber: number, Before: correctedEntry(b), After: correctedEntry(a)})
+		}
+	}
+	for number, a := range after {
+		if _, ok := before[number]; !ok {
+			corrections = append(corrections, types.Correction{Release: release, PRNumber: number, After: correctedEntry(a)})
+		}
+	}
+	sort.Slice(corrections, func(i, j int) bool {
+		return corrections[i].PRNumber < corrections[j].PRNumber
+	})
+	return corrections
+}
+
+func correctedEntry(entry types.HistoricalPR) *types.CorrectedEntry {
+	return &types.CorrectedEntry{Category: entry.Category, Description: entry.Description}
+}
+
+// LoadCorrections reads a corrections dataset (one JSON correction per line).
+// A missing dataset has no corrections.
+func LoadCorrections(path string) ([]types.Correction, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read corrections dataset: %w", err)
+	}
+	var corrections []types.Correction
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var c types.Correction
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			return nil, fmt.Errorf("failed to parse corrections dataset %s, line %d: %w", path, line, err)
+		}
+		corrections = append(corrections, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read corrections dataset: %w", err)
+	}
+	return corrections, nil
+}
+
+// AppendCorrections appends corrections to a dataset, skipping the PRs of a
+// release which are already recorded so that feedback can be collected again,
+// and returns the number of corrections appended
+func AppendCorrections(path string, corrections []types.Correction) (int, error) {
+	existing, err := LoadCorrections(path)
+	if err != nil {
+		return 0, err
+	}
+	recorded := make(map[string]bool)
+	for _, c := range existing {
+		recorded[fmt.Sprintf("%s#%d", c.Release, c.PRNumber)] = true
+	}
+	var buf bytes.Buffer
+	appended := 0
+	for _, c := range corrections {
+		if recorded[fmt.Sprintf("%s#%d", c.Release, c.PRNumber)] {
+			continue
+		}
+		line, err := json.Marshal(c)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal correction: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteString("\n")
+		appended++
+	}
+	if appended == 0 {
+		return 0, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open corrections dataset: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return 0, fmt.Errorf("failed to write corrections dataset: %w", err)
+	}
+	return appended, nil
+}
+
+// correctionsPrompt returns the prompt section with the most recent
+// corrections, as examples of how release managers edit the generated entries
+func correctionsPrompt(corrections []types.Correction) string {
+	if len(corrections) > maxPromptCorrections {
+		corrections = corrections[len(corrections)-maxPromptCorrections:]
+	}
+	var sb strings.Builder
+	sb.WriteString(`## Corrections from Past Reviews
+
+Release managers edited the following generated entries of past releases before merging them. Learn from these
+corrections: avoid the mistakes of the removed and edited entries, and follow the style of the corrected ones.
+
+`)
+	for _, c := range corrections {
+		switch {
+		case c.After == nil:
+			sb.WriteString(fmt.Sprintf("- #%d (%s), removed: %s %q\n", c.PRNumber, c.Release, c.Before.Category, c.Before.Description))
+		case c.Before == nil:
+			sb.WriteString(fmt.Sprintf("- #%d (%s), added by hand: %s %q\n", c.PRNumber, c.Release, c.After.Category, c.After.Description))
+		default:
+			sb.WriteString(fmt.Sprintf("- #%d (%s), generated: %s %q, corrected: %s %q\n", c.PRNumber, c.Release, c.Before.Category, c.Before.Description, c.After.Category, c.After.Description))
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const feedbackDraft = `## 2.5.0 - 2025-01-28
+
+### Added
+
+- Add Egress support for Windows. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+- *OPTIONAL* Add a flag to the agent. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])
+
+### Fixed
+
+- Fix a crash. ([#102](https://github.com/antrea-io/antrea/pull/102), [@carol])
+- Fix test flakes. ([#103](https://github.com/antrea-io/antrea/pull/103), [@carol])
+`
+
+const feedbackMerged = `# Changelog 2.5
+
+## 2.5.1 - 2025-02-15
+
+### Fixed
+
+- Fix a crash in the Egress controller. ([#110](https://github.com/antrea-io/antrea/pull/110), [@carol])
+
+## 2.5.0 - 2025-01-30
+
+### Added
+
+- Add Egress support for Windows. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+### Changed
+
+- Add the --sync-period flag to the agent. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])
+
+### Fixed
+
+- Fix a crash of the agent when the Egress IP is deleted. ([#102](https://github.com/antrea-io/antrea/pull/102), [@carol])
+- Fix NodePortLocal rules. ([#104](https://github.com/antrea-io/antrea/pull/104), [@dave])
+`
+
+func TestCollectCorrections(t *testing.T) {
+	corrections := CollectCorrections("2.5.0", feedbackDraft, feedbackMerged, config.DefaultCategories())
+	assert.Equal(t, []types.Correction{
+		{
+			Release:  "2.5.0",
+			PRNumber: 101,
+			Before:   &types.CorrectedEntry{Category: "ADDED", Description: "Add a flag to the agent"},
+			After:    &types.CorrectedEntry{Category: "CHANGED", Description: "Add the --sync-period flag to the agent"},
+		},
+		{
+			Release:  "2.5.0",
+			PRNumber: 102,
+			Before:   &types.CorrectedEntry{Category: "FIXED", Description: "Fix a crash"},
+			After:    &types.CorrectedEntry{Category: "FIXED", Description: "Fix a crash of the agent when the Egress IP is deleted"},
+		},
+		{
+			Release:  "2.5.0",
+			PRNumber: 103,
+			Before:   &types.CorrectedEntry{Category: "FIXED", Description: "Fix test flakes"},
+		},
+		{
+			Release:  "2.5.0",
+			PRNumber: 104,
+			After:    &types.CorrectedEntry{Category: "FIXED", Description: "Fix NodePortLocal rules"},
+		},
+	}, corrections)
+}
+
+func TestAppendCorrections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corrections.jsonl")
+	loaded, err := LoadCorrections(path)
+	require.NoError(t, err)
+	assert.Empty(t, loaded)
+
+	corrections := CollectCorrections("2.5.0", feedbackDraft, feedbackMerged, config.DefaultCategories())
+	appended, err := AppendCorrections(path, corrections[:2])
+	require.NoError(t, err)
+	assert.Equal(t, 2, appended)
+
+	// Corrections already recorded are skipped
+	appended, err = AppendCorrections(path, corrections)
+	require.NoError(t, err)
+	assert.Equal(t, 2, appended)
+
+	loaded, err = LoadCorrections(path)
+	require.NoError(t, err)
+	assert.Equal(t, corrections, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("{\"release\": \"2.5.0\"}\nnot json\n"), 0600))
+	_, err = LoadCorrections(path)
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestCorrectionsPrompt(t *testing.T) {
+	corrections := CollectCorrections("2.5.0", feedbackDraft, feedbackMerged, config.DefaultCategories())
+	generator := NewChangelogGenerator("2.6.0", "", false, "gemini-2.5-flash", nil, nil, WithCorrections(corrections))
+	promptText := generator.buildPrompt("", nil, nil)
+	assert.Contains(t, promptText, "## Corrections from Past Reviews")
+	assert.Contains(t, promptText, `- #101 (2.5.0), generated: ADDED "Add a flag to the agent", corrected: CHANGED "Add the --sync-period flag to the agent"`)
+	assert.Contains(t, promptText, `- #103 (2.5.0), removed: FIXED "Fix test flakes"`)
+	assert.Contains(t, promptText, `- #104 (2.5.0), added by hand: FIXED "Fix NodePortLocal rules"`)
+
+	many := make([]types.Correction, 40)
+	for i := range many {
+		many[i] = types.Correction{Release: "2.4.0", PRNumber: i + 1, Before: &types.CorrectedEntry{Category: "FIXED", Description: "Fix"}}
+	}
+	promptText = correctionsPrompt(many)
+	assert.NotContains(t, promptText, "#10 (2.4.0)")
+	assert.Contains(t, promptText, "#11 (2.4.0)")
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// PRFilter is a filter expression selecting the PRs sent to the model, e.g.
+// "label:area/agent AND NOT label:kind/documentation". Expressions combine
+// terms with AND, OR, NOT and parentheses, NOT binding tighter than AND, and
+// AND tighter than OR. The supported terms are:
+//   - label:<pattern>, matching PRs with a label matching the glob pattern
+//   - author:<login>, matching PRs authored by login
+//   - title:<text>, matching PRs whose title contains text (case-insensitive)
+//
+// Values containing spaces can be quoted, e.g. title:"network policy".
+type PRFilter struct {
+	source string
+	root   filterNode
+}
+
+type filterNode interface {
+	match(pr types.PRInfo) bool
+}
+
+type andNode struct{ left, right filterNode }
+
+func (n andNode) match(pr types.PRInfo) bool { return n.left.match(pr) && n.right.match(pr) }
+
+type orNode struct{ left, right filterNode }
+
+func (n orNode) match(pr types.PRInfo) bool { return n.left.match(pr) || n.right.match(pr) }
+
+type notNode struct{ operand filterNode }
+
+func (n notNode) match(pr types.PRInfo) bool { return !n.operand.match(pr) }
+
+type termNode struct{ key, value string }
+
+func (n termNode) match(pr types.PRInfo) bool {
+	switch n.key {
+	case "label":
+		for _, l := range pr.Labels {
+			if ok, _ := path.Match(n.value, l); ok {
+				return true
+			}
+		}
+		return false
+	case "author":
+		return strings.EqualFold(pr.Author, n.value)
+	default: // title
+		return strings.Contains(strings.ToLower(pr.Title), strings.ToLower(n.value))
+	}
+}
+
+// ParsePRFilter parses a filter expression
+func ParsePRFilter(expression string) (*PRFilter, error) {
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PR filter %q: %w", expression, err)
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("invalid PR filter %q: empty expression", expression)
+	}
+	p := &filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid PR filter %q: %w", expression, err)
+	}
+	return &PRFilter{source: expression, root: root}, nil
+}
+
+// Match returns true if the PR matches the filter
+func (f *PRFilter) Match(pr types.PRInfo) bool {
+	return f.root.match(pr)
+}
+
+func (f *PRFilter) String() string {
+	return f.source
+}
+
+// filterToken is a parenthesis, an operator or a term. Quoted words are never
+// operators.
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, filterToken{text: string(r)})
+			i++
+		default:
+			var sb strings.Builder
+			inQuote, quoted := false, false
+			for ; i < len(runes); i++ {
+				r := runes[i]
+				if r == '"' {
+					inQuote = !inQuote
+					quoted = true
+					continue
+				}
+				if !inQuote && (unicode.IsSpace(r) || r == '(' || r == ')') {
+					break
+				}
+				sb.WriteRune(r)
+			}
+			if inQuote {
+				return nil, fmt.Errorf("unterminated quote")
+			}
+			tokens = append(tokens, filterToken{text: sb.String(), quoted: quoted})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// accept consumes the next token if it is the operator or parenthesis op
+func (p *filterParser) accept(op string) bool {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && strings.EqualFold(p.tokens[p.pos].text, op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterNode, error) {
+	if p.accept("NOT") {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *filterParser) parsePrimary() (filterNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	if p.accept("(") {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return node, nil
+	}
+	token := p.tokens[p.pos]
+	key, value, ok := strings.Cut(token.text, ":")
+	if !ok || value == "" {
+		return nil, fmt.Errorf("expected a term such as label:<pattern>, got %q", token.text)
+	}
+	key = strings.ToLower(key)
+	switch key {
+	case "label":
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid label pattern %q: %w", value, err)
+		}
+	case "author", "title":
+	default:
+		return nil, fmt.Errorf("unknown term %q, expected label, author or title", key)
+	}
+	p.pos++
+	return termNode{key: key, value: value}, nil
+}
+
+// filterByExpression filters out PRs which do not match the filter
+func filterByExpression(prs []types.PRInfo, filter *PRFilter) ([]types.PRInfo, []types.SkippedPR) {
+	if filter == nil {
+		return prs, nil
+	}
+	filtered := make([]types.PRInfo, 0, len(prs))
+	var skipped []types.SkippedPR
+	for _, pr := range prs {
+		if !filter.Match(pr) {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonFilter, filter.String()))
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered, skipped
+}
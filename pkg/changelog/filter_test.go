@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestPRFilter(t *testing.T) {
+	agentPR := types.PRInfo{Number: 1, Title: "Fix NetworkPolicy realization", Author: "alice", Labels: []string{"area/agent", "kind/bug"}}
+	docsPR := types.PRInfo{Number: 2, Title: "Document Egress", Author: "bob", Labels: []string{"area/agent", "kind/documentation"}}
+	controllerPR := types.PRInfo{Number: 3, Title: "Add Multicast stats", Author: "carol", Labels: []string{"area/controller"}}
+	prs := []types.PRInfo{agentPR, docsPR, controllerPR}
+
+	tests := []struct {
+		expression string
+		expected   []int
+	}{
+		{"label:area/agent", []int{1, 2}},
+		{"label:area/agent AND NOT label:kind/documentation", []int{1}},
+		{"label:area/* and not label:kind/*", []int{3}},
+		{"label:kind/bug OR label:area/controller", []int{1, 3}},
+		// AND binds tighter than OR
+		{"label:area/controller OR label:area/agent AND author:bob", []int{2, 3}},
+		{"(label:area/controller OR label:area/agent) AND author:bob", []int{2}},
+		{"NOT NOT author:ALICE", []int{1}},
+		{`title:"networkpolicy realization"`, []int{1}},
+		{"title:egress", []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			filter, err := ParsePRFilter(tt.expression)
+			require.NoError(t, err)
+			filtered, skipped := filterByExpression(prs, filter)
+			var numbers []int
+			for _, pr := range filtered {
+				numbers = append(numbers, pr.Number)
+			}
+			assert.Equal(t, tt.expected, numbers)
+			assert.Len(t, skipped, len(prs)-len(filtered))
+			for _, s := range skipped {
+				assert.Equal(t, types.SkipReasonFilter, s.Reason)
+				assert.Equal(t, tt.expression, s.Detail)
+			}
+		})
+	}
+}
+
+func TestParsePRFilter_Errors(t *testing.T) {
+	for expression, expectedErr := range map[string]string{
+		"":                         "empty expression",
+		"label:area/agent AND":     "unexpected end of expression",
+		"(label:area/agent":        "missing closing parenthesis",
+		"label:area/agent)":        `unexpected ")"`,
+		"area/agent":               "expected a term",
+		"milestone:v2.5":           `unknown term "milestone"`,
+		`title:"network policy`:    "unterminated quote",
+		"label:[area":              "invalid label pattern",
+		"label:a label:b":          `unexpected "label:b"`,
+		"label:area/agent OR NOT ": "unexpected end of expression",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParsePRFilter(expression)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), expectedErr)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// followUpRegex matches the reference of a PR to the PR it follows up on, e.g.
+// "Follow-up to #7100", "Followup of https://github.com/antrea-io/antrea/pull/7100"
+// or "Fix a bug introduced in #7100"
+var followUpRegex = regexp.MustCompile(`(?i)\b(?:follow[- ]?up(?:\s+(?:to|of|for|on))?|introduced\s+(?:in|by))\s*:?\s*(?:https://github\.com/antrea-io/antrea/pull/|antrea-io/antrea#|#)(\d+)\b`)
+
+// followUpsPrompt is added to the prompt when some PRs follow up on another PR
+const followUpsPrompt = `## Follow-Up PRs
+
+Some PRs below follow up on another PR of this release (e.g. fixing or completing a new feature before it is released); they
+are marked with **Follow-up of**. Maintainers list such a change once: describe the whole change in the entry of the original
+PR, and do not describe the follow-up PRs separately unless they are a distinct user-visible change. Follow-up PRs are grouped
+with the original PR in the CHANGELOG.
+
+`
+
+// detectFollowUps sets FollowUpOf for the PRs following up on another PR of
+// the release. Chains of follow-ups are resolved to their first PR, so that a
+// feature and all its follow-ups form a single group.
+func detectFollowUps(prs []types.PRInfo) {
+	inRelease := make(map[int]bool, len(prs))
+	for _, pr := range prs {
+		inRelease[pr.Number] = true
+	}
+	direct := make(map[int]int)
+	for _, pr := range prs {
+		for _, m := range followUpRegex.FindAllStringSubmatch(pr.Title+"\n"+pr.Body, -1) {
+			number, _ := strconv.Atoi(m[1])
+			if number != pr.Number && inRelease[number] {
+				direct[pr.Number] = number
+				break
+			}
+		}
+	}
+
+	var count int
+	for i := range prs {
+		original, ok := direct[prs[i].Number]
+		if !ok {
+			continue
+		}
+		// Follow the chain to its first PR, PRs in a cycle are left alone
+		seen := map[int]bool{prs[i].Number: true}
+		cycle := false
+		for {
+			seen[original] = true
+			next, ok := direct[original]
+			if !ok {
+				break
+			}
+			if seen[next] {
+				cycle = true
+				break
+			}
+			original = next
+		}
+		if cycle {
+			continue
+		}
+		prs[i].FollowUpOf = original
+		count++
+	}
+	if count > 0 {
+		log.Printf("Found %d follow-up PRs of other PRs of the release", count)
+	}
+}
+
+// groupFollowUps groups the entries of follow-up PRs with the entry of the PR
+// they follow up on, whatever their include_score, so that the change is
+// listed once with all its PR links. Follow-ups of PRs which are not rendered,
+// and PRs with a historical entry, which must be reused as is, are left alone.
+func groupFollowUps(response *types.ModelResponse, prs []types.PRInfo, prCache map[int]types.HistoricalPR, thresholds config.Thresholds) {
+	followUpOf := make(map[int]int)
+	for _, pr := range prs {
+		if pr.FollowUpOf != 0 {
+			followUpOf[pr.Number] = pr.FollowUpOf
+		}
+	}
+	if len(followUpOf) == 0 {
+		return
+	}
+	originals := make(map[int]int)
+	for i, change := range response.Changes {
+		originals[change.PRNumber] = i
+	}
+
+	grouped := make(map[int]bool)
+	for _, change := range response.Changes {
+		original, ok := followUpOf[change.PRNumber]
+		if !ok {
+			continue
+		}
+		idx, ok := originals[original]
+		if !ok {
+			continue
+		}
+		primary := &response.Changes[idx]
+		if primary.IncludeScore < thresholds.Optional {
+			continue
+		}
+		_, followUpReleased := prCache[change.PRNumber]
+		_, originalReleased := prCache[original]
+		if followUpReleased || originalReleased {
+			continue
+		}
+		log.Printf("Grouping follow-up PR #%d with PR #%d", change.PRNumber, original)
+		primary.GroupedWith = append(primary.GroupedWith, append([]int{change.PRNumber}, change.GroupedWith...)...)
+		primary.GroupedAuthors = append(primary.GroupedAuthors, append([]string{change.Author}, change.GroupedAuthors...)...)
+		grouped[change.PRNumber] = true
+	}
+
+	kept := response.Changes[:0]
+	for _, change := range response.Changes {
+		if !grouped[change.PRNumber] {
+			kept = append(kept, change)
+		}
+	}
+	response.Changes = kept
+}
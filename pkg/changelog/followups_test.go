@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDetectFollowUps(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Add the Egress bandwidth limit"},
+		{Number: 101, Title: "Fix the Egress bandwidth limit validation", Body: "Follow-up to #100."},
+		{Number: 102, Title: "Document the Egress bandwidth limit", Body: "Followup of https://github.com/antrea-io/antrea/pull/101"},
+		{Number: 103, Title: "Fix a crash introduced in #50"},
+		{Number: 104, Title: "Fix A", Body: "Follow up on #105"},
+		{Number: 105, Title: "Fix B", Body: "Follow up on #104"},
+	}
+
+	detectFollowUps(prs)
+
+	followUpOf := make(map[int]int)
+	for _, pr := range prs {
+		followUpOf[pr.Number] = pr.FollowUpOf
+	}
+	assert.Equal(t, map[int]int{100: 0, 101: 100, 102: 100, 103: 0, 104: 0, 105: 0}, followUpOf,
+		"chains should be resolved to their first PR, and references outside of the release and cycles ignored")
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil)
+	promptText := generator.buildPrompt("", prs, nil)
+	assert.Contains(t, promptText, "## Follow-Up PRs")
+	assert.Contains(t, promptText, "**Follow-up of:** #100\n")
+}
+
+func TestGroupFollowUps(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100},
+		{Number: 101, FollowUpOf: 100},
+		{Number: 102, FollowUpOf: 100},
+		{Number: 200},
+		{Number: 201, FollowUpOf: 200},
+		{Number: 300},
+		{Number: 301, FollowUpOf: 300},
+	}
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Author: "alice"},
+		{PRNumber: 101, Category: "FIXED", IncludeScore: 60, Author: "bob"},
+		{PRNumber: 102, Category: "CHANGED", IncludeScore: 10, Author: "alice"},
+		{PRNumber: 200, Category: "ADDED", IncludeScore: 5, Author: "carol"},
+		{PRNumber: 201, Category: "FIXED", IncludeScore: 80, Author: "carol"},
+		{PRNumber: 300, Category: "FIXED", IncludeScore: 80, Author: "dave"},
+		{PRNumber: 301, Category: "FIXED", IncludeScore: 80, Author: "dave"},
+	}}
+	prCache := map[int]types.HistoricalPR{301: {Description: "Fix C", Category: "FIXED", Release: "2.4.1"}}
+
+	groupFollowUps(response, prs, prCache, config.DefaultThresholds())
+
+	var numbers []int
+	for _, change := range response.Changes {
+		numbers = append(numbers, change.PRNumber)
+	}
+	assert.Equal(t, []int{100, 200, 201, 300, 301}, numbers,
+		"follow-ups of unrendered PRs and released follow-ups should not be grouped")
+	require.NotEmpty(t, response.Changes)
+	assert.Equal(t, []int{101, 102}, response.Changes[0].GroupedWith)
+	assert.Equal(t, []string{"bob", "alice"}, response.Changes[0].GroupedAuthors)
+	assert.Equal(t, 90, response.Changes[0].IncludeScore)
+}
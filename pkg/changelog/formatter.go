@@ -16,82 +16,274 @@ package changelog
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
 )
 
+// isKnownCategory returns true if the category is rendered by the formatter
+func isKnownCategory(category string, categories []config.Category) bool {
+	category = strings.ToUpper(category)
+	for _, c := range categories {
+		if c.Name == category {
+			return true
+		}
+	}
+	return false
+}
+
+// categoryForHeader returns the category rendered with a section header, which
+// is either the configured header or the category name itself
+func categoryForHeader(header string, categories []config.Category) string {
+	for _, c := range categories {
+		if strings.EqualFold(c.Header, header) {
+			return c.Name
+		}
+	}
+	return strings.ToUpper(header)
+}
+
+// knownIssue is an open issue affecting the release
+type knownIssue struct {
+	number int
+	title  string
+}
+
+// formatOptions contains optional formatting settings
+type formatOptions struct {
+	// categories lists the categories to render, in order (default: config.DefaultCategories())
+	categories []config.Category
+	// thresholds are the include_score thresholds
+	thresholds config.Thresholds
+	// callout is rendered after the categories when it has entries
+	callout *calloutSection
+	// knownIssues are rendered in a Known Issues section when non-empty
+	knownIssues []knownIssue
+	// provenanceComment is written right after the release header when non-empty
+	provenanceComment string
+	// unreleased renders an Unreleased section instead of a release section
+	unreleased bool
+	// date is the release date rendered in the release header
+	date time.Time
+	// links are the URL templates of PR, issue and author links (default: GitHub)
+	links config.LinkTemplates
+	// linkStyle controls whether links are inline or reference definitions
+	// (default: LinkStyleMixed)
+	linkStyle LinkStyle
+	// backports are the minor releases intentionally backported features
+	// were backported to, by PR number
+	backports map[int][]string
+	// anchors adds an HTML anchor to each entry of the categories
+	anchors bool
+	// deprecations are rendered in a Deprecated section when non-empty
+	deprecations []deprecation
+	// cliFlagChanges are rendered in a CLI Flag Changes section when non-empty
+	cliFlagChanges []cliFlagChange
+}
+
+// entryAnchorRegex matches the HTML anchor of an entry: <a id="pr-123"></a>
+var entryAnchorRegex = regexp.MustCompile(`<a id="[^"]*"></a>\s*`)
+
+// entryAnchor returns the HTML anchor of an entry, derived from its PR number
+// so that it is stable across regenerations of the CHANGELOG
+func entryAnchor(number int) string {
+	return fmt.Sprintf(`<a id="pr-%d"></a>`, number)
+}
+
 // formatChangelog formats the AI response into a CHANGELOG
-func formatChangelog(ver *version.Version, response *types.ModelResponse) string {
+func formatChangelog(ver *version.Version, response *types.ModelResponse, opts formatOptions) string {
 	var sb strings.Builder
 
-	// Title for minor releases only
-	if ver.Patch() == 0 {
-		sb.WriteString(fmt.Sprintf("# Changelog %d.%d\n\n", ver.Major(), ver.Minor()))
+	if opts.unreleased {
+		sb.WriteString("## Unreleased\n\n")
+	} else {
+		// Title for minor releases only
+		if ver.Patch() == 0 {
+			sb.WriteString(fmt.Sprintf("# Changelog %d.%d\n\n", ver.Major(), ver.Minor()))
+		}
+
+		// Release header
+		sb.WriteString(fmt.Sprintf("## %d.%d.%d - %s\n\n", ver.Major(), ver.Minor(), ver.Patch(), opts.date.Format("2006-01-02")))
 	}
 
-	// Release header
-	sb.WriteString(fmt.Sprintf("## %d.%d.%d - %s\n\n", ver.Major(), ver.Minor(), ver.Patch(), time.Now().Format("2006-01-02")))
+	if opts.provenanceComment != "" {
+		sb.WriteString(opts.provenanceComment)
+		sb.WriteString("\n\n")
+	}
 
-	// Group changes by category based on include_score
-	// >= 50: include normally
-	// 25-49: include with *OPTIONAL* prefix
-	// < 25: exclude from CHANGELOG
-	categories := []string{"ADDED", "CHANGED", "FIXED"}
-	changesByCategory := make(map[string][]types.ChangeEntry)
+	categories := opts.categories
+	if categories == nil {
+		categories = config.DefaultCategories()
+	}
+	changesByCategory, _ := capEntries(groupChanges(response, categories, opts.thresholds), categories)
 
+	// Collect authors
+	authorSet := make(map[string]bool)
+
+	// Output each category
+	writeCategories(&sb, categories, changesByCategory, opts, authorSet)
+
+	// Repeat the selected entries in the callout section, in category order
+	if opts.callout != nil && len(opts.callout.prs) > 0 {
+		sb.WriteString(fmt.Sprintf("### %s\n\n", opts.callout.header))
+		// Anchors must be unique, repeated entries link to the category entries
+		calloutOpts := opts
+		calloutOpts.anchors = false
+		for _, category := range categories {
+			for _, change := range changesByCategory[category.Name] {
+				if opts.callout.prs[change.PRNumber] {
+					sb.WriteString(formatEntry(change, calloutOpts))
+				}
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(opts.deprecations) > 0 {
+		sb.WriteString("### Deprecated\n\n")
+		for _, d := range opts.deprecations {
+			sb.WriteString(fmt.Sprintf("- %s.\n", d))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(opts.cliFlagChanges) > 0 {
+		sb.WriteString("### CLI Flag Changes\n\n")
+		for _, c := range opts.cliFlagChanges {
+			sb.WriteString(fmt.Sprintf("- %s\n", c))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(opts.knownIssues) > 0 {
+		sb.WriteString("### Known Issues\n\n")
+		for _, issue := range opts.knownIssues {
+			sb.WriteString(fmt.Sprintf("- %s. (%s)\n",
+				strings.TrimSuffix(strings.TrimSpace(issue.title), "."), formatNumberLink(issue.number, opts.links.IssueURL(issue.number), opts.linkStyle)))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+
+	writeEntryLinks(&sb, changesByCategory, opts, authorSet)
+	return sb.String()
+}
+
+// groupChanges groups the entries rendered in the categories, those with
+// an include_score of at least the optional threshold, sorted by
+// importance_score (descending)
+func groupChanges(response *types.ModelResponse, categories []config.Category, thresholds config.Thresholds) map[string][]types.ChangeEntry {
+	// >= thresholds.Include: include normally
+	// >= thresholds.Optional: include with *OPTIONAL* prefix
+	// otherwise: exclude from CHANGELOG
+	byCategory := make(map[string][]types.ChangeEntry)
 	for _, change := range response.Changes {
-		// Skip PRs with include_score < 25
-		if change.IncludeScore < 25 {
+		// Skip PRs below the optional threshold
+		if change.IncludeScore < thresholds.Optional {
 			continue
 		}
 
-		category := strings.ToUpper(change.Category)
-		if category == "ADDED" || category == "CHANGED" || category == "FIXED" {
-			changesByCategory[category] = append(changesByCategory[category], change)
+		if isKnownCategory(change.Category, categories) {
+			category := strings.ToUpper(change.Category)
+			byCategory[category] = append(byCategory[category], change)
 		}
 	}
 
-	// Sort changes within each category by importance_score (descending)
-	for category := range changesByCategory {
-		changes := changesByCategory[category]
+	for category := range byCategory {
+		changes := byCategory[category]
 		sort.Slice(changes, func(i, j int) bool {
 			return changes[i].ImportanceScore > changes[j].ImportanceScore
 		})
-		changesByCategory[category] = changes
+		byCategory[category] = changes
 	}
+	return byCategory
+}
 
-	// Collect authors
-	authorSet := make(map[string]bool)
+// capEntries keeps the most important entries of the categories with a
+// maximum number of entries, and returns the others as overflow
+func capEntries(byCategory map[string][]types.ChangeEntry, categories []config.Category) (map[string][]types.ChangeEntry, map[string][]types.ChangeEntry) {
+	overflow := make(map[string][]types.ChangeEntry)
+	for _, category := range categories {
+		changes := byCategory[category.Name]
+		if category.MaxEntries > 0 && len(changes) > category.MaxEntries {
+			overflow[category.Name] = changes[category.MaxEntries:]
+			byCategory[category.Name] = changes[:category.MaxEntries]
+		}
+	}
+	return byCategory, overflow
+}
 
-	// Output each category
+// writeCategories writes a section for each category, and collects the
+// authors of the entries
+func writeCategories(sb *strings.Builder, categories []config.Category, byCategory map[string][]types.ChangeEntry, opts formatOptions, authorSet map[string]bool) {
 	for _, category := range categories {
-		// Use simple capitalization for category headers (e.g., "Added", "Changed", "Fixed")
-		categoryTitle := strings.ToUpper(category[:1]) + strings.ToLower(category[1:])
-		sb.WriteString(fmt.Sprintf("### %s\n\n", categoryTitle))
+		sb.WriteString(fmt.Sprintf("### %s\n\n", category.Header))
 
-		changes := changesByCategory[category]
-		if len(changes) > 0 {
+		writeEntries := func(changes []types.ChangeEntry) {
 			for _, change := range changes {
-				prefix := ""
-				if change.IncludeScore >= 25 && change.IncludeScore < 50 {
-					prefix = "*OPTIONAL* "
-				}
-				sb.WriteString(fmt.Sprintf("- %s%s. ([#%d](https://github.com/antrea-io/antrea/pull/%d), [@%s])\n",
-					prefix, change.Description, change.PRNumber, change.PRNumber, change.Author))
+				sb.WriteString(formatEntry(change, opts))
 				authorSet[change.Author] = true
+				for _, author := range change.GroupedAuthors {
+					authorSet[author] = true
+				}
 			}
 		}
-
-		sb.WriteString("\n")
+		changes := byCategory[category.Name]
+		if len(category.Subcategories) == 0 {
+			writeEntries(changes)
+			sb.WriteString("\n")
+			continue
+		}
+		names, bySubcategory := groupBySubcategory(category, changes)
+		if len(names) == 1 && names[0] == config.OtherSubcategory {
+			// No entry was assigned to a subcategory
+			writeEntries(changes)
+			sb.WriteString("\n")
+			continue
+		}
+		for _, name := range names {
+			sb.WriteString(fmt.Sprintf("#### %s\n\n", name))
+			writeEntries(bySubcategory[name])
+			sb.WriteString("\n")
+		}
 	}
+}
 
-	sb.WriteString("\n")
+// writeEntryLinks writes the reference definitions of the PR and issue
+// links (with LinkStyleReference) and of the author links (unless
+// LinkStyleInline)
+func writeEntryLinks(sb *strings.Builder, byCategory map[string][]types.ChangeEntry, opts formatOptions, authorSet map[string]bool) {
+	if opts.linkStyle == LinkStyleReference {
+		labels := make(map[string]bool)
+		urls := make(map[string]string)
+		for _, changes := range byCategory {
+			for _, change := range changes {
+				for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+					label := fmt.Sprintf("#%d", number)
+					labels[label] = true
+					urls[label] = opts.links.PRURL(number)
+				}
+			}
+		}
+		for _, issue := range opts.knownIssues {
+			label := fmt.Sprintf("#%d", issue.number)
+			labels[label] = true
+			urls[label] = opts.links.IssueURL(issue.number)
+		}
+		for _, def := range numberDefinitions(labels, urls) {
+			sb.WriteString(def + "\n")
+		}
+	}
 
-	// Add author links
+	if opts.linkStyle == LinkStyleInline {
+		return
+	}
 	var authors []string
 	for author := range authorSet {
 		authors = append(authors, author)
@@ -99,8 +291,104 @@ func formatChangelog(ver *version.Version, response *types.ModelResponse) string
 	sort.Strings(authors)
 
 	for _, author := range authors {
-		sb.WriteString(fmt.Sprintf("[@%s]: https://github.com/%s\n", author, author))
+		if isDeletedAuthor(author) {
+			// Rendered as a placeholder without a link
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("[@%s]: %s\n", author, opts.links.AuthorURL(author)))
+	}
+}
+
+// formatOverflow formats the entries left out of the CHANGELOG by the maximum
+// numbers of entries per category as a supplementary full change list, or
+// returns "" if no entry was left out
+func formatOverflow(ver *version.Version, response *types.ModelResponse, opts formatOptions) string {
+	categories := opts.categories
+	if categories == nil {
+		categories = config.DefaultCategories()
 	}
+	_, overflow := capEntries(groupChanges(response, categories, opts.thresholds), categories)
+	if len(overflow) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	release := UnreleasedRelease
+	if !opts.unreleased {
+		release = fmt.Sprintf("%d.%d.%d", ver.Major(), ver.Minor(), ver.Patch())
+	}
+	sb.WriteString(fmt.Sprintf("# Full Change List %s\n\n", release))
+	sb.WriteString(fmt.Sprintf("Other changes of %s, in addition to the most important ones listed in the CHANGELOG.\n\n", release))
 
+	// Anchors belong to the CHANGELOG entries
+	opts.anchors = false
+	opts.knownIssues = nil
+	var overflowCategories []config.Category
+	for _, category := range categories {
+		if len(overflow[category.Name]) > 0 {
+			overflowCategories = append(overflowCategories, category)
+		}
+	}
+	authorSet := make(map[string]bool)
+	writeCategories(&sb, overflowCategories, overflow, opts, authorSet)
+	sb.WriteString("\n")
+	writeEntryLinks(&sb, overflow, opts, authorSet)
 	return sb.String()
 }
+
+// formatEntry returns the CHANGELOG line of an entry
+func formatEntry(change types.ChangeEntry, opts formatOptions) string {
+	prefix := ""
+	if change.IncludeScore < opts.thresholds.Include {
+		prefix = "*OPTIONAL* "
+	}
+	if opts.anchors {
+		prefix = entryAnchor(change.PRNumber) + prefix
+	}
+	todo := ""
+	if change.TitleFallback {
+		todo = " " + titleFallbackComment
+	}
+	return fmt.Sprintf("- %s%s. (%s, %s)%s%s\n", prefix, change.Description, formatPRLinks(change, opts), formatAuthorRefs(change, opts), backportSuffix(change, opts.backports), todo)
+}
+
+// formatPRLinks returns the PR links of an entry, including grouped PRs
+func formatPRLinks(change types.ChangeEntry, opts formatOptions) string {
+	links := make([]string, 0, 1+len(change.GroupedWith))
+	for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+		links = append(links, formatNumberLink(number, opts.links.PRURL(number), opts.linkStyle))
+	}
+	return strings.Join(links, " ")
+}
+
+// formatNumberLink returns the link of a PR or issue: [#123](url), or [#123]
+// with LinkStyleReference
+func formatNumberLink(number int, url string, style LinkStyle) string {
+	if style == LinkStyleReference {
+		return fmt.Sprintf("[#%d]", number)
+	}
+	return fmt.Sprintf("[#%d](%s)", number, url)
+}
+
+// formatAuthorRefs returns the author references of an entry, including
+// authors of grouped PRs, without duplicates. With LinkStyleInline, the
+// references are inline links.
+func formatAuthorRefs(change types.ChangeEntry, opts formatOptions) string {
+	seen := make(map[string]bool)
+	var refs []string
+	for _, author := range append([]string{change.Author}, change.GroupedAuthors...) {
+		if seen[author] {
+			continue
+		}
+		seen[author] = true
+		if isDeletedAuthor(author) {
+			// The account was deleted, there is no profile to link to
+			refs = append(refs, opts.links.DeletedAuthorPlaceholder())
+		} else if opts.linkStyle == LinkStyleInline {
+			refs = append(refs, fmt.Sprintf("[@%s](%s)", author, opts.links.AuthorURL(author)))
+		} else {
+			refs = append(refs, fmt.Sprintf("[@%s]", author))
+		}
+	}
+	return strings.Join(refs, " ")
+}
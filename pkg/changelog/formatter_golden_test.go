@@ -0,0 +1,106 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/golden"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// goldenFixture is a model response rendered to the golden markdown file
+// next to it, with the formatting options that are not part of the response
+type goldenFixture struct {
+	Version    string `json:"version"`
+	Date       string `json:"date"`
+	Unreleased bool   `json:"unreleased"`
+	Anchors    bool   `json:"anchors"`
+	LinkStyle  string `json:"link_style"`
+	Callout    *struct {
+		Header string `json:"header"`
+		PRs    []int  `json:"prs"`
+	} `json:"callout"`
+	KnownIssues []struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+	} `json:"known_issues"`
+	// Authors and GroupedAuthors are not part of the model output
+	Authors        map[int]string      `json:"authors"`
+	GroupedAuthors map[int][]string    `json:"grouped_authors"`
+	Response       types.ModelResponse `json:"response"`
+}
+
+func renderGoldenFixture(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var fixture goldenFixture
+	require.NoError(t, json.Unmarshal(data, &fixture))
+
+	ver, err := version.Parse(fixture.Version)
+	require.NoError(t, err)
+	date, err := time.Parse(time.DateOnly, fixture.Date)
+	require.NoError(t, err)
+
+	response := fixture.Response
+	for i := range response.Changes {
+		entry := &response.Changes[i]
+		entry.Author = fixture.Authors[entry.PRNumber]
+		entry.GroupedAuthors = fixture.GroupedAuthors[entry.PRNumber]
+	}
+	opts := formatOptions{
+		categories: config.DefaultCategories(),
+		thresholds: config.DefaultThresholds(),
+		unreleased: fixture.Unreleased,
+		date:       date,
+		anchors:    fixture.Anchors,
+		linkStyle:  LinkStyle(fixture.LinkStyle),
+	}
+	if fixture.Callout != nil {
+		prs := make(map[int]bool)
+		for _, number := range fixture.Callout.PRs {
+			prs[number] = true
+		}
+		opts.callout = &calloutSection{header: fixture.Callout.Header, prs: prs}
+	}
+	for _, issue := range fixture.KnownIssues {
+		opts.knownIssues = append(opts.knownIssues, knownIssue{number: issue.Number, title: issue.Title})
+	}
+	return formatChangelog(ver, &response, opts)
+}
+
+func TestFormatChangelog_Golden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "golden", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".json")
+		t.Run(name, func(t *testing.T) {
+			changelogText := renderGoldenFixture(t, fixture)
+			require.NoError(t, Validate(changelogText))
+			golden.Assert(t, strings.TrimSuffix(fixture, ".json")+".md", changelogText)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestFormatChangelog_MaxEntries(t *testing.T) {
+	ver, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "FIXED", Description: "Fix Egress IP leak", IncludeScore: 80, ImportanceScore: 50, Author: "alice"},
+		{PRNumber: 101, Category: "FIXED", Description: "Fix agent crash on startup", IncludeScore: 80, ImportanceScore: 90, Author: "bob"},
+		{PRNumber: 102, Category: "FIXED", Description: "Fix typo in antctl output", IncludeScore: 80, ImportanceScore: 10, Author: "carol"},
+		{PRNumber: 103, Category: "ADDED", Description: "Add Egress support for Windows", IncludeScore: 80, ImportanceScore: 70, Author: "alice"},
+	}}
+	categories := config.DefaultCategories()
+	categories[2].MaxEntries = 2
+	opts := formatOptions{
+		categories: categories,
+		thresholds: config.DefaultThresholds(),
+		date:       time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC),
+		anchors:    true,
+	}
+
+	changelogText := formatChangelog(ver, response, opts)
+	require.NoError(t, Validate(changelogText))
+	assert.Contains(t, changelogText, "### Fixed\n\n"+
+		"- <a id=\"pr-101\"></a>Fix agent crash on startup. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])\n"+
+		"- <a id=\"pr-100\"></a>Fix Egress IP leak. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n")
+	assert.NotContains(t, changelogText, "#102")
+	assert.NotContains(t, changelogText, "[@carol]")
+
+	fullList := formatOverflow(ver, response, opts)
+	assert.Equal(t, "# Full Change List 2.5.0\n\n"+
+		"Other changes of 2.5.0, in addition to the most important ones listed in the CHANGELOG.\n\n"+
+		"### Fixed\n\n"+
+		"- Fix typo in antctl output. ([#102](https://github.com/antrea-io/antrea/pull/102), [@carol])\n\n\n"+
+		"[@carol]: https://github.com/carol\n", fullList)
+
+	header, err := frontMatter(ver, false, opts.date, response, categories, opts.thresholds)
+	require.NoError(t, err)
+	assert.Contains(t, header, "entries: 3\n")
+
+	categories[2].MaxEntries = 0
+	assert.Empty(t, formatOverflow(ver, response, opts), "no full change list without overflow")
+}
+
+func TestFormatChangelog_DeletedAuthor(t *testing.T) {
+	ver, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "FIXED", Description: "Fix Egress IP leak", IncludeScore: 80, ImportanceScore: 50, Author: prAuthor(&gogithub.PullRequest{})},
+		{PRNumber: 101, Category: "FIXED", Description: "Fix agent crash", IncludeScore: 80, ImportanceScore: 40, Author: "bob", GroupedWith: []int{102}, GroupedAuthors: []string{"ghost"}},
+	}}
+	opts := formatOptions{
+		categories: config.DefaultCategories(),
+		thresholds: config.DefaultThresholds(),
+		date:       time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	changelogText := formatChangelog(ver, response, opts)
+	require.NoError(t, Validate(changelogText))
+	assert.Contains(t, changelogText, "- Fix Egress IP leak. ([#100](https://github.com/antrea-io/antrea/pull/100), @ghost)\n")
+	assert.Contains(t, changelogText, "- Fix agent crash. ([#101](https://github.com/antrea-io/antrea/pull/101) [#102](https://github.com/antrea-io/antrea/pull/102), [@bob] @ghost)\n")
+	assert.NotContains(t, changelogText, "[@ghost]")
+	assert.NotContains(t, changelogText, "[@]")
+
+	opts.links.DeletedAuthor = "a deleted user"
+	opts.linkStyle = LinkStyleInline
+	assert.Contains(t, formatChangelog(ver, response, opts), "([#100](https://github.com/antrea-io/antrea/pull/100), a deleted user)\n")
+}
+
+func TestFormatChangelog_CustomCategories(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 1, Category: "ADDED", Description: "Add X", IncludeScore: 100, Author: "alice"},
+			{PRNumber: 2, Category: "FIXED", Description: "Fix Y", IncludeScore: 100, Author: "bob"},
+			{PRNumber: 3, Category: "CHANGED", Description: "Change Z", IncludeScore: 100, Author: "carol"},
+		},
+	}
+	categories := []config.Category{
+		{Name: "FIXED", Header: "Bug Fixes"},
+		{Name: "ADDED", Header: "New Features"},
+	}
+
+	text := formatChangelog(version.New(2, 5, 0), response, formatOptions{categories: categories, thresholds: config.DefaultThresholds()})
+
+	assert.Less(t, strings.Index(text, "### Bug Fixes"), strings.Index(text, "### New Features"), "Categories should follow the configured order")
+	assert.NotContains(t, text, "### Changed", "Omitted categories should not be rendered")
+	assert.NotContains(t, text, "Change Z")
+	require.NoError(t, Validate(text))
+}
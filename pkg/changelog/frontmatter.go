@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// frontMatterRegex matches the YAML front matter at the start of a changelog
+var frontMatterRegex = regexp.MustCompile(`(?s)\A---\n.*?\n---\n+`)
+
+// frontMatterData is the metadata of a generated changelog section, for the
+// consumers indexing changelogs programmatically
+type frontMatterData struct {
+	Version   string `yaml:"version"`
+	Date      string `yaml:"date,omitempty"`
+	Generator string `yaml:"generator"`
+	// Entries is the number of rendered entries, Categories breaks it down
+	// by category name
+	Entries    int            `yaml:"entries"`
+	Categories map[string]int `yaml:"categories"`
+}
+
+// frontMatter returns the YAML front matter of a generated changelog section
+func frontMatter(ver *version.Version, unreleased bool, date time.Time, response *types.ModelResponse, categories []config.Category, thresholds config.Thresholds) (string, error) {
+	data := frontMatterData{
+		Version:    UnreleasedRelease,
+		Generator:  "antrea-releaser " + ToolVersion(),
+		Categories: make(map[string]int),
+	}
+	if !unreleased {
+		data.Version = fmt.Sprintf("%d.%d.%d", ver.Major(), ver.Minor(), ver.Patch())
+		data.Date = date.Format("2006-01-02")
+	}
+	if categories == nil {
+		categories = config.DefaultCategories()
+	}
+	for _, category := range categories {
+		data.Categories[strings.ToLower(category.Name)] = 0
+	}
+	// Same selection as formatChangelog
+	byCategory, _ := capEntries(groupChanges(response, categories, thresholds), categories)
+	for category, changes := range byCategory {
+		data.Categories[strings.ToLower(category)] = len(changes)
+		data.Entries += len(changes)
+	}
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	encoder := yaml.NewEncoder(&sb)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(data); err != nil {
+		return "", fmt.Errorf("failed to marshal front matter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal front matter: %w", err)
+	}
+	sb.WriteString("---\n\n")
+	return sb.String(), nil
+}
+
+// stripFrontMatter removes the YAML front matter of a changelog, if any
+func stripFrontMatter(changelogText string) string {
+	return frontMatterRegex.ReplaceAllString(changelogText, "")
+}
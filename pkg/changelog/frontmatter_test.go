@@ -0,0 +1,58 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestFrontMatter(t *testing.T) {
+	ver, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 1, Category: "ADDED", Description: "Add X", IncludeScore: 90, Author: "alice"},
+		{PRNumber: 2, Category: "FIXED", Description: "Fix Y", IncludeScore: 80, Author: "bob"},
+		{PRNumber: 3, Category: "FIXED", Description: "Fix Z", IncludeScore: 60, Author: "bob"},
+		{PRNumber: 4, Category: "FIXED", Description: "Fix typo", IncludeScore: 10, Author: "carol"},
+		{PRNumber: 5, Category: "UNKNOWN", Description: "Something", IncludeScore: 90, Author: "carol"},
+	}}
+	date := time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC)
+	thresholds := config.DefaultThresholds()
+
+	header, err := frontMatter(ver, false, date, response, nil, thresholds)
+	require.NoError(t, err)
+	assert.Equal(t, "---\nversion: 2.5.0\ndate: \"2025-01-30\"\ngenerator: antrea-releaser "+ToolVersion()+"\nentries: 3\ncategories:\n  added: 1\n  changed: 0\n  fixed: 2\n---\n\n", header)
+
+	header, err = frontMatter(ver, true, date, response, nil, thresholds)
+	require.NoError(t, err)
+	assert.Contains(t, header, "version: unreleased\n")
+	assert.NotContains(t, header, "date:")
+
+	// The front matter is ignored when validating, merging and rendering
+	changelogText := header + formatChangelog(ver, response, formatOptions{thresholds: thresholds, date: date})
+	require.NoError(t, Validate(changelogText))
+	merged, err := MergeChangelog("", changelogText, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+	assert.NotContains(t, merged, "version:")
+	assert.NotContains(t, FormatEmail(changelogText), "version:")
+}
@@ -17,7 +17,10 @@ package genai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync"
 	"time"
 
 	"google.golang.org/genai"
@@ -25,9 +28,14 @@ import (
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
 )
 
-// GeminiCaller implements ModelCaller for Google's Gemini API
+// GeminiCaller implements ModelCaller for Google's Gemini API. The client is
+// created on the first call and reused by the following ones (e.g. retries or
+// the description shortening pass), so that connections are reused.
 type GeminiCaller struct {
 	apiKey string
+
+	mutex  sync.Mutex
+	client *genai.Client
 }
 
 // NewGeminiCaller creates a new GeminiCaller with the provided API key
@@ -37,17 +45,39 @@ func NewGeminiCaller(apiKey string) *GeminiCaller {
 	}
 }
 
-// Call sends a prompt to Gemini and returns the structured response and metadata
-func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+// getClient returns the Gemini client, creating it on first use. A failure to
+// create the client is not cached, so that the next call tries again.
+func (g *GeminiCaller) getClient(ctx context.Context) (*genai.Client, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.client != nil {
+		return g.client, nil
+	}
 	client, err := genai.NewClient(ctx, &genai.ClientConfig{
 		APIKey:  g.apiKey,
 		Backend: genai.BackendGeminiAPI,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create Gemini client: %w", err)
+		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	g.client = client
+	return client, nil
+}
+
+// Call sends a prompt to Gemini and returns the structured response and
+// metadata. The call is not sent if ctx is already done, and is aborted as soon
+// as ctx is done. When the model answered with malformed output, the details
+// are returned with the error, so that the cost of the call is still reported.
+func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("not calling the model: %w", err)
+	}
+	client, err := g.getClient(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Prepare the generation config
+	// Prepare the generation config, per call
 	genConfig := &genai.GenerateContentConfig{
 		Temperature:      genai.Ptr(float32(0.2)),
 		ResponseMIMEType: "application/json",
@@ -65,11 +95,21 @@ func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName stri
 	latency := time.Since(startTime).Seconds()
 
 	if err != nil {
+		var apiErr genai.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusTooManyRequests {
+			err = &types.RateLimitedError{Service: "gemini", Err: err}
+		}
+		// The client does not always wrap the context error, which callers
+		// check to tell a cancellation or deadline from a failure of the API
+		if ctxErr := ctx.Err(); ctxErr != nil && !errors.Is(err, ctxErr) {
+			err = fmt.Errorf("%w: %w", ctxErr, err)
+		}
 		return nil, nil, fmt.Errorf("failed to generate content: %w", err)
 	}
 
-	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
-		return nil, nil, fmt.Errorf("no response from model")
+	details := geminiDetails(resp, version, modelName, latency)
+	if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil || len(resp.Candidates[0].Content.Parts) == 0 {
+		return nil, details, &types.ModelMalformedOutputError{Err: fmt.Errorf("no response from model")}
 	}
 
 	// Extract JSON from response
@@ -83,9 +123,15 @@ func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName stri
 	// Parse JSON response
 	var modelResponse types.ModelResponse
 	if err := json.Unmarshal([]byte(jsonStr), &modelResponse); err != nil {
-		return nil, nil, fmt.Errorf("failed to parse model response: %w\nResponse: %s", err, jsonStr)
+		return nil, details, &types.ModelMalformedOutputError{Output: jsonStr, Err: err}
 	}
 
+	return &modelResponse, details, nil
+}
+
+// geminiDetails returns the metadata of a call, with the cost estimated from
+// the usage metadata of the response
+func geminiDetails(resp *genai.GenerateContentResponse, version, modelName string, latency float64) *types.ModelDetails {
 	// Extract usage metadata
 	var promptTokens, candidatesTokens, totalTokens int32
 	var estimatedCost float64
@@ -107,7 +153,7 @@ func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName stri
 	// Generate timestamp
 	timestamp := time.Now().Format("20060102-150405")
 
-	details := &types.ModelDetails{
+	return &types.ModelDetails{
 		Version:          version,
 		Timestamp:        timestamp,
 		Model:            modelName,
@@ -116,7 +162,6 @@ func (g *GeminiCaller) Call(ctx context.Context, prompt, version, modelName stri
 		CandidatesTokens: candidatesTokens,
 		TotalTokens:      totalTokens,
 		EstimatedCostUSD: estimatedCost,
+		Calls:            1,
 	}
-
-	return &modelResponse, details, nil
 }
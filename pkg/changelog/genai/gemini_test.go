@@ -0,0 +1,44 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeminiCaller_ReusesClient(t *testing.T) {
+	caller := NewGeminiCaller("key")
+	first, err := caller.getClient(context.Background())
+	require.NoError(t, err)
+	second, err := caller.getClient(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
+
+func TestGeminiCaller_Cancelled(t *testing.T) {
+	caller := NewGeminiCaller("key")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, details, err := caller.Call(ctx, "prompt", "2.5.0", "gemini-2.5-flash")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, details)
+	// No client is created for a call which is not sent
+	assert.Nil(t, caller.client)
+}
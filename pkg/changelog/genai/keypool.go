@@ -0,0 +1,84 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// KeyPoolCaller implements ModelCaller with a pool of callers using different
+// API keys. Calls use the current caller, and rotate to the next one when the
+// quota of the current key is exhausted, so that a run can continue past the
+// daily limits of a single key.
+type KeyPoolCaller struct {
+	callers []types.ModelCaller
+	mutex   sync.Mutex
+	current int
+}
+
+// NewKeyPoolCaller creates a new KeyPoolCaller, callers are used in order
+func NewKeyPoolCaller(callers ...types.ModelCaller) *KeyPoolCaller {
+	return &KeyPoolCaller{callers: callers}
+}
+
+// NewGeminiKeyPool creates a KeyPoolCaller with a GeminiCaller per API key
+func NewGeminiKeyPool(apiKeys []string) *KeyPoolCaller {
+	callers := make([]types.ModelCaller, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		callers = append(callers, NewGeminiCaller(apiKey))
+	}
+	return NewKeyPoolCaller(callers...)
+}
+
+// Call sends the prompt with the current caller, trying each key of the pool
+// at most once. Errors other than rate limiting are returned immediately, and
+// no other key is tried once ctx is done.
+func (p *KeyPoolCaller) Call(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+	p.mutex.Lock()
+	start := p.current
+	p.mutex.Unlock()
+
+	var err error
+	for attempt := 0; attempt < len(p.callers); attempt++ {
+		if attempt > 0 && ctx.Err() != nil {
+			return nil, nil, fmt.Errorf("not rotating to the next API key: %w: %w", ctx.Err(), err)
+		}
+		i := (start + attempt) % len(p.callers)
+		var response *types.ModelResponse
+		var details *types.ModelDetails
+		response, details, err = p.callers[i].Call(ctx, prompt, version, modelName)
+		var rateLimitedErr *types.RateLimitedError
+		if err == nil || !errors.As(err, &rateLimitedErr) {
+			return response, details, err
+		}
+		next := (i + 1) % len(p.callers)
+		if len(p.callers) > 1 {
+			log.Printf("Warning: API key %d of %d is rate limited, rotating to key %d", i+1, len(p.callers), next+1)
+		}
+		p.mutex.Lock()
+		p.current = next
+		p.mutex.Unlock()
+	}
+	if err == nil {
+		return nil, nil, fmt.Errorf("no API key configured")
+	}
+	return nil, nil, fmt.Errorf("all %d API keys are rate limited: %w", len(p.callers), err)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestKeyPoolCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := mocks.NewMockModelCaller(ctrl)
+	second := mocks.NewMockModelCaller(ctrl)
+	rateLimited := &types.RateLimitedError{Service: "gemini", Err: fmt.Errorf("quota exceeded")}
+	response := &types.ModelResponse{}
+	details := &types.ModelDetails{}
+
+	pool := NewKeyPoolCaller(first, second)
+
+	// The first key is exhausted, the call is retried with the second key
+	first.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").Return(nil, nil, rateLimited)
+	second.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").Return(response, details, nil)
+	gotResponse, gotDetails, err := pool.Call(context.Background(), "prompt", "2.5.0", "model")
+	require.NoError(t, err)
+	assert.Same(t, response, gotResponse)
+	assert.Same(t, details, gotDetails)
+
+	// The next call starts with the second key
+	second.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").Return(nil, nil, fmt.Errorf("invalid prompt"))
+	_, _, err = pool.Call(context.Background(), "prompt", "2.5.0", "model")
+	require.EqualError(t, err, "invalid prompt")
+
+	// Each key is tried once when all are exhausted
+	second.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").Return(nil, nil, rateLimited)
+	first.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").Return(nil, nil, rateLimited)
+	_, _, err = pool.Call(context.Background(), "prompt", "2.5.0", "model")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "all 2 API keys are rate limited")
+	assert.True(t, types.IsRetryable(err))
+}
+
+func TestKeyPoolCaller_Cancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := mocks.NewMockModelCaller(ctrl)
+	second := mocks.NewMockModelCaller(ctrl)
+	pool := NewKeyPoolCaller(first, second)
+
+	// The run is cancelled during the first call, the next key is not tried
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first.EXPECT().Call(gomock.Any(), "prompt", "2.5.0", "model").
+		DoAndReturn(func(context.Context, string, string, string) (*types.ModelResponse, *types.ModelDetails, error) {
+			cancel()
+			return nil, nil, &types.RateLimitedError{Service: "gemini", Err: fmt.Errorf("quota exceeded")}
+		})
+	_, _, err := pool.Call(ctx, "prompt", "2.5.0", "model")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
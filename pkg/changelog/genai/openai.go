@@ -0,0 +1,179 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// OpenAICaller implements ModelCaller for OpenAI-compatible chat completions
+// APIs, e.g. OpenRouter or a LiteLLM proxy
+type OpenAICaller struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewOpenAICaller creates a new OpenAICaller for the API at baseURL (e.g.
+// https://openrouter.ai/api/v1) with the provided API key
+func NewOpenAICaller(baseURL, apiKey string) *OpenAICaller {
+	return &OpenAICaller{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type chatRequest struct {
+	Model          string             `json:"model"`
+	Messages       []chatMessage      `json:"messages"`
+	Temperature    float32            `json:"temperature"`
+	ResponseFormat chatResponseFormat `json:"response_format"`
+}
+
+type chatResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int32 `json:"prompt_tokens"`
+		CompletionTokens int32 `json:"completion_tokens"`
+		TotalTokens      int32 `json:"total_tokens"`
+		// Cost is reported by some gateways, e.g. OpenRouter
+		Cost float64 `json:"cost"`
+	} `json:"usage"`
+}
+
+// Call sends a prompt to the chat completions endpoint and returns the structured response and metadata
+func (o *OpenAICaller) Call(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+	body, err := json.Marshal(chatRequest{
+		Model:          modelName,
+		Messages:       []chatMessage{{Role: "user", Content: prompt}},
+		Temperature:    0.2,
+		ResponseFormat: chatResponseFormat{Type: "json_object"},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal chat completions request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create chat completions request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.apiKey)
+	}
+
+	// Measure latency
+	startTime := time.Now()
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	latency := time.Since(startTime).Seconds()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read chat completions response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("chat completions request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		if resp.StatusCode == http.StatusTooManyRequests {
+			err = &types.RateLimitedError{Service: "model gateway", Err: err}
+		}
+		return nil, nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	// The details are returned with the malformed outputs too, as the call
+	// used the quota and possibly tokens
+	details := &types.ModelDetails{
+		Version:        version,
+		Timestamp:      time.Now().Format("20060102-150405"),
+		Model:          modelName,
+		LatencySeconds: latency,
+		Calls:          1,
+	}
+	details.Quota = quotaFromHeaders(resp.Header)
+
+	var chatResp chatResponse
+	if err := json.Unmarshal(respBody, &chatResp); err != nil {
+		return nil, details, &types.ModelMalformedOutputError{Output: string(respBody), Err: err}
+	}
+	if chatResp.Usage != nil {
+		details.PromptTokens = chatResp.Usage.PromptTokens
+		details.CandidatesTokens = chatResp.Usage.CompletionTokens
+		details.TotalTokens = chatResp.Usage.TotalTokens
+		// Prices depend on the gateway and model, only report the cost if the
+		// gateway does
+		details.EstimatedCostUSD = chatResp.Usage.Cost
+	}
+	if len(chatResp.Choices) == 0 || chatResp.Choices[0].Message.Content == "" {
+		return nil, details, &types.ModelMalformedOutputError{Err: fmt.Errorf("no response from model")}
+	}
+
+	// Parse JSON response, which some models wrap in a markdown code block
+	jsonStr := strings.TrimSpace(chatResp.Choices[0].Message.Content)
+	jsonStr = strings.TrimPrefix(jsonStr, "```json")
+	jsonStr = strings.TrimSuffix(strings.TrimPrefix(jsonStr, "```"), "```")
+	var modelResponse types.ModelResponse
+	if err := json.Unmarshal([]byte(jsonStr), &modelResponse); err != nil {
+		return nil, details, &types.ModelMalformedOutputError{Output: jsonStr, Err: err}
+	}
+
+	return &modelResponse, details, nil
+}
+
+// quotaFromHeaders returns the quota reported by the x-ratelimit-* headers of
+// OpenAI-compatible APIs, or nil if there is none
+func quotaFromHeaders(header http.Header) *types.QuotaUsage {
+	parse := func(name string) *int64 {
+		v, err := strconv.ParseInt(header.Get(name), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return &v
+	}
+	quota := &types.QuotaUsage{
+		LimitRequests:     parse("x-ratelimit-limit-requests"),
+		RemainingRequests: parse("x-ratelimit-remaining-requests"),
+		LimitTokens:       parse("x-ratelimit-limit-tokens"),
+		RemainingTokens:   parse("x-ratelimit-remaining-tokens"),
+	}
+	if quota.RemainingRequests == nil && quota.RemainingTokens == nil {
+		return nil
+	}
+	return quota
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestOpenAICaller_Call(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		var req chatRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "openai/gpt-4o", req.Model)
+		assert.Equal(t, "json_object", req.ResponseFormat.Type)
+		require.Len(t, req.Messages, 1)
+		assert.Equal(t, "prompt", req.Messages[0].Content)
+
+		w.Header().Set("x-ratelimit-limit-requests", "1000")
+		w.Header().Set("x-ratelimit-remaining-requests", "999")
+		w.Header().Set("x-ratelimit-remaining-tokens", "50000")
+		_, _ = w.Write([]byte(`{
+			"choices": [{"message": {"role": "assistant", "content": "` + "```json\\n" + `{\"changes\": [{\"pr_number\": 100, \"category\": \"ADDED\", \"description\": \"Add feature\", \"include_score\": 90}]}` + "\\n```" + `"}}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15, "cost": 0.01}
+		}`))
+	}))
+	defer server.Close()
+
+	caller := NewOpenAICaller(server.URL+"/api/v1/", "key")
+	response, details, err := caller.Call(context.Background(), "prompt", "2.5.0", "openai/gpt-4o")
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	assert.Equal(t, 100, response.Changes[0].PRNumber)
+	assert.Equal(t, "Add feature", response.Changes[0].Description)
+	assert.Equal(t, int32(15), details.TotalTokens)
+	assert.Equal(t, 0.01, details.EstimatedCostUSD)
+	assert.Equal(t, "openai/gpt-4o", details.Model)
+	require.NotNil(t, details.Quota)
+	assert.Equal(t, "requests remaining: 999 of 1000, tokens remaining: 50000", details.Quota.String())
+}
+
+func TestOpenAICaller_CallErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		retryable bool
+		errTarget any
+	}{
+		{
+			name:      "rate limited",
+			status:    http.StatusTooManyRequests,
+			body:      `{"error": "rate limited"}`,
+			retryable: true,
+			errTarget: new(*types.RateLimitedError),
+		},
+		{
+			name:      "malformed output",
+			status:    http.StatusOK,
+			body:      `{"choices": [{"message": {"content": "not json"}}]}`,
+			retryable: true,
+			errTarget: new(*types.ModelMalformedOutputError),
+		},
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			body:   `{"error": "invalid key"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, _, err := NewOpenAICaller(server.URL, "key").Call(context.Background(), "prompt", "2.5.0", "model")
+			require.Error(t, err)
+			assert.Equal(t, tt.retryable, types.IsRetryable(err))
+			if tt.errTarget != nil {
+				assert.ErrorAs(t, err, tt.errTarget)
+			}
+		})
+	}
+}
+
+func TestOpenAICaller_CallMalformedOutputDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-limit-requests", "1000")
+		w.Header().Set("x-ratelimit-remaining-requests", "998")
+		_, _ = w.Write([]byte(`{
+			"choices": [{"message": {"role": "assistant", "content": "Here is the changelog: {\"changes\": ["}}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15, "cost": 0.01}
+		}`))
+	}))
+	defer server.Close()
+
+	// The usage and the quota of the call are returned with the error, to be
+	// accounted for before retrying
+	response, details, err := NewOpenAICaller(server.URL, "key").Call(context.Background(), "prompt", "2.5.0", "openai/gpt-4o")
+	assert.Nil(t, response)
+	var malformedErr *types.ModelMalformedOutputError
+	require.ErrorAs(t, err, &malformedErr)
+	require.NotNil(t, details)
+	assert.Equal(t, "openai/gpt-4o", details.Model)
+	assert.Equal(t, 1, details.Calls)
+	assert.Equal(t, int32(15), details.TotalTokens)
+	assert.Equal(t, 0.01, details.EstimatedCostUSD)
+	require.NotNil(t, details.Quota)
+	assert.Equal(t, "requests remaining: 998 of 1000, tokens remaining: unknown", details.Quota.String())
+}
@@ -16,6 +16,7 @@ package changelog
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"regexp"
@@ -26,6 +27,8 @@ import (
 
 	gogithub "github.com/google/go-github/v76/github"
 
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/artifacts"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/prompt"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
@@ -39,6 +42,114 @@ type ChangelogGenerator struct {
 	model        string
 	modelCaller  types.ModelCaller
 	githubClient types.GitHubClient
+
+	provenanceComment bool
+	frontMatter       bool
+	excludedLabels    []string
+	prFilter          *PRFilter
+	categories        []config.Category
+	thresholds        config.Thresholds
+	includeOptional   bool
+	windowsCallout    *config.Callout
+	knownIssuesLabel  string
+	yankedReleases    []string
+	// groupFollowUps groups the entries of follow-up PRs with the PR they follow up on
+	groupFollowUps bool
+	// issueSymptoms adds the titles of the issues fixed by the PRs to the prompt
+	issueSymptoms bool
+	// docsOnly controls how documentation-only PRs are handled, using the docsPaths patterns
+	docsOnly  DocsOnlyMode
+	docsPaths []string
+	// notableDependencies are the dependencies whose bot upgrade PRs are kept (nil to disable)
+	notableDependencies []config.NotableDependency
+	// buildPaths are the build file patterns used to detect platform support changes (nil to disable)
+	buildPaths             []string
+	kubernetesVersionCheck bool
+	configDefaultsCheck    bool
+	cliFlagsCheck          bool
+	cliFlagsSection        bool
+	deprecationsCheck      bool
+	labelLegend            bool
+	driftReport            bool
+	entryAnchors           bool
+	linkStyle              LinkStyle
+	// style is the style profile of the descriptions
+	style    config.StyleProfile
+	links    config.LinkTemplates
+	timeouts StageTimeouts
+	// maxDescriptionLength is the maximum length of entry descriptions (0 for no limit)
+	maxDescriptionLength int
+	// minDescriptionConfidence is the description_confidence below which the
+	// PR title is used instead of the description (0 to disable)
+	minDescriptionConfidence int
+	windowLimits             WindowLimits
+	guardrails               config.Guardrails
+	prSource                 PRSource
+	confirm                  ConfirmFunc
+	clock                    Clock
+	overrides                PROverrides
+	// acknowledgedPatchFeatures are the PRs allowed to be ADDED entries in a patch release
+	acknowledgedPatchFeatures []int
+	// backports are the minor releases intentionally backported features were
+	// backported to, by PR number
+	backports map[int][]string
+	// corrections are the past edits of generated entries used as examples
+	corrections []types.Correction
+
+	// prFiles caches the files changed by each PR
+	prFiles map[int][]string
+
+	// warnings records the non-fatal issues of the last generated CHANGELOG
+	warnings []types.Warning
+	// skipped records the PRs excluded from the last generated CHANGELOG
+	skipped []types.SkippedPR
+	// conflicts records the historical category conflicts of the last generated CHANGELOG
+	conflicts []types.HistoryConflict
+	// missingLabels records the PRs of the last generated CHANGELOG which should have the release note label
+	missingLabels []types.MissingLabel
+	// kubernetesChange records the Kubernetes dependency upgrade of the last
+	// generated CHANGELOG, if any
+	kubernetesChange *kubernetesVersionChange
+	// configDefaultChanges records the changed configuration defaults of the
+	// last generated CHANGELOG
+	configDefaultChanges []configDefaultChange
+	// cliFlagChanges records the CLI flags added, deprecated or removed in the
+	// last generated CHANGELOG
+	cliFlagChanges []cliFlagChange
+	// deprecations records the Prometheus metrics and CRD fields deprecated or
+	// removed in the last generated CHANGELOG
+	deprecations []deprecation
+	// legend is the label legend of the last generated CHANGELOG's prompt
+	legend string
+	// duplicates records the entries of the last generated CHANGELOG which
+	// duplicate a historical entry of another PR
+	duplicates []types.HistoricalDuplicate
+	// quality records the quality score of the last generated CHANGELOG
+	quality types.QualityScore
+	// guardrailViolations records the categories of the last generated
+	// CHANGELOG with an unexpected number of entries
+	guardrailViolations []types.GuardrailViolation
+	// patchFeatures records the unacknowledged ADDED entries of the last
+	// generated CHANGELOG, if it is for a patch release
+	patchFeatures []types.ChangeEntry
+	// appliedOverrides records the PR overrides applied to the last generated CHANGELOG
+	appliedOverrides []types.PROverride
+	// drift records the release drift audit of the last generated CHANGELOG, if enabled
+	drift *types.ReleaseDrift
+	// fullChangeList records the entries left out of the last generated
+	// CHANGELOG by the maximum numbers of entries per category
+	fullChangeList string
+	// suspiciousEntries records the PRs of the last generated CHANGELOG whose
+	// entry looks derailed by instructions in the PR content
+	suspiciousEntries []int
+	// codeOwners are used to route the review of the entries, if set
+	codeOwners *CodeOwners
+	// reviewRoutes records the owners of the entries of the last generated
+	// CHANGELOG, if code owners are set
+	reviewRoutes []types.ReviewRoute
+	// previousResponse is the model response of a previous run, whose entries
+	// are reused in an incremental run
+	previousResponse *types.ModelResponse
 }
 
 // NewChangelogGenerator creates a new ChangelogGenerator
@@ -49,29 +160,338 @@ func NewChangelogGenerator(
 	model string,
 	modelCaller types.ModelCaller,
 	githubClient types.GitHubClient,
+	opts ...Option,
 ) *ChangelogGenerator {
-	return &ChangelogGenerator{
-		release:      release,
-		fromRelease:  fromRelease,
-		all:          all,
-		model:        model,
-		modelCaller:  modelCaller,
-		githubClient: githubClient,
+	g := &ChangelogGenerator{
+		release:         release,
+		fromRelease:     fromRelease,
+		all:             all,
+		model:           model,
+		modelCaller:     modelCaller,
+		githubClient:    githubClient,
+		categories:      config.DefaultCategories(),
+		thresholds:      config.DefaultThresholds(),
+		includeOptional: true,
+		prSource:        PRSourceList,
+		clock:           time.Now,
+	}
+	for _, opt := range opts {
+		opt(g)
 	}
+	return g
+}
+
+// releaseInputs contains the data fetched from GitHub before calling the model
+type releaseInputs struct {
+	ver                  *version.Version
+	unreleased           bool
+	fromRelease          string
+	branch               string
+	since                time.Time
+	historicalCHANGELOGs string
+	prCache              map[int]types.HistoricalPR
+	prs                  []types.PRInfo
 }
 
 // Generate generates the changelog by fetching PRs, calling the AI model, and returning the formatted changelog
 func (g *ChangelogGenerator) Generate(ctx context.Context) (string, *types.Prompt, *types.ModelResponse, *types.ModelDetails, error) {
-	// Parse version information
-	ver, err := version.Parse(g.release)
+	fetchCtx, cancel := stageContext(ctx, g.timeouts.Fetch)
+	inputs, err := g.fetchInputs(fetchCtx)
+	cancel()
 	if err != nil {
-		return "", nil, nil, nil, fmt.Errorf("invalid release version: %w", err)
+		return "", nil, nil, nil, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+	}
+	prs := inputs.prs
+
+	// In an incremental run, only the PRs the previous run did not cover are
+	// sent to the model
+	promptPRs := prs
+	var reused []types.ChangeEntry
+	if g.previousResponse != nil {
+		reused, promptPRs = splitIncremental(g.previousResponse, prs)
+		log.Printf("Incremental run: reusing %d entries of the previous run, %d new PRs", len(reused), len(promptPRs))
+	}
+
+	// The release window is checked outside of the fetch stage, so that the
+	// time spent answering the confirmation does not count against its timeout
+	if err := g.checkWindowAge(inputs.fromRelease, inputs.since); err != nil {
+		return "", nil, nil, nil, err
+	}
+	if err := g.checkWindowSize(len(promptPRs)); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	// Build the prompt
+	promptText := g.buildPrompt(inputs.historicalCHANGELOGs, promptPRs, inputs.prCache)
+	now := g.clock()
+	timestamp := now.Format(artifacts.TimestampFormat)
+
+	promptData := &types.Prompt{
+		Text:      promptText,
+		Version:   g.release,
+		Timestamp: timestamp,
+	}
+
+	var modelResponse *types.ModelResponse
+	var modelDetails *types.ModelDetails
+	if g.previousResponse != nil && len(promptPRs) == 0 {
+		log.Printf("No new PRs since the previous run, not calling the AI model")
+		modelResponse = &types.ModelResponse{}
+		modelDetails = &types.ModelDetails{Version: g.release, Timestamp: timestamp, Model: g.model}
+	} else {
+		// Call AI model
+		log.Printf("Calling AI model (model: %s)...", g.model)
+		modelCtx, cancel := stageContext(ctx, g.timeouts.Model)
+		modelResponse, modelDetails, err = g.modelCaller.Call(modelCtx, promptText, g.release, g.model)
+		cancel()
+		if err != nil {
+			// The details of a billed call are returned, to report the cost
+			return "", promptData, nil, modelDetails, stageError(modelCtx, StageModel, g.timeouts.Model, &types.ModelError{Err: fmt.Errorf("failed to call AI model: %w", err)})
+		}
+		log.Printf("Received %d change entries from model", len(modelResponse.Changes))
+		log.Printf("Model latency: %.2f seconds, Total tokens: %d", modelDetails.LatencySeconds, modelDetails.TotalTokens)
+	}
+	// The new entries are spliced into the entries of the previous run, and
+	// the whole release section is rendered again
+	modelResponse.Changes = append(reused, modelResponse.Changes...)
+	integrityWarnings, suspicious := checkResponseIntegrity(modelResponse, prs)
+	for _, w := range integrityWarnings {
+		g.warn(w)
+	}
+	g.suspiciousEntries = suspicious
+
+	// Enrich with author information
+	g.enrichWithAuthors(modelResponse, prs)
+	thresholds := g.effectiveThresholds()
+	g.includeForcedEntries(modelResponse, thresholds)
+	g.conflicts = detectHistoryConflicts(modelResponse, prs, inputs.prCache, thresholds)
+	for _, c := range g.conflicts {
+		log.Printf("Warning: PR #%d is %s in a historical CHANGELOG, but the model returned %s (label: %q)", c.Number, c.HistoricalCategory, c.ModelCategory, c.Label)
+	}
+	if g.minDescriptionConfidence > 0 {
+		for _, number := range applyTitleFallbacks(modelResponse, prs, g.minDescriptionConfidence) {
+			g.warn(types.Warning{Kind: types.WarningKindDescription, PRNumber: number,
+				Message: fmt.Sprintf("description of PR #%d has a very low confidence, using the PR title instead", number)})
+		}
+	}
+	if rewritten := applyStyle(modelResponse, g.style); len(rewritten) > 0 {
+		log.Printf("Rewrote the leading verb of %d descriptions to follow the description style", len(rewritten))
+	}
+	if g.maxDescriptionLength > 0 {
+		if err := g.enforceDescriptionConstraints(ctx, modelResponse, modelDetails, thresholds); err != nil {
+			return "", promptData, modelResponse, modelDetails, err
+		}
+	}
+	if g.groupFollowUps {
+		groupFollowUps(modelResponse, prs, inputs.prCache, thresholds)
+	}
+	g.skipped = append(g.skipped, skippedFromResponse(modelResponse, prs, g.categories, thresholds)...)
+	for _, w := range entryWarnings(modelResponse, inputs.prCache, g.categories, thresholds) {
+		g.warn(w)
+	}
+	g.missingLabels = detectMissingLabels(modelResponse, prs, g.categories, thresholds)
+	dedupeEntries(modelResponse, thresholds)
+	releaseTag := ""
+	if !inputs.unreleased {
+		releaseTag = "v" + inputs.ver.String()
+	}
+	enrichWithBuildRefs(modelResponse, prs, releaseTag)
+	g.duplicates = detectHistoricalDuplicates(modelResponse, inputs.prCache, thresholds)
+	for _, d := range g.duplicates {
+		log.Printf("Warning: PR #%d looks like a duplicate of PR #%d, released in %s (similarity %.2f)", d.Number, d.HistoricalNumber, d.HistoricalRelease, d.Similarity)
+	}
+	g.guardrailViolations = checkGuardrails(modelResponse, inputs.ver, inputs.unreleased, g.guardrails, g.categories, thresholds)
+	for _, v := range g.guardrailViolations {
+		log.Printf("Warning: guardrail violated: %s", v)
+	}
+	acknowledged := g.acknowledgedPatchFeatures
+	if !inputs.unreleased {
+		acknowledged = append(backportedTo(inputs.ver, g.backports), acknowledged...)
+	}
+	g.patchFeatures = detectPatchFeatures(modelResponse, inputs.ver, inputs.unreleased, acknowledged, g.categories, thresholds)
+	for _, f := range g.patchFeatures {
+		log.Printf("Warning: PR #%d is an ADDED entry in patch release %s, features should not land in patch releases: %s", f.PRNumber, g.release, f.Description)
+	}
+	g.quality = computeQualityScore(modelResponse, prs, inputs.prCache, g.categories, thresholds, g.maxDescriptionLength)
+	if g.kubernetesChange != nil {
+		g.kubernetesChange.checkEntry(modelResponse, thresholds)
+	}
+	checkConfigDefaultEntries(g.configDefaultChanges, modelResponse, thresholds)
+
+	// Format the changelog
+	linkStyle := g.linkStyle
+	if linkStyle == LinkStyleAuto || linkStyle == "" {
+		linkStyle = styleLinkStyle(g.style)
+	}
+	fmtOpts := formatOptions{categories: g.categories, thresholds: thresholds, unreleased: inputs.unreleased, links: g.links, backports: g.backports, anchors: g.entryAnchors, linkStyle: linkStyle, date: now}
+	fetchCtx, cancel = stageContext(ctx, g.timeouts.Fetch)
+	defer cancel()
+	if g.windowsCallout != nil {
+		windowsPRs, err := g.calloutPRs(fetchCtx, g.windowsCallout, modelResponse, prs, thresholds)
+		if err != nil {
+			return "", promptData, modelResponse, modelDetails, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+		}
+		fmtOpts.callout = &calloutSection{header: g.windowsCallout.Header, prs: windowsPRs}
+	}
+	if g.codeOwners != nil {
+		routes, err := g.routeReviews(fetchCtx, modelResponse, thresholds)
+		if err != nil {
+			return "", promptData, modelResponse, modelDetails, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+		}
+		g.reviewRoutes = routes
+	}
+	if g.knownIssuesLabel != "" {
+		knownIssues, err := g.fetchKnownIssues(fetchCtx)
+		if err != nil {
+			return "", promptData, modelResponse, modelDetails, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+		}
+		fmtOpts.knownIssues = knownIssues
+	}
+	fmtOpts.deprecations = g.deprecations
+	if g.cliFlagsSection {
+		fmtOpts.cliFlagChanges = g.cliFlagChanges
+	}
+	if g.provenanceComment {
+		fmtOpts.provenanceComment = provenanceComment(modelDetails.Model, promptText, timestamp)
+	}
+	changelogText := formatChangelog(inputs.ver, modelResponse, fmtOpts)
+	g.fullChangeList = formatOverflow(inputs.ver, modelResponse, fmtOpts)
+
+	// Catch formatter regressions before the changelog is written anywhere
+	if err := Validate(changelogText); err != nil {
+		return "", promptData, modelResponse, modelDetails, &types.ValidationError{Err: fmt.Errorf("generated changelog failed validation: %w", err)}
+	}
+	if g.frontMatter {
+		header, err := frontMatter(inputs.ver, inputs.unreleased, now, modelResponse, g.categories, thresholds)
+		if err != nil {
+			return "", promptData, modelResponse, modelDetails, err
+		}
+		changelogText = header + changelogText
+	}
+
+	return changelogText, promptData, modelResponse, modelDetails, nil
+}
+
+// fetchInputs fetches the historical CHANGELOGs and the PRs of the release
+// from GitHub, and filters the PRs which are not sent to the model
+func (g *ChangelogGenerator) fetchInputs(ctx context.Context) (*releaseInputs, error) {
+	g.warnings = nil
+	inputs, err := g.releaseRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fromRelease, branch := inputs.fromRelease, inputs.branch
+
+	// Fetch historical CHANGELOGs
+	log.Println("Fetching historical CHANGELOGs...")
+	inputs.historicalCHANGELOGs, inputs.prCache, err = g.fetchHistoricalCHANGELOGs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch historical CHANGELOGs: %w", err)
+	}
+	log.Printf("Found %d historical PR entries", len(inputs.prCache))
+
+	if err := g.listPRs(ctx, inputs); err != nil {
+		return nil, err
+	}
+
+	g.drift = nil
+	if g.driftReport {
+		if g.drift, err = g.detectReleaseDrift(ctx, branch, fromRelease, inputs.prs); err != nil {
+			return nil, fmt.Errorf("failed to audit release drift: %w", err)
+		}
+		log.Printf("Release drift audit: %d discrepancies between %s and the selected PRs", g.drift.Discrepancies(), branch)
+	}
+
+	prs, err := g.selectPRs(ctx, inputs.prs)
+	if err != nil {
+		return nil, err
+	}
+	inputs.prs = prs
+
+	g.kubernetesChange = nil
+	if g.kubernetesVersionCheck {
+		if g.kubernetesChange, err = g.detectKubernetesVersionChange(ctx, fromRelease, branch); err != nil {
+			return nil, err
+		}
 	}
 
-	// Calculate from-release if not provided
+	g.configDefaultChanges = nil
+	if g.configDefaultsCheck {
+		if g.configDefaultChanges, err = g.detectConfigDefaultChanges(ctx, fromRelease, branch); err != nil {
+			return nil, err
+		}
+	}
+
+	g.cliFlagChanges = nil
+	if g.cliFlagsCheck {
+		if g.cliFlagChanges, err = g.detectCLIFlagChanges(ctx, fromRelease, branch); err != nil {
+			return nil, err
+		}
+	}
+
+	g.deprecations = nil
+	if g.deprecationsCheck {
+		if g.deprecations, err = g.detectDeprecations(ctx, fromRelease, branch); err != nil {
+			return nil, err
+		}
+	}
+
+	g.legend = ""
+	if g.labelLegend {
+		if g.legend, err = g.fetchLabelLegend(ctx, prs); err != nil {
+			return nil, err
+		}
+	}
+
+	if g.groupFollowUps {
+		detectFollowUps(prs)
+	}
+
+	if g.issueSymptoms {
+		log.Println("Fetching the issues fixed by the PRs...")
+		if err := g.detectFixedIssues(ctx, prs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(g.buildPaths) > 0 {
+		log.Println("Detecting build changes...")
+		if err := g.detectBuildChanges(ctx, prs); err != nil {
+			return nil, err
+		}
+	}
+
+	return inputs, nil
+}
+
+// releaseRange determines the version, the from-release and the branch of
+// the release
+func (g *ChangelogGenerator) releaseRange(ctx context.Context) (*releaseInputs, error) {
+	var ver *version.Version
+	var err error
 	fromRelease := g.fromRelease
-	if fromRelease == "" {
-		fromRelease = ver.CalculatePreviousRelease()
+	unreleased := g.release == UnreleasedRelease
+	if unreleased {
+		// Changes on main are collected as if for the next minor release
+		var lastMinor string
+		if ver, lastMinor, err = g.nextMinorRelease(ctx); err != nil {
+			return nil, err
+		}
+		if fromRelease == "" {
+			fromRelease = lastMinor
+		}
+	} else {
+		// Parse version information
+		if ver, err = version.Parse(g.release); err != nil {
+			return nil, fmt.Errorf("invalid release version: %w", err)
+		}
+
+		// Calculate from-release if not provided
+		if fromRelease == "" {
+			if fromRelease, err = g.calculateFromRelease(ctx, ver); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Determine target branch
@@ -79,52 +499,228 @@ func (g *ChangelogGenerator) Generate(ctx context.Context) (string, *types.Promp
 
 	log.Printf("Generating changelog for %s (from %s, branch: %s)", g.release, fromRelease, branch)
 
-	// Fetch historical CHANGELOGs
-	log.Println("Fetching historical CHANGELOGs...")
-	historicalCHANGELOGs, prCache, err := g.fetchHistoricalCHANGELOGs(ctx)
+	return &releaseInputs{
+		ver:         ver,
+		unreleased:  unreleased,
+		fromRelease: fromRelease,
+		branch:      branch,
+	}, nil
+}
+
+// listPRs lists the merged PRs of the release range of inputs from GitHub,
+// before any filtering
+func (g *ChangelogGenerator) listPRs(ctx context.Context, inputs *releaseInputs) error {
+	// Get the merge time of the from-release to use as start time
+	since, err := g.getReleaseStartTime(ctx, inputs.fromRelease)
 	if err != nil {
-		return "", nil, nil, nil, fmt.Errorf("failed to fetch historical CHANGELOGs: %w", err)
+		return fmt.Errorf("failed to get release start time: %w", err)
 	}
-	log.Printf("Found %d historical PR entries", len(prCache))
 
 	// Fetch PR data
 	log.Println("Fetching PR data from GitHub...")
-	prs, err := g.fetchPRs(ctx, branch, fromRelease, ver)
+	prs, err := g.fetchPRs(ctx, inputs.branch, inputs.fromRelease, since, inputs.ver)
 	if err != nil {
-		return "", nil, nil, nil, fmt.Errorf("failed to fetch PRs: %w", err)
+		return fmt.Errorf("failed to fetch PRs: %w", err)
 	}
 	log.Printf("Found %d PRs", len(prs))
 
+	inputs.since, inputs.prs = since, prs
+	return nil
+}
+
+// selectPRs filters the listed PRs which are not sent to the model, recording
+// them as skipped, and applies the overrides
+func (g *ChangelogGenerator) selectPRs(ctx context.Context, prs []types.PRInfo) ([]types.PRInfo, error) {
+	var err error
+	g.appliedOverrides = nil
+	if len(g.overrides.Include) > 0 {
+		if prs, err = g.fetchForcedPRs(ctx, prs); err != nil {
+			return nil, err
+		}
+	}
+	selected := prs
+
 	// Filter out bot-authored PRs
-	prs = filterBotPRs(prs)
+	g.skipped = nil
+	prs, skipped := filterBotPRs(prs)
+	if len(g.notableDependencies) > 0 {
+		log.Println("Detecting notable dependency upgrades...")
+		if prs, skipped, err = g.keepNotableDependencyBumps(ctx, prs, skipped, selected); err != nil {
+			return nil, err
+		}
+	}
+	g.skipped = append(g.skipped, skipped...)
 	log.Printf("After filtering bot PRs: %d PRs remaining", len(prs))
 
-	// Build the prompt
-	promptText := g.buildPrompt(historicalCHANGELOGs, prs, prCache)
-	timestamp := time.Now().Format("20060102-150405")
+	// Filter out PRs with excluded labels, PRs reverted within the release and
+	// PRs not matching the filter expression
+	prs, skipped = filterExcludedLabels(prs, g.excludedLabels)
+	g.skipped = append(g.skipped, skipped...)
+	prs, skipped = filterRevertPairs(prs)
+	g.skipped = append(g.skipped, skipped...)
+	prs, skipped = filterByExpression(prs, g.prFilter)
+	g.skipped = append(g.skipped, skipped...)
+	if g.docsOnly == DocsOnlyMark || g.docsOnly == DocsOnlyExclude {
+		log.Println("Detecting documentation-only PRs...")
+		if prs, skipped, err = g.detectDocsOnlyPRs(ctx, prs); err != nil {
+			return nil, err
+		}
+		g.skipped = append(g.skipped, skipped...)
+	}
+	prs, g.skipped = g.applyOverrides(prs, g.skipped, selected)
+	if len(g.skipped) > 0 {
+		log.Printf("Skipped %d PRs before calling the model (%d remaining)", len(g.skipped), len(prs))
+	}
+	return prs, nil
+}
 
-	promptData := &types.Prompt{
-		Text:      promptText,
-		Version:   g.release,
-		Timestamp: timestamp,
+// effectiveThresholds returns the include_score thresholds, taking into account
+// whether optional entries are emitted
+func (g *ChangelogGenerator) effectiveThresholds() config.Thresholds {
+	thresholds := g.thresholds
+	if !g.includeOptional {
+		// Only emit entries the model is confident about
+		thresholds.Optional = thresholds.Include
 	}
+	return thresholds
+}
 
-	// Call AI model
-	log.Printf("Calling AI model (model: %s)...", g.model)
-	modelResponse, modelDetails, err := g.modelCaller.Call(ctx, promptText, g.release, g.model)
+// provenanceComment builds the HTML comment recording how a changelog section was produced
+func provenanceComment(model, promptText, timestamp string) string {
+	return fmt.Sprintf("<!-- Generated by antrea-releaser %s; model: %s; prompt sha256: %x; timestamp: %s -->",
+		ToolVersion(), model, sha256.Sum256([]byte(promptText)), timestamp)
+}
+
+// fetchKnownIssues returns the open issues with the known issues label, oldest first
+func (g *ChangelogGenerator) fetchKnownIssues(ctx context.Context) ([]knownIssue, error) {
+	issues, err := g.githubClient.ListOpenIssues(ctx, repoOwner, repoName, g.knownIssuesLabel)
 	if err != nil {
-		return "", promptData, nil, nil, fmt.Errorf("failed to call AI model: %w", err)
+		return nil, fmt.Errorf("failed to fetch known issues: %w", err)
+	}
+	knownIssues := make([]knownIssue, 0, len(issues))
+	for _, issue := range issues {
+		knownIssues = append(knownIssues, knownIssue{number: issue.GetNumber(), title: issue.GetTitle()})
 	}
-	log.Printf("Received %d change entries from model", len(modelResponse.Changes))
-	log.Printf("Model latency: %.2f seconds, Total tokens: %d", modelDetails.LatencySeconds, modelDetails.TotalTokens)
+	sort.Slice(knownIssues, func(i, j int) bool {
+		return knownIssues[i].number < knownIssues[j].number
+	})
+	log.Printf("Found %d known issues with label %s", len(knownIssues), g.knownIssuesLabel)
+	return knownIssues, nil
+}
 
-	// Enrich with author information
-	g.enrichWithAuthors(modelResponse, prs)
+// HistoryConflicts returns the PRs of the last generated CHANGELOG whose
+// historical category disagrees with the model or their labels
+func (g *ChangelogGenerator) HistoryConflicts() []types.HistoryConflict {
+	return g.conflicts
+}
 
-	// Format the changelog
-	changelogText := formatChangelog(ver, modelResponse)
+// HistoricalDuplicates returns the entries of the last generated CHANGELOG
+// whose description is near-identical to a historical entry of another PR
+func (g *ChangelogGenerator) HistoricalDuplicates() []types.HistoricalDuplicate {
+	return g.duplicates
+}
 
-	return changelogText, promptData, modelResponse, modelDetails, nil
+// Warnings returns the issues of the last generated CHANGELOG which need the
+// attention of a human before releasing
+func (g *ChangelogGenerator) Warnings() []string {
+	var warnings []string
+	if len(g.conflicts) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d PRs have a historical category conflict", len(g.conflicts)))
+	}
+	if len(g.duplicates) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d entries look like duplicates of released changes", len(g.duplicates)))
+	}
+	for _, v := range g.guardrailViolations {
+		warnings = append(warnings, "guardrail violated: "+v.String())
+	}
+	if g.drift != nil && g.drift.Discrepancies() > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d discrepancies between the release branch and the selected PRs", g.drift.Discrepancies()))
+	}
+	if len(g.patchFeatures) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d unacknowledged ADDED entries in a patch release", len(g.patchFeatures)))
+	}
+	if len(g.suspiciousEntries) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d entries look derailed by instructions in the PR content", len(g.suspiciousEntries)))
+	}
+	if c := g.kubernetesChange; c != nil && !c.mentioned {
+		warnings = append(warnings, fmt.Sprintf("no CHANGED entry mentions the Kubernetes %s upgrade", c.to))
+	}
+	for _, c := range g.configDefaultChanges {
+		if !c.mentioned {
+			warnings = append(warnings, "unexplained configuration change: "+c.String())
+		}
+	}
+	return warnings
+}
+
+// RunWarnings returns all the non-fatal issues of the last generated
+// CHANGELOG, including the Warnings which need a review before releasing
+func (g *ChangelogGenerator) RunWarnings() []types.Warning {
+	warnings := append([]types.Warning(nil), g.warnings...)
+	for _, w := range g.Warnings() {
+		warnings = append(warnings, types.Warning{Kind: types.WarningKindReview, Message: w})
+	}
+	return warnings
+}
+
+// warn logs a non-fatal issue of the run and records it for RunWarnings
+func (g *ChangelogGenerator) warn(w types.Warning) {
+	log.Printf("Warning: %s", w.Message)
+	g.warnings = append(g.warnings, w)
+}
+
+// ReviewRoutes returns the owners of the entries of the last generated
+// CHANGELOG, if code owners are set
+func (g *ChangelogGenerator) ReviewRoutes() []types.ReviewRoute {
+	return g.reviewRoutes
+}
+
+// MissingLabels returns the PRs of the last generated CHANGELOG which the
+// model wants to include but which lack the action/release-note label. It is
+// only useful when all PRs are sent to the model.
+func (g *ChangelogGenerator) MissingLabels() []types.MissingLabel {
+	return g.missingLabels
+}
+
+// GuardrailViolations returns the categories of the last generated CHANGELOG
+// whose number of entries is outside of the configured limits
+func (g *ChangelogGenerator) GuardrailViolations() []types.GuardrailViolation {
+	return g.guardrailViolations
+}
+
+// PatchReleaseFeatures returns the ADDED entries of the last generated
+// CHANGELOG which were not acknowledged, if it is for a patch release
+func (g *ChangelogGenerator) PatchReleaseFeatures() []types.ChangeEntry {
+	return g.patchFeatures
+}
+
+// AppliedOverrides returns the PRs forcibly included in or excluded from the
+// last generated CHANGELOG
+func (g *ChangelogGenerator) AppliedOverrides() []types.PROverride {
+	return g.appliedOverrides
+}
+
+// ReleaseDrift returns the release drift audit of the last generated
+// CHANGELOG, or nil if it was not enabled
+func (g *ChangelogGenerator) ReleaseDrift() *types.ReleaseDrift {
+	return g.drift
+}
+
+// QualityScore returns the quality score of the last generated CHANGELOG
+func (g *ChangelogGenerator) QualityScore() types.QualityScore {
+	return g.quality
+}
+
+// FullChangeList returns the supplementary full change list of the last
+// generated CHANGELOG, with the entries left out by the maximum numbers of
+// entries per category, or "" if no entry was left out
+func (g *ChangelogGenerator) FullChangeList() string {
+	return g.fullChangeList
+}
+
+// SkippedPRs returns the PRs excluded from the last generated CHANGELOG and why
+func (g *ChangelogGenerator) SkippedPRs() []types.SkippedPR {
+	return g.skipped
 }
 
 func (g *ChangelogGenerator) enrichWithAuthors(response *types.ModelResponse, prs []types.PRInfo) {
@@ -138,6 +734,25 @@ func (g *ChangelogGenerator) enrichWithAuthors(response *types.ModelResponse, pr
 	}
 }
 
+// enrichWithBuildRefs sets the merge commits and the release tag of the
+// entries, which downstream tooling (e.g. vulnerability tracking) needs to map
+// fixes to exact builds. The tag is empty for unreleased changes.
+func enrichWithBuildRefs(response *types.ModelResponse, prs []types.PRInfo, releaseTag string) {
+	shas := make(map[int]string, len(prs))
+	for _, pr := range prs {
+		shas[pr.Number] = pr.MergeCommitSHA
+	}
+	for i := range response.Changes {
+		change := &response.Changes[i]
+		change.MergeCommitSHA = shas[change.PRNumber]
+		change.GroupedMergeCommitSHAs = nil
+		for _, number := range change.GroupedWith {
+			change.GroupedMergeCommitSHAs = append(change.GroupedMergeCommitSHAs, shas[number])
+		}
+		change.ReleaseTag = releaseTag
+	}
+}
+
 func (g *ChangelogGenerator) fetchHistoricalCHANGELOGs(ctx context.Context) (string, map[int]types.HistoricalPR, error) {
 	// List contents of CHANGELOG directory
 	dirContent, err := g.githubClient.GetDirectoryContents(ctx, repoOwner, repoName, "CHANGELOG")
@@ -187,7 +802,8 @@ func (g *ChangelogGenerator) fetchHistoricalCHANGELOGs(ctx context.Context) (str
 		// Fetch raw content
 		content, err := g.githubClient.GetFileContent(ctx, repoOwner, repoName, "CHANGELOG/"+file.name)
 		if err != nil {
-			log.Printf("Warning: failed to fetch %s: %v", file.name, err)
+			g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: "CHANGELOG/" + file.name,
+				Message: fmt.Sprintf("failed to fetch %s: %v", file.name, err)})
 			continue
 		}
 
@@ -217,19 +833,73 @@ func (g *ChangelogGenerator) fetchHistoricalCHANGELOGs(ctx context.Context) (str
 }
 
 func (g *ChangelogGenerator) parseCHANGELOG(content string, prCache map[int]types.HistoricalPR) {
+	parseCHANGELOGEntries(content, g.categories, prCache)
+}
+
+// parseCHANGELOGEntries adds the entries of a CHANGELOG to prCache, by PR
+// number. Entries of PRs already in prCache are ignored.
+func parseCHANGELOGEntries(content string, categories []config.Category, prCache map[int]types.HistoricalPR) {
+	// Regex to match PR entries: - Description. ([#123](url), [@author]), the
+	// URL depends on the link templates, and is a reference definition with
+	// LinkStyleReference
+	prRegex := regexp.MustCompile(`\[#(\d+)\](?:\([^)\s]+\))?`)
+
+	forEachCHANGELOGEntry(content, categories, func(release, category, line string) {
+		matches := prRegex.FindAllStringSubmatch(line, -1)
+		if len(matches) == 0 {
+			return
+		}
+		// Extract PR number
+		prNum, err := strconv.Atoi(matches[0][1])
+		if err != nil {
+			return
+		}
+
+		// Extract description (everything before the first [#
+		descEnd := strings.Index(line, "([#")
+		if descEnd > 0 {
+			description := entryDescription(line[:descEnd])
+
+			// Only store if not already present (first occurrence wins)
+			if _, exists := prCache[prNum]; !exists {
+				prCache[prNum] = types.HistoricalPR{
+					Description: description,
+					Category:    category,
+					Release:     release,
+				}
+			}
+		}
+	})
+}
+
+// forEachCHANGELOGEntry calls fn with each entry line of the ADDED, CHANGED
+// and FIXED sections of a CHANGELOG, with the release (X.Y.Z) and category
+// of the entry
+func forEachCHANGELOGEntry(content string, categories []config.Category, fn func(release, category, line string)) {
 	lines := strings.Split(content, "\n")
 	currentCategory := ""
-
-	// Regex to match PR entries: - Description. ([#123](url), [@author])
-	prRegex := regexp.MustCompile(`\[#(\d+)\]\(https://github\.com/antrea-io/antrea/pull/\d+\)`)
+	categoryLevel := 0
+	currentRelease := ""
 
 	for _, line := range lines {
 		trimmed := strings.TrimSpace(line)
 
-		// Detect category headers
-		if strings.HasPrefix(trimmed, "### ") {
-			category := strings.TrimPrefix(trimmed, "### ")
-			category = strings.ToUpper(strings.TrimSpace(category))
+		// Detect release headers at any level: ## X.Y.Z - YYYY-MM-DD
+		if m := releaseHeadingRegex.FindStringSubmatch(trimmed); m != nil {
+			currentRelease = m[2]
+			continue
+		}
+
+		// Detect category headers (either the default or the configured header names)
+		if m := headingRegex.FindStringSubmatch(trimmed); m != nil {
+			// Subcategory headers are nested in the category
+			if currentCategory != "" && len(m[1]) > categoryLevel {
+				continue
+			}
+			categoryLevel = len(m[1])
+			category := categoryForHeader(strings.TrimSpace(m[2]), categories)
+			// Other sections (e.g. callouts) only repeat entries
+			currentCategory = ""
 			if category == "ADDED" || category == "CHANGED" || category == "FIXED" {
 				currentCategory = category
 			}
@@ -238,47 +908,36 @@ func (g *ChangelogGenerator) parseCHANGELOG(content string, prCache map[int]type
 
 		// Parse PR entries
 		if strings.HasPrefix(trimmed, "- ") && currentCategory != "" {
-			matches := prRegex.FindAllStringSubmatch(line, -1)
-			if len(matches) > 0 {
-				// Extract PR number
-				prNum, err := strconv.Atoi(matches[0][1])
-				if err != nil {
-					continue
-				}
-
-				// Extract description (everything before the first [#
-				descEnd := strings.Index(line, "([#")
-				if descEnd > 0 {
-					description := strings.TrimSpace(line[2:descEnd]) // Skip "- " prefix
-					// Skip "*OPTIONAL*" prefix if present
-					description = strings.TrimPrefix(description, "*OPTIONAL* ")
-					description = strings.TrimSuffix(description, ".")
-
-					// Only store if not already present (first occurrence wins)
-					if _, exists := prCache[prNum]; !exists {
-						prCache[prNum] = types.HistoricalPR{
-							Description: description,
-							Category:    currentCategory,
-						}
-					}
-				}
-			}
+			fn(currentRelease, currentCategory, line)
 		}
 	}
 }
 
-func (g *ChangelogGenerator) fetchPRs(ctx context.Context, branch, fromRelease string, ver *version.Version) ([]types.PRInfo, error) {
-	var allPRs []types.PRInfo
+// entryDescription returns the description of an entry, from the text of its
+// line before the PR links
+func entryDescription(text string) string {
+	description := strings.TrimSpace(text)
+	description = strings.TrimSpace(strings.TrimPrefix(description, "- "))
+	description = entryAnchorRegex.ReplaceAllString(description, "")
+	// Skip the optional prefix if present (e.g. "*OPTIONAL*")
+	description = optionalMarkerRegex.ReplaceAllString(description, "")
+	return strings.TrimSuffix(description, ".")
+}
 
-	// Get the merge time of the from-release to use as start time
-	releaseStartTime, err := g.getReleaseStartTime(ctx, fromRelease)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get release start time: %w", err)
-	}
+func (g *ChangelogGenerator) fetchPRs(ctx context.Context, branch, fromRelease string, releaseStartTime time.Time, ver *version.Version) ([]types.PRInfo, error) {
+	var allPRs []types.PRInfo
 
 	log.Printf("Fetching PRs merged after %s", releaseStartTime.Format(time.RFC3339))
 
-	if g.all {
+	if g.prSource == PRSourceCompare {
+		// Map the commits between the from-release and the branch to PRs
+		log.Println("Fetching PRs from commits between the from-release and the branch...")
+		commitPRs, err := g.fetchPRsFromCommits(ctx, branch, fromRelease)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch PRs from commits: %w", err)
+		}
+		allPRs = append(allPRs, commitPRs...)
+	} else if g.all {
 		// Fetch all PRs (except those with kind/cherry-pick label which are handled separately)
 		log.Println("Fetching all PRs for model analysis...")
 		allMergedPRs, err := g.fetchAllPRs(ctx, branch, releaseStartTime)
@@ -289,7 +948,7 @@ func (g *ChangelogGenerator) fetchPRs(ctx context.Context, branch, fromRelease s
 	} else {
 		// Fetch only PRs with action/release-note label
 		log.Println("Fetching PRs with action/release-note label...")
-		prsWithLabel, err := g.fetchPRsWithLabel(ctx, branch, releaseStartTime, "action/release-note")
+		prsWithLabel, err := g.fetchPRsWithLabel(ctx, branch, releaseStartTime, releaseNoteLabel)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch PRs with action/release-note label: %w", err)
 		}
@@ -327,6 +986,67 @@ func (g *ChangelogGenerator) fetchPRs(ctx context.Context, branch, fromRelease s
 	return uniquePRs, nil
 }
 
+// calculateFromRelease returns the comparison baseline for the release based
+// on the existing release tags, so that skipped and yanked versions are handled
+func (g *ChangelogGenerator) calculateFromRelease(ctx context.Context, ver *version.Version) (string, error) {
+	tags, err := g.githubClient.ListTags(ctx, repoOwner, repoName)
+	if err != nil {
+		return "", fmt.Errorf("failed to list release tags: %w", err)
+	}
+	var releases []*version.Version
+	yanked := make(map[string]bool)
+	for _, v := range g.yankedReleases {
+		yanked[v] = true
+	}
+	for _, tag := range tags {
+		release, err := version.ParseTag(tag)
+		if err != nil {
+			continue
+		}
+		// The changes of yanked releases are folded into the next release
+		if yanked[release.String()] {
+			log.Printf("Skipping yanked release %s", release)
+			continue
+		}
+		releases = append(releases, release)
+	}
+
+	expected := ver.CalculatePreviousRelease()
+	previous := ver.PreviousRelease(releases)
+	if previous == nil {
+		log.Printf("Warning: no previous release found in tags, using %s", expected)
+		return expected, nil
+	}
+	if previous.String() != expected {
+		log.Printf("Release %s does not exist, using %s as the previous release", expected, previous)
+	}
+	return previous.String(), nil
+}
+
+// nextMinorRelease returns the minor release following the last minor release
+// tag, and the version of that tag
+func (g *ChangelogGenerator) nextMinorRelease(ctx context.Context) (*version.Version, string, error) {
+	tags, err := g.githubClient.ListTags(ctx, repoOwner, repoName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list release tags: %w", err)
+	}
+	var lastMinor *version.Version
+	for _, tag := range tags {
+		release, err := version.ParseTag(tag)
+		if err != nil || release.Patch() != 0 {
+			continue
+		}
+		if lastMinor == nil || release.GreaterThan(lastMinor) {
+			lastMinor = release
+		}
+	}
+	if lastMinor == nil {
+		return nil, "", fmt.Errorf("no minor release tag found")
+	}
+	log.Printf("Collecting unreleased changes since %s", lastMinor)
+	return version.New(lastMinor.Major(), lastMinor.Minor()+1, 0), lastMinor.String(), nil
+}
+
 func (g *ChangelogGenerator) getReleaseStartTime(ctx context.Context, fromRelease string) (time.Time, error) {
 	// Search for the commit that was tagged with the from-release
 	tag := "v" + fromRelease
@@ -387,12 +1107,13 @@ func (g *ChangelogGenerator) fetchPRsWithLabel(ctx context.Context, branch strin
 			}
 
 			prs = append(prs, types.PRInfo{
-				Number:   pull.GetNumber(),
-				Title:    pull.GetTitle(),
-				Body:     pull.GetBody(),
-				Author:   pull.User.GetLogin(),
-				Labels:   labels,
-				MergedAt: pull.MergedAt.Time,
+				Number:         pull.GetNumber(),
+				Title:          pull.GetTitle(),
+				Body:           pull.GetBody(),
+				Author:         prAuthor(pull),
+				Labels:         labels,
+				MergedAt:       pull.MergedAt.Time,
+				MergeCommitSHA: pull.GetMergeCommitSHA(),
 			})
 		}
 
@@ -405,8 +1126,17 @@ func (g *ChangelogGenerator) fetchPRsWithLabel(ctx context.Context, branch strin
 	return prs, nil
 }
 
+// cherryPickRegex extracts the original PR numbers referenced by the body of a cherry-pick PR
+var cherryPickRegex = regexp.MustCompile(`#(\d+)`)
+
 func (g *ChangelogGenerator) handleCherryPicks(ctx context.Context, branch string, since time.Time) ([]types.PRInfo, error) {
-	var prs []types.PRInfo
+	// Original PR numbers referenced by cherry-picks, with the cherry-pick merge time
+	type cherryPickRef struct {
+		number         int
+		mergedAt       time.Time
+		mergeCommitSHA string
+	}
+	var refs []cherryPickRef
 
 	// Fetch PRs with kind/cherry-pick label
 	opts := &gogithub.PullRequestListOptions{
@@ -419,8 +1149,7 @@ func (g *ChangelogGenerator) handleCherryPicks(ctx context.Context, branch strin
 		},
 	}
 
-	cherryPickRegex := regexp.MustCompile(`#(\d+)`)
-
+pages:
 	for {
 		pulls, resp, err := g.githubClient.ListPullRequests(ctx, repoOwner, repoName, opts)
 		if err != nil {
@@ -432,7 +1161,7 @@ func (g *ChangelogGenerator) handleCherryPicks(ctx context.Context, branch strin
 				continue
 			}
 			if pull.MergedAt.Before(since) {
-				return prs, nil
+				break pages
 			}
 
 			// Check if PR has kind/cherry-pick label
@@ -456,27 +1185,7 @@ func (g *ChangelogGenerator) handleCherryPicks(ctx context.Context, branch strin
 				if err != nil {
 					continue
 				}
-
-				// Fetch the original PR
-				originalPR, err := g.githubClient.GetPullRequest(ctx, repoOwner, repoName, prNum)
-				if err != nil {
-					log.Printf("Warning: failed to fetch original PR #%d: %v", prNum, err)
-					continue
-				}
-
-				var labels []string
-				for _, l := range originalPR.Labels {
-					labels = append(labels, l.GetName())
-				}
-
-				prs = append(prs, types.PRInfo{
-					Number:   originalPR.GetNumber(),
-					Title:    originalPR.GetTitle(),
-					Body:     originalPR.GetBody(),
-					Author:   originalPR.User.GetLogin(),
-					Labels:   labels,
-					MergedAt: pull.MergedAt.Time, // Use cherry-pick merge time
-				})
+				refs = append(refs, cherryPickRef{number: prNum, mergedAt: pull.MergedAt.Time, mergeCommitSHA: pull.GetMergeCommitSHA()})
 			}
 		}
 
@@ -486,6 +1195,50 @@ func (g *ChangelogGenerator) handleCherryPicks(ctx context.Context, branch strin
 		opts.Page = resp.NextPage
 	}
 
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	// Fetch all the original PRs in batches
+	numbers := make([]int, 0, len(refs))
+	for _, ref := range refs {
+		numbers = append(numbers, ref.number)
+	}
+	// Original PRs which cannot be fetched are skipped with a warning
+	originalPRs, err := g.githubClient.GetPullRequests(ctx, repoOwner, repoName, numbers)
+	reason := "not found"
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("failed to fetch original PRs: %w", err)
+		}
+		reason = err.Error()
+	}
+
+	var prs []types.PRInfo
+	for _, ref := range refs {
+		originalPR, ok := originalPRs[ref.number]
+		if !ok {
+			g.warn(types.Warning{Kind: types.WarningKindUnreachablePR, PRNumber: ref.number,
+				Message: fmt.Sprintf("failed to fetch original PR #%d: %s", ref.number, reason)})
+			continue
+		}
+
+		var labels []string
+		for _, l := range originalPR.Labels {
+			labels = append(labels, l.GetName())
+		}
+
+		prs = append(prs, types.PRInfo{
+			Number:         originalPR.GetNumber(),
+			Title:          originalPR.GetTitle(),
+			Body:           originalPR.GetBody(),
+			Author:         prAuthor(originalPR),
+			Labels:         labels,
+			MergedAt:       ref.mergedAt, // Use cherry-pick merge time
+			MergeCommitSHA: ref.mergeCommitSHA,
+		})
+	}
+
 	return prs, nil
 }
 
@@ -535,12 +1288,13 @@ func (g *ChangelogGenerator) fetchAllPRs(ctx context.Context, branch string, sin
 			}
 
 			prs = append(prs, types.PRInfo{
-				Number:   pull.GetNumber(),
-				Title:    pull.GetTitle(),
-				Body:     pull.GetBody(),
-				Author:   pull.User.GetLogin(),
-				Labels:   labels,
-				MergedAt: pull.MergedAt.Time,
+				Number:         pull.GetNumber(),
+				Title:          pull.GetTitle(),
+				Body:           pull.GetBody(),
+				Author:         prAuthor(pull),
+				Labels:         labels,
+				MergedAt:       pull.MergedAt.Time,
+				MergeCommitSHA: pull.GetMergeCommitSHA(),
 			})
 		}
 
@@ -559,18 +1313,86 @@ func (g *ChangelogGenerator) buildPrompt(historicalCHANGELOGs string, prs []type
 	sb.WriteString(prompt.Template)
 	sb.WriteString("\n\n")
 
+	if g.maxDescriptionLength > 0 {
+		sb.WriteString(fmt.Sprintf("**Each description MUST be a single sentence of at most %d characters.**\n\n", g.maxDescriptionLength))
+	}
+	sb.WriteString(stylePrompt(g.style))
+
+	for _, pr := range prs {
+		if len(pr.BuildFiles) > 0 {
+			sb.WriteString(platformHintsPrompt)
+			break
+		}
+	}
+	if countDocsOnly(prs) > 0 {
+		sb.WriteString(docsOnlyPrompt)
+	}
+	for _, pr := range prs {
+		if pr.NotableDependency != "" {
+			sb.WriteString(notableDependenciesPrompt)
+			break
+		}
+	}
+	for _, pr := range prs {
+		if pr.FollowUpOf != 0 {
+			sb.WriteString(followUpsPrompt)
+			break
+		}
+	}
+	for _, pr := range prs {
+		if len(pr.FixedIssues) > 0 {
+			sb.WriteString(fixedIssuesPrompt)
+			break
+		}
+	}
+
+	if g.kubernetesChange != nil {
+		sb.WriteString(g.kubernetesChange.prompt())
+	}
+	if len(g.configDefaultChanges) > 0 {
+		sb.WriteString(configDefaultsPrompt(g.configDefaultChanges))
+	}
+	if len(g.cliFlagChanges) > 0 {
+		sb.WriteString(cliFlagsPrompt(g.cliFlagChanges))
+	}
+
+	sb.WriteString(subcategoriesPrompt(g.categories))
+
+	if len(g.corrections) > 0 {
+		sb.WriteString(correctionsPrompt(g.corrections))
+	}
+
+	sb.WriteString(g.legend)
+
 	// Add historical CHANGELOGs
 	sb.WriteString("# HISTORICAL CHANGELOGS (for reference and consistency)\n\n")
 	sb.WriteString(historicalCHANGELOGs)
 	sb.WriteString("\n\n")
 
+	sb.WriteString(untrustedInputPrompt)
+
 	// Add PR list
 	sb.WriteString("# PULL REQUESTS FOR THIS RELEASE\n\n")
 	for _, pr := range prs {
 		sb.WriteString(fmt.Sprintf("## PR #%d\n", pr.Number))
-		sb.WriteString(fmt.Sprintf("**Title:** %s\n", pr.Title))
+		sb.WriteString(fmt.Sprintf("**Title:** %s\n", g.promptTitle(pr.Title, "PR", pr.Number, pr.Number)))
 		sb.WriteString(fmt.Sprintf("**Author:** %s\n", pr.Author))
 		sb.WriteString(fmt.Sprintf("**Labels:** %s\n", strings.Join(pr.Labels, ", ")))
+		if len(pr.BuildFiles) > 0 {
+			sb.WriteString(fmt.Sprintf("**Build changes:** %s\n", strings.Join(pr.BuildFiles, ", ")))
+		}
+		if pr.DocsOnly {
+			sb.WriteString("**Documentation only:** yes\n")
+		}
+		if pr.NotableDependency != "" {
+			sb.WriteString(fmt.Sprintf("**Notable dependency:** %s\n", pr.NotableDependency))
+		}
+		if pr.FollowUpOf != 0 {
+			sb.WriteString(fmt.Sprintf("**Follow-up of:** #%d\n", pr.FollowUpOf))
+		}
+		for _, issue := range pr.FixedIssues {
+			sb.WriteString(fmt.Sprintf("**Fixes issue:** #%d: %s\n", issue.Number, g.promptTitle(issue.Title, "issue", issue.Number, pr.Number)))
+		}
 
 		// Check if this PR is in historical cache
 		if historical, exists := prCache[pr.Number]; exists {
@@ -579,18 +1401,59 @@ func (g *ChangelogGenerator) buildPrompt(historicalCHANGELOGs string, prs []type
 			sb.WriteString(fmt.Sprintf("- Description: %s\n", historical.Description))
 		}
 
-		sb.WriteString(fmt.Sprintf("**Body:**\n%s\n", pr.Body))
+		body, removed := sanitizePRBody(pr.Body)
+		if removed > 0 {
+			g.warn(types.Warning{Kind: types.WarningKindPromptInjection, PRNumber: pr.Number,
+				Message: fmt.Sprintf("removed %d lines of the body of PR #%d which look like instructions to the model", removed, pr.Number)})
+		}
+		sb.WriteString(fmt.Sprintf("**Body:**\n%s\n%s\n%s\n", prBodyBegin, body, prBodyEnd))
 		sb.WriteString("\n---\n\n")
 	}
 
 	return sb.String()
 }
 
+// promptTitle returns the sanitized title of a PR or issue of the PR prNumber
+// for the prompt, warning if it was replaced
+func (g *ChangelogGenerator) promptTitle(title, kind string, number, prNumber int) string {
+	sanitized, removed := sanitizeTitle(title)
+	if removed {
+		g.warn(types.Warning{Kind: types.WarningKindPromptInjection, PRNumber: prNumber,
+			Message: fmt.Sprintf("removed the title of %s #%d which looks like instructions to the model", kind, number)})
+	}
+	return sanitized
+}
+
 const (
 	repoOwner = "antrea-io"
 	repoName  = "antrea"
+
+	// releaseNoteLabel is the label of the PRs to include in the CHANGELOG
+	releaseNoteLabel = "action/release-note"
 )
 
+// UnreleasedRelease can be used instead of a release version to generate an
+// Unreleased section with the changes merged into main since the last minor release
+const UnreleasedRelease = "unreleased"
+
+// ghostAuthor is the login of the GitHub user which the accounts of deleted
+// users are replaced with
+const ghostAuthor = "ghost"
+
+// prAuthor returns the login of the author of a PR, or ghostAuthor if the
+// account was deleted (the GraphQL API has no author for these PRs)
+func prAuthor(pull *gogithub.PullRequest) string {
+	if login := pull.GetUser().GetLogin(); login != "" {
+		return login
+	}
+	return ghostAuthor
+}
+
+// isDeletedAuthor reports whether the author of a PR is a deleted account
+func isDeletedAuthor(author string) bool {
+	return author == ghostAuthor || author == ""
+}
+
 var ignoredAuthors = map[string]bool{
 	"renovate[bot]":   true,
 	"dependabot":      true,
@@ -607,12 +1470,15 @@ func determineBranch(v *version.Version) string {
 }
 
 // filterBotPRs filters out PRs authored by bots
-func filterBotPRs(prs []types.PRInfo) []types.PRInfo {
+func filterBotPRs(prs []types.PRInfo) ([]types.PRInfo, []types.SkippedPR) {
 	filtered := make([]types.PRInfo, 0, len(prs))
+	var skipped []types.SkippedPR
 	for _, pr := range prs {
-		if !ignoredAuthors[pr.Author] {
-			filtered = append(filtered, pr)
+		if ignoredAuthors[pr.Author] {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonBotAuthor, pr.Author))
+			continue
 		}
+		filtered = append(filtered, pr)
 	}
-	return filtered
+	return filtered, skipped
 }
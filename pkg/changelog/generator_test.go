@@ -16,6 +16,9 @@ package changelog
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,8 +27,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
 	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
 )
 
 func TestGenerate_MinorRelease(t *testing.T) {
@@ -55,6 +60,7 @@ func TestGenerate_MinorRelease(t *testing.T) {
 	// Verify prompt data
 	assert.Equal(t, "2.5.0", promptData.Version, "Prompt version should match")
 	assert.Contains(t, promptData.Text, "PULL REQUESTS FOR THIS RELEASE", "Prompt should contain PR section")
+	assert.Contains(t, promptData.Text, "**Body:**\n<pr-body>\nThis adds feature X\n</pr-body>\n", "PR bodies should be delimited")
 
 	// Verify model response
 	assert.Len(t, modelResponse.Changes, 2, "Should have 2 changes")
@@ -71,6 +77,26 @@ func TestGenerate_MinorRelease(t *testing.T) {
 	assert.Contains(t, changelogText, "[#1234]", "Changelog should contain PR link")
 }
 
+func TestGenerate_FixedClock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseExpectations(t, mockGitHubClient, mockModelCaller)
+
+	// A clock in another time zone than UTC, late in the evening
+	pst := time.FixedZone("PST", -8*60*60)
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient,
+		WithClock(FixedClock(time.Date(2025, 1, 30, 23, 30, 25, 0, pst))), WithProvenanceComment(true))
+
+	changelogText, promptData, _, _, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "20250130-233025", promptData.Timestamp)
+	assert.Contains(t, changelogText, "## 2.5.0 - 2025-01-30\n")
+	assert.Contains(t, changelogText, "timestamp: 20250130-233025 -->")
+}
+
 func TestGenerate_PatchRelease(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -186,6 +212,71 @@ func TestGenerate_OptionalPrefix(t *testing.T) {
 	assert.Contains(t, changelogText, "*OPTIONAL*", "Should include *OPTIONAL* prefix for low-confidence changes")
 }
 
+func TestGenerate_ExcludeOptional(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	setupOptionalPrefixExpectations(t, mockGitHubClient, mockModelCaller)
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithIncludeOptional(false),
+	)
+
+	changelogText, _, _, _, err := generator.Generate(context.Background())
+	require.NoError(t, err, "Generate() should not fail")
+
+	assert.NotContains(t, changelogText, "*OPTIONAL*", "Should not emit optional entries")
+	assert.NotContains(t, changelogText, "#7777")
+	skipped := generator.SkippedPRs()
+	require.Len(t, skipped, 1, "Optional entry should be reported as skipped")
+	assert.Equal(t, 7777, skipped[0].Number)
+	assert.Equal(t, types.SkipReasonLowIncludeScore, skipped[0].Reason)
+}
+
+func TestGenerate_KnownIssues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	setupOptionalPrefixExpectations(t, mockGitHubClient, mockModelCaller)
+	issueNum1, issueTitle1 := 7000, "Egress IP is lost on agent restart."
+	issueNum2, issueTitle2 := 6900, "antctl supportbundle fails on Windows"
+	mockGitHubClient.EXPECT().
+		ListOpenIssues(gomock.Any(), "antrea-io", "antrea", "known-issue/v2.5").
+		Return([]*gogithub.Issue{
+			{Number: &issueNum1, Title: &issueTitle1},
+			{Number: &issueNum2, Title: &issueTitle2},
+		}, nil)
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithKnownIssuesLabel("known-issue/v2.5"),
+	)
+
+	changelogText, _, _, _, err := generator.Generate(context.Background())
+	require.NoError(t, err, "Generate() should not fail")
+
+	assert.Contains(t, changelogText, "### Known Issues\n\n"+
+		"- antctl supportbundle fails on Windows. ([#6900](https://github.com/antrea-io/antrea/issues/6900))\n"+
+		"- Egress IP is lost on agent restart. ([#7000](https://github.com/antrea-io/antrea/issues/7000))\n")
+}
+
 func TestGenerate_ExcludeLowScore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -215,6 +306,218 @@ func TestGenerate_ExcludeLowScore(t *testing.T) {
 
 	// But changelog should NOT include PR #9999 (score < 25)
 	assert.NotContains(t, changelogText, "#9999", "Should exclude changes with include_score < 25 from changelog")
+
+	// And the exclusion should be reported
+	skipped := generator.SkippedPRs()
+	require.Len(t, skipped, 1, "Should report 1 skipped PR")
+	assert.Equal(t, 9999, skipped[0].Number)
+	assert.Equal(t, "Trivial change", skipped[0].Title)
+	assert.Equal(t, types.SkipReasonLowIncludeScore, skipped[0].Reason)
+}
+
+func TestGenerate_ScoreThresholds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	setupExcludeLowScoreExpectations(t, mockGitHubClient, mockModelCaller)
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithScoreThresholds(config.Thresholds{Include: 90, Optional: 10}),
+	)
+
+	changelogText, _, _, _, err := generator.Generate(context.Background())
+	require.NoError(t, err, "Generate() should not fail")
+
+	assert.Contains(t, changelogText, "- *OPTIONAL* Good change.", "Should apply the configured include threshold")
+	assert.Contains(t, changelogText, "- *OPTIONAL* Trivial change.", "Should apply the configured optional threshold")
+	assert.Empty(t, generator.SkippedPRs())
+}
+
+func TestGenerate_ProvenanceComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	setupMinorReleaseExpectations(t, mockGitHubClient, mockModelCaller)
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithProvenanceComment(true),
+	)
+
+	ctx := context.Background()
+	changelogText, promptData, _, _, err := generator.Generate(ctx)
+
+	require.NoError(t, err, "Generate() should not fail")
+
+	assert.Regexp(t, `## 2\.5\.0 - \S+\n\n<!-- Generated by antrea-releaser \S+; model: gemini-2\.5-flash; prompt sha256: [0-9a-f]{64}; timestamp: `+promptData.Timestamp+` -->\n`,
+		changelogText, "Provenance comment should follow the release header")
+}
+
+func TestGenerate_SkippedPreviousRelease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	// 2.4.1 was never released, so 2.4.2 is compared with 2.4.0
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		Return([]*gogithub.RepositoryContent{}, nil)
+	expectReleaseTags(mockGitHubClient, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
+	mockGitHubClient.EXPECT().
+		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(nil, assert.AnError)
+
+	generator := NewChangelogGenerator("2.4.2", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient)
+	_, _, _, _, err := generator.Generate(context.Background())
+	require.ErrorIs(t, err, assert.AnError, "Generate() should stop after looking up the v2.4.0 tag")
+}
+
+func TestGenerate_YankedPreviousRelease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	// 2.4.1 was yanked, so its changes are included in 2.4.2
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		Return([]*gogithub.RepositoryContent{}, nil)
+	expectReleaseTags(mockGitHubClient, "v2.4.0", "v2.4.1")
+	mockGitHubClient.EXPECT().
+		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(nil, assert.AnError)
+
+	generator := NewChangelogGenerator("2.4.2", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient,
+		WithYankedReleases([]string{"2.4.1"}))
+	_, _, _, _, err := generator.Generate(context.Background())
+	require.ErrorIs(t, err, assert.AnError, "Generate() should stop after looking up the v2.4.0 tag")
+}
+
+func TestGenerate_Unreleased(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	// Unreleased changes are collected since the last minor release, ignoring patch releases
+	expectReleaseTags(mockGitHubClient, "v2.3.0", "v2.4.0", "v2.4.1", "v2.5.0-rc.1")
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		Return([]*gogithub.RepositoryContent{}, nil)
+	mockGitHubClient.EXPECT().
+		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(nil, assert.AnError)
+
+	generator := NewChangelogGenerator(UnreleasedRelease, "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient)
+	_, _, _, _, err := generator.Generate(context.Background())
+	require.ErrorIs(t, err, assert.AnError, "Generate() should stop after looking up the v2.4.0 tag")
+
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature", Author: "alice"},
+		},
+	}
+	changelogText := formatChangelog(version.New(2, 5, 0), response, formatOptions{thresholds: config.DefaultThresholds(), unreleased: true})
+	assert.True(t, strings.HasPrefix(changelogText, "## Unreleased\n\n### Added\n"), "Changelog should start with the Unreleased header")
+	assert.NotContains(t, changelogText, "2.5")
+}
+
+func TestFormatChangelog_LinkTemplates(t *testing.T) {
+	links := config.LinkTemplates{
+		PR:     "https://gitea.example.com/antrea/antrea/pulls/{{.Number}}",
+		Issue:  "https://gitea.example.com/antrea/antrea/issues/{{.Number}}",
+		Author: "https://gitea.example.com/{{.Author}}",
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", IncludeScore: 90, Description: "Fix crash", Author: "alice"},
+		},
+	}
+	changelogText := formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		categories:  config.DefaultCategories(),
+		thresholds:  config.DefaultThresholds(),
+		knownIssues: []knownIssue{{number: 7, title: "Egress IP is lost"}},
+		links:       links,
+	})
+	assert.Contains(t, changelogText, "- Fix crash. ([#100](https://gitea.example.com/antrea/antrea/pulls/100), [@alice])\n")
+	assert.Contains(t, changelogText, "- Egress IP is lost. ([#7](https://gitea.example.com/antrea/antrea/issues/7))\n")
+	assert.Contains(t, changelogText, "[@alice]: https://gitea.example.com/alice\n")
+	assert.NotContains(t, changelogText, "github.com")
+
+	// Historical entries are found whatever the link URLs
+	generator := NewChangelogGenerator("2.6.0", "", false, "gemini-2.5-flash", nil, nil)
+	prCache := make(map[int]types.HistoricalPR)
+	generator.parseCHANGELOG(changelogText, prCache)
+	assert.Equal(t, types.HistoricalPR{Description: "Fix crash", Category: "FIXED", Release: "2.5.0"}, prCache[100])
+}
+
+func TestFormatChangelog_EntryAnchors(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", IncludeScore: 90, Description: "Fix route deletion on Windows Nodes", Author: "alice"},
+			{PRNumber: 101, Category: "ADDED", IncludeScore: 40, Description: "Add Egress support for Windows", Author: "bob"},
+		},
+	}
+	changelogText := formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		callout:    &calloutSection{header: "Windows", prs: map[int]bool{100: true}},
+		anchors:    true,
+	})
+	assert.Contains(t, changelogText, "- <a id=\"pr-101\"></a>*OPTIONAL* Add Egress support for Windows. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])\n")
+	assert.Contains(t, changelogText, "### Fixed\n\n- <a id=\"pr-100\"></a>Fix route deletion on Windows Nodes.")
+	// Callout entries repeat the category entries without their anchor
+	assert.Contains(t, changelogText, "### Windows\n\n- Fix route deletion on Windows Nodes.")
+	assert.NoError(t, Validate(changelogText))
+
+	generator := NewChangelogGenerator("2.6.0", "", false, "gemini-2.5-flash", nil, nil)
+	prCache := make(map[int]types.HistoricalPR)
+	generator.parseCHANGELOG(changelogText, prCache)
+	assert.Equal(t, "Fix route deletion on Windows Nodes", prCache[100].Description)
+	assert.Equal(t, "Add Egress support for Windows", prCache[101].Description)
+
+	assert.NotContains(t, FormatEmail(changelogText), "<a id=")
+}
+
+func TestEnrichWithBuildRefs(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, MergeCommitSHA: "sha100"},
+		{Number: 101, MergeCommitSHA: "sha101"},
+		{Number: 102, MergeCommitSHA: "sha102"},
+	}
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, GroupedWith: []int{102}},
+		{PRNumber: 101},
+	}}
+	enrichWithBuildRefs(response, prs, "v2.5.0")
+	assert.Equal(t, []types.ChangeEntry{
+		{PRNumber: 100, GroupedWith: []int{102}, MergeCommitSHA: "sha100", GroupedMergeCommitSHAs: []string{"sha102"}, ReleaseTag: "v2.5.0"},
+		{PRNumber: 101, MergeCommitSHA: "sha101", ReleaseTag: "v2.5.0"},
+	}, response.Changes)
+
+	data, err := json.Marshal(response.Changes[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"merge_commit_sha":"sha100","grouped_merge_commit_shas":["sha102"],"release_tag":"v2.5.0"`)
 }
 
 func TestFilterBotPRs(t *testing.T) {
@@ -227,9 +530,13 @@ func TestFilterBotPRs(t *testing.T) {
 		{Number: 6, Author: "user2"},
 	}
 
-	filtered := filterBotPRs(prs)
+	filtered, skipped := filterBotPRs(prs)
 
 	assert.Len(t, filtered, 2, "Should have 2 PRs after filtering")
+	assert.Len(t, skipped, 4, "Should report 4 skipped bot PRs")
+	for _, s := range skipped {
+		assert.Equal(t, types.SkipReasonBotAuthor, s.Reason)
+	}
 
 	for _, pr := range filtered {
 		assert.NotContains(t, []string{"renovate[bot]", "dependabot", "dependabot[bot]", "antrea-bot"},
@@ -239,8 +546,53 @@ func TestFilterBotPRs(t *testing.T) {
 
 // Helper functions to setup mock expectations
 
+// expectReleaseTags mocks the tags used to calculate the from-release
+func expectReleaseTags(mockGitHub *mocks.MockGitHubClient, tags ...string) {
+	mockGitHub.EXPECT().
+		ListTags(gomock.Any(), "antrea-io", "antrea").
+		Return(tags, nil)
+}
+
 func setupMinorReleaseExpectations(t *testing.T, mockGitHub *mocks.MockGitHubClient, mockModel *mocks.MockModelCaller) {
 	t.Helper()
+	setupMinorReleaseGitHubExpectations(t, mockGitHub)
+
+	// Mock model call
+	mockModel.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		Return(&types.ModelResponse{
+			Changes: []types.ChangeEntry{
+				{
+					PRNumber:          1234,
+					Category:          "ADDED",
+					Description:       "Add new feature X",
+					IncludeScore:      100,
+					ImportanceScore:   90,
+					ReusedFromHistory: false,
+				},
+				{
+					PRNumber:          5678,
+					Category:          "FIXED",
+					Description:       "Fix bug Y",
+					IncludeScore:      100,
+					ImportanceScore:   85,
+					ReusedFromHistory: false,
+				},
+			},
+		}, &types.ModelDetails{
+			Version:          "2.5.0",
+			Timestamp:        time.Now().Format("20060102-150405"),
+			Model:            "gemini-2.5-flash",
+			LatencySeconds:   1.5,
+			TotalTokens:      1000,
+			EstimatedCostUSD: 0.001,
+		}, nil)
+}
+
+// setupMinorReleaseGitHubExpectations sets up the GitHub calls of a 2.5.0 run
+// with PRs #1234 (author1) and #5678 (author2)
+func setupMinorReleaseGitHubExpectations(t *testing.T, mockGitHub *mocks.MockGitHubClient) {
+	t.Helper()
 
 	// Mock GetDirectoryContents for CHANGELOG directory
 	changelog := "CHANGELOG-2.4.md"
@@ -264,6 +616,7 @@ func setupMinorReleaseExpectations(t *testing.T, mockGitHub *mocks.MockGitHubCli
 
 	// Mock GetTagRef for from-release
 	sha := "abc123"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -318,37 +671,6 @@ func setupMinorReleaseExpectations(t *testing.T, mockGitHub *mocks.MockGitHubCli
 				},
 			},
 		}, &gogithub.Response{NextPage: 0}, nil)
-
-	// Mock model call
-	mockModel.EXPECT().
-		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
-		Return(&types.ModelResponse{
-			Changes: []types.ChangeEntry{
-				{
-					PRNumber:          1234,
-					Category:          "ADDED",
-					Description:       "Add new feature X",
-					IncludeScore:      100,
-					ImportanceScore:   90,
-					ReusedFromHistory: false,
-				},
-				{
-					PRNumber:          5678,
-					Category:          "FIXED",
-					Description:       "Fix bug Y",
-					IncludeScore:      100,
-					ImportanceScore:   85,
-					ReusedFromHistory: false,
-				},
-			},
-		}, &types.ModelDetails{
-			Version:          "2.5.0",
-			Timestamp:        time.Now().Format("20060102-150405"),
-			Model:            "gemini-2.5-flash",
-			LatencySeconds:   1.5,
-			TotalTokens:      1000,
-			EstimatedCostUSD: 0.001,
-		}, nil)
 }
 
 func setupPatchReleaseExpectations(t *testing.T, mockGitHub *mocks.MockGitHubClient, mockModel *mocks.MockModelCaller) {
@@ -370,6 +692,7 @@ func setupPatchReleaseExpectations(t *testing.T, mockGitHub *mocks.MockGitHubCli
 
 	// Mock GetTagRef
 	sha := "def456"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -452,6 +775,7 @@ func setupAllFlagExpectations(t *testing.T, mockGitHub *mocks.MockGitHubClient,
 
 	// Mock GetTagRef
 	sha := "ghi789"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -531,6 +855,7 @@ func setupBotFilteringExpectations(t *testing.T, mockGitHub *mocks.MockGitHubCli
 
 	// Mock GetTagRef
 	sha := "jkl012"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -628,6 +953,7 @@ func setupOptionalPrefixExpectations(t *testing.T, mockGitHub *mocks.MockGitHubC
 
 	// Mock GetTagRef
 	sha := "mno345"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -710,6 +1036,7 @@ func setupExcludeLowScoreExpectations(t *testing.T, mockGitHub *mocks.MockGitHub
 
 	// Mock GetTagRef
 	sha := "pqr678"
+	expectReleaseTags(mockGitHub, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
 	mockGitHub.EXPECT().
 		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
 		Return(&gogithub.Reference{
@@ -795,3 +1122,283 @@ func setupExcludeLowScoreExpectations(t *testing.T, mockGitHub *mocks.MockGitHub
 			EstimatedCostUSD: 0.0005,
 		}, nil)
 }
+
+func TestGenerate_CherryPicks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		Return([]*gogithub.RepositoryContent{}, nil)
+
+	sha := "stu901"
+	expectReleaseTags(mockGitHubClient, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
+	mockGitHubClient.EXPECT().
+		GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(&gogithub.Reference{Object: &gogithub.GitObject{SHA: &sha}}, nil)
+	mockGitHubClient.EXPECT().
+		GetCommit(gomock.Any(), "antrea-io", "antrea", sha).
+		Return(&gogithub.Commit{
+			Committer: &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: time.Now().Add(-10 * 24 * time.Hour)}},
+		}, nil)
+
+	// The cherry-pick PR references two original PRs, which must be fetched in a single batch
+	mergedAt := time.Now()
+	mockGitHubClient.EXPECT().
+		ListPullRequests(gomock.Any(), "antrea-io", "antrea", gomock.Any()).
+		Return([]*gogithub.PullRequest{
+			{
+				Number:         gogithub.Ptr(4001),
+				Title:          gogithub.Ptr("[release-2.4] Cherry pick of #3001 #3002"),
+				Body:           gogithub.Ptr("Cherry pick of #3001 #3002 on release-2.4."),
+				User:           &gogithub.User{Login: gogithub.Ptr("author8")},
+				MergedAt:       &gogithub.Timestamp{Time: mergedAt},
+				Labels:         []*gogithub.Label{{Name: gogithub.Ptr("kind/cherry-pick")}},
+				MergeCommitSHA: gogithub.Ptr("cp4001"),
+			},
+		}, &gogithub.Response{NextPage: 0}, nil).Times(2)
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{3001, 3002}).
+		Return(map[int]*gogithub.PullRequest{
+			3001: {
+				Number: gogithub.Ptr(3001),
+				Title:  gogithub.Ptr("Fix crash in agent"),
+				User:   &gogithub.User{Login: gogithub.Ptr("author9")},
+				Labels: []*gogithub.Label{{Name: gogithub.Ptr("action/backport")}},
+			},
+		}, nil)
+
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.4.1", "gemini-2.5-flash").
+		Return(&types.ModelResponse{Changes: []types.ChangeEntry{
+			{PRNumber: 3001, Category: "FIXED", Description: "Fix crash in agent", IncludeScore: 90},
+		}}, &types.ModelDetails{Version: "2.4.1", Model: "gemini-2.5-flash"}, nil)
+
+	generator := NewChangelogGenerator("2.4.1", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient)
+
+	_, promptData, modelResponse, _, err := generator.Generate(context.Background())
+	require.NoError(t, err, "Generate() should not fail")
+
+	// Backported fixes map to the cherry-pick commit on the release branch
+	require.Len(t, modelResponse.Changes, 1)
+	assert.Equal(t, "cp4001", modelResponse.Changes[0].MergeCommitSHA)
+	assert.Equal(t, "v2.4.1", modelResponse.Changes[0].ReleaseTag)
+
+	assert.Contains(t, promptData.Text, "## PR #3001\n**Title:** Fix crash in agent\n**Author:** author9\n**Labels:** action/backport\n")
+	assert.NotContains(t, promptData.Text, "PR #3002", "Missing original PRs should be skipped")
+	assert.Contains(t, generator.RunWarnings(), types.Warning{Kind: types.WarningKindUnreachablePR, PRNumber: 3002,
+		Message: "failed to fetch original PR #3002: not found"})
+}
+
+func TestGenerate_WindowLimits(t *testing.T) {
+	tests := []struct {
+		name            string
+		tagAge          time.Duration
+		limits          WindowLimits
+		confirm         bool
+		confirmDelay    time.Duration
+		expectedWarning string
+	}{
+		{
+			name:   "within limits",
+			tagAge: 30 * 24 * time.Hour,
+			limits: WindowLimits{MaxMonths: 6, MaxPRs: 2},
+		},
+		{
+			name:            "too old, confirmed",
+			tagAge:          365 * 24 * time.Hour,
+			limits:          WindowLimits{MaxMonths: 6},
+			confirm:         true,
+			expectedWarning: "more than 6 months ago",
+		},
+		{
+			name:            "too many PRs, declined",
+			tagAge:          30 * 24 * time.Hour,
+			limits:          WindowLimits{MaxPRs: 1},
+			expectedWarning: "2 PRs would be sent to the model",
+		},
+		{
+			name:            "confirmed after the fetch timeout",
+			tagAge:          365 * 24 * time.Hour,
+			limits:          WindowLimits{MaxMonths: 6},
+			confirm:         true,
+			confirmDelay:    200 * time.Millisecond,
+			expectedWarning: "more than 6 months ago",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModelCaller := mocks.NewMockModelCaller(ctrl)
+			mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+			sha := "vwx234"
+			mockGitHubClient.EXPECT().
+				GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+				Return([]*gogithub.RepositoryContent{}, nil)
+			expectReleaseTags(mockGitHubClient, "v2.3.0", "v2.4.0", "v2.5.0-rc.1")
+			mockGitHubClient.EXPECT().
+				GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+				Return(&gogithub.Reference{Object: &gogithub.GitObject{SHA: &sha}}, nil)
+			mockGitHubClient.EXPECT().
+				GetCommit(gomock.Any(), "antrea-io", "antrea", sha).
+				Return(&gogithub.Commit{
+					Committer: &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: time.Now().Add(-tt.tagAge)}},
+				}, nil)
+			var pulls []*gogithub.PullRequest
+			for _, number := range []int{1, 2} {
+				pulls = append(pulls, &gogithub.PullRequest{
+					Number:   gogithub.Ptr(number),
+					User:     &gogithub.User{Login: gogithub.Ptr("author")},
+					MergedAt: &gogithub.Timestamp{Time: time.Now()},
+					Labels:   []*gogithub.Label{{Name: gogithub.Ptr("action/release-note")}},
+				})
+			}
+			mockGitHubClient.EXPECT().
+				ListPullRequests(gomock.Any(), "antrea-io", "antrea", gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _, _ string, _ *gogithub.PullRequestListOptions) ([]*gogithub.PullRequest, *gogithub.Response, error) {
+					if err := ctx.Err(); err != nil {
+						return nil, nil, err
+					}
+					return pulls, &gogithub.Response{NextPage: 0}, nil
+				})
+
+			aborted := tt.expectedWarning != "" && !tt.confirm
+			if !aborted {
+				mockModelCaller.EXPECT().
+					Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+					Return(&types.ModelResponse{}, &types.ModelDetails{}, nil)
+			}
+
+			var warnings []string
+			confirm := func(warning string) bool {
+				warnings = append(warnings, warning)
+				time.Sleep(tt.confirmDelay)
+				return tt.confirm
+			}
+			generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient,
+				WithWindowLimits(tt.limits, confirm), WithStageTimeouts(StageTimeouts{Fetch: 100 * time.Millisecond}))
+
+			_, _, _, _, err := generator.Generate(context.Background())
+
+			if aborted {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "aborted")
+				if tt.limits.MaxPRs > 0 {
+					var budgetErr *types.BudgetExceededError
+					assert.ErrorAs(t, err, &budgetErr)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectedWarning == "" {
+				assert.Empty(t, warnings)
+			} else {
+				require.Len(t, warnings, 1)
+				assert.Contains(t, warnings[0], tt.expectedWarning)
+			}
+		})
+	}
+}
+
+func TestGenerate_StageTimeouts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+
+	// Simulate a stuck connection, which only returns once the context is done
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		DoAndReturn(func(ctx context.Context, owner, repo, path string) ([]*gogithub.RepositoryContent, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"2.4.0",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithStageTimeouts(StageTimeouts{Fetch: 10 * time.Millisecond, Model: time.Minute}),
+	)
+
+	_, _, _, _, err := generator.Generate(context.Background())
+	require.Error(t, err)
+	var timeoutErr *types.StageTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, StageFetch, timeoutErr.Stage)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, types.IsRetryable(err))
+	assert.Contains(t, err.Error(), "fetch stage timed out after 10ms")
+}
+
+func TestGenerate_ModelFailureReportsCost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseGitHubExpectations(t, mockGitHubClient)
+
+	// The model answered, but its output could not be parsed: the call was billed
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		Return(nil, &types.ModelDetails{TotalTokens: 1000, EstimatedCostUSD: 0.01, Calls: 1}, &types.ModelMalformedOutputError{Err: errors.New("unexpected end of JSON input")})
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient)
+
+	_, _, _, modelDetails, err := generator.Generate(context.Background())
+	require.Error(t, err)
+	require.NotNil(t, modelDetails)
+	assert.Equal(t, 0.01, modelDetails.EstimatedCostUSD)
+	assert.Equal(t, 1, modelDetails.Calls)
+}
+
+func TestGenerate_ModelCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseGitHubExpectations(t, mockGitHubClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// The run is cancelled while the model call is in flight, which returns
+	// once the context is done
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		DoAndReturn(func(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+			cancel()
+			<-ctx.Done()
+			return nil, nil, ctx.Err()
+		})
+
+	generator := NewChangelogGenerator(
+		"2.5.0",
+		"",
+		false,
+		"gemini-2.5-flash",
+		mockModelCaller,
+		mockGitHubClient,
+		WithStageTimeouts(StageTimeouts{Model: time.Minute}),
+	)
+
+	_, _, _, _, err := generator.Generate(ctx)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	// A cancellation is not a timeout of the stage
+	var timeoutErr *types.StageTimeoutError
+	assert.False(t, errors.As(err, &timeoutErr))
+}
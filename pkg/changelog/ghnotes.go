@@ -0,0 +1,155 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+var (
+	// githubNoteRegex matches a PR line of the GitHub auto-generated release
+	// notes: "* Title by @author in https://github.com/owner/repo/pull/123"
+	githubNoteRegex = regexp.MustCompile(`^[*-]\s+(.*?)\s+by\s+@(\S+)\s+in\s+https://github\.com/[^/\s]+/[^/\s]+/pull/(\d+)\s*$`)
+	// cherryPickTitleRegex matches the PRs cherry-picked by a PR from its
+	// title, e.g. "Automated cherry pick of #7200 #7210: Fix ..."
+	cherryPickTitleRegex = regexp.MustCompile(`(?i)cherry[- ]pick of ((?:#\d+[\s,;]*)+)`)
+)
+
+// githubNote is a PR of the GitHub auto-generated release notes
+type githubNote struct {
+	number int
+	title  string
+	author string
+	// cherryPickOf are the original PRs of a cherry-pick PR, which the
+	// CHANGELOG links instead
+	cherryPickOf []int
+}
+
+// parseGitHubNotes returns the PRs listed in the body of the GitHub
+// auto-generated release notes
+func parseGitHubNotes(body string) []githubNote {
+	var notes []githubNote
+	for _, line := range strings.Split(body, "\n") {
+		m := githubNoteRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		number, _ := strconv.Atoi(m[3])
+		note := githubNote{number: number, title: m[1], author: m[2]}
+		if cp := cherryPickTitleRegex.FindStringSubmatch(note.title); cp != nil {
+			for _, ref := range cherryPickRegex.FindAllStringSubmatch(cp[1], -1) {
+				original, _ := strconv.Atoi(ref[1])
+				note.cherryPickOf = append(note.cherryPickOf, original)
+			}
+		}
+		notes = append(notes, note)
+	}
+	return notes
+}
+
+// CompareReleaseNotes compares the PRs linked by the CHANGELOG section of a
+// release with the PRs of the release notes GitHub generated for its tag, to
+// catch the PRs one of them missed. A cherry-pick PR of the GitHub notes
+// matches the entries linking the PRs it cherry-picks.
+func CompareReleaseNotes(release, section, githubNotes string) *types.NotesComparison {
+	comparison := &types.NotesComparison{Release: release}
+
+	// PR numbers linked by the CHANGELOG, with the first entry linking them
+	entries := make(map[int]string)
+	for _, line := range strings.Split(section, "\n") {
+		if !strings.HasPrefix(line, "- ") {
+			continue
+		}
+		text := strings.TrimPrefix(line, "- ")
+		if i := strings.Index(text, " ([#"); i >= 0 {
+			text = text[:i]
+		}
+		text = entryAnchorRegex.ReplaceAllString(text, "")
+		for _, m := range entryPRRegex.FindAllStringSubmatch(line, -1) {
+			number, _ := strconv.Atoi(m[1])
+			if _, ok := entries[number]; !ok {
+				entries[number] = text
+			}
+		}
+	}
+
+	inGitHub := make(map[int]bool)
+	for _, note := range parseGitHubNotes(githubNotes) {
+		numbers := append([]int{note.number}, note.cherryPickOf...)
+		covered := false
+		for _, number := range numbers {
+			inGitHub[number] = true
+			if _, ok := entries[number]; ok {
+				covered = true
+			}
+		}
+		if covered {
+			comparison.Common++
+			continue
+		}
+		comparison.OnlyInGitHub = append(comparison.OnlyInGitHub, types.NotesPR{Number: note.number, Text: note.title, Author: note.author})
+	}
+	for number, text := range entries {
+		if !inGitHub[number] {
+			comparison.OnlyInChangelog = append(comparison.OnlyInChangelog, types.NotesPR{Number: number, Text: text})
+		}
+	}
+	sort.Slice(comparison.OnlyInGitHub, func(i, j int) bool {
+		return comparison.OnlyInGitHub[i].Number < comparison.OnlyInGitHub[j].Number
+	})
+	sort.Slice(comparison.OnlyInChangelog, func(i, j int) bool {
+		return comparison.OnlyInChangelog[i].Number < comparison.OnlyInChangelog[j].Number
+	})
+	return comparison
+}
+
+// FormatNotesComparison renders the comparison with the GitHub auto-generated
+// release notes as a markdown report for the release manager
+func FormatNotesComparison(comparison *types.NotesComparison) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# GitHub release notes coverage of the %s CHANGELOG\n\n", comparison.Release))
+	sb.WriteString(fmt.Sprintf("%d PRs are both in the CHANGELOG and in the release notes generated by GitHub for `v%s`.\n\n", comparison.Common, comparison.Release))
+	if len(comparison.OnlyInGitHub) == 0 && len(comparison.OnlyInChangelog) == 0 {
+		sb.WriteString("No discrepancies were found.\n")
+		return sb.String()
+	}
+
+	writePRs := func(title, explanation string, prs []types.NotesPR) {
+		if len(prs) == 0 {
+			return
+		}
+		sb.WriteString(fmt.Sprintf("## %s\n\n%s\n\n", title, explanation))
+		sb.WriteString("| PR | Title | Author |\n")
+		sb.WriteString("|----|-------|--------|\n")
+		for _, pr := range prs {
+			sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s |\n",
+				pr.Number, repoOwner, repoName, pr.Number, escapeTableCell(pr.Text), escapeTableCell(pr.Author)))
+		}
+		sb.WriteString("\n")
+	}
+	writePRs("Only in the CHANGELOG",
+		"These PRs are linked by the CHANGELOG, but are not in the GitHub release notes, e.g. because of a wrong PR number or a PR missing from the tag.",
+		comparison.OnlyInChangelog)
+	writePRs("Only in the GitHub release notes",
+		"These PRs are in the GitHub release notes, but no CHANGELOG entry links them. Most are expected (e.g. PRs without the action/release-note label, or bot PRs), check that no user-facing change is missing.",
+		comparison.OnlyInGitHub)
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const testGitHubNotes = `## What's Changed
+* Automated cherry pick of #7200: Fix Egress IP leak by @alice in https://github.com/antrea-io/antrea/pull/7300
+* Fix agent crash on startup by @bob in https://github.com/antrea-io/antrea/pull/7301
+* Bump golang.org/x/net from 0.37.0 to 0.38.0 by @dependabot[bot] in https://github.com/antrea-io/antrea/pull/7302
+
+## New Contributors
+* @bob made their first contribution in https://github.com/antrea-io/antrea/pull/7301
+
+**Full Changelog**: https://github.com/antrea-io/antrea/compare/v2.4.0...v2.4.1
+`
+
+func TestCompareReleaseNotes(t *testing.T) {
+	section := `## 2.4.1 - 2025-02-01
+
+### Fixed
+
+- <a id="pr-7200"></a>Fix Egress IP leak. ([#7200](https://github.com/antrea-io/antrea/pull/7200), [@alice])
+- Fix antctl output. ([#7299](https://github.com/antrea-io/antrea/pull/7299), [@carol])
+
+[@alice]: https://github.com/alice
+[@carol]: https://github.com/carol
+`
+	comparison := CompareReleaseNotes("2.4.1", section, testGitHubNotes)
+	assert.Equal(t, 1, comparison.Common, "the cherry-pick matches the entry of the original PR")
+	assert.Equal(t, []types.NotesPR{
+		{Number: 7301, Text: "Fix agent crash on startup", Author: "bob"},
+		{Number: 7302, Text: "Bump golang.org/x/net from 0.37.0 to 0.38.0", Author: "dependabot[bot]"},
+	}, comparison.OnlyInGitHub)
+	assert.Equal(t, []types.NotesPR{{Number: 7299, Text: "Fix antctl output."}}, comparison.OnlyInChangelog)
+
+	report := FormatNotesComparison(comparison)
+	assert.Contains(t, report, "# GitHub release notes coverage of the 2.4.1 CHANGELOG\n")
+	assert.Contains(t, report, "## Only in the CHANGELOG\n")
+	assert.Contains(t, report, "| [#7299](https://github.com/antrea-io/antrea/pull/7299) | Fix antctl output. |  |\n")
+	assert.Contains(t, report, "| [#7301](https://github.com/antrea-io/antrea/pull/7301) | Fix agent crash on startup | bob |\n")
+}
+
+func TestFormatNotesComparison_NoDiscrepancies(t *testing.T) {
+	report := FormatNotesComparison(&types.NotesComparison{Release: "2.5.0", Common: 3})
+	assert.Contains(t, report, "No discrepancies were found.")
+	assert.NotContains(t, report, "## Only")
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	gogithub "github.com/google/go-github/v76/github"
+)
+
+// CachingClient is a RealClient caching the responses of the read requests
+// whose result does not change during a run (pull requests, tags, historical
+// CHANGELOGs, ...), so that the releases of a release train share them.
+// Listing merged pull requests, which depends on the release branch, and
+// reading the files of a branch before committing to it are not cached.
+type CachingClient struct {
+	*RealClient
+
+	mu      sync.Mutex
+	entries map[string]any
+}
+
+// NewCachingClient wraps a client with a cache
+func NewCachingClient(client *RealClient) *CachingClient {
+	return &CachingClient{RealClient: client, entries: make(map[string]any)}
+}
+
+// cached returns the cached response for the key, or fetches and caches it.
+// Errors are not cached.
+func cached[T any](c *CachingClient, key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	v, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		return v.(T), nil
+	}
+	result, err := fetch()
+	if err != nil {
+		return result, err
+	}
+	c.mu.Lock()
+	c.entries[key] = result
+	c.mu.Unlock()
+	return result, nil
+}
+
+// GetDirectoryContents lists contents of a directory in a repository
+func (c *CachingClient) GetDirectoryContents(ctx context.Context, owner, repo, path string) ([]*gogithub.RepositoryContent, error) {
+	contents, err := cached(c, fmt.Sprintf("dir/%s/%s/%s", owner, repo, path), func() ([]*gogithub.RepositoryContent, error) {
+		return c.RealClient.GetDirectoryContents(ctx, owner, repo, path)
+	})
+	return slices.Clone(contents), err
+}
+
+// GetFileContent gets the content of a file from a repository
+func (c *CachingClient) GetFileContent(ctx context.Context, owner, repo, path string) (string, error) {
+	return cached(c, fmt.Sprintf("file/%s/%s/%s", owner, repo, path), func() (string, error) {
+		return c.RealClient.GetFileContent(ctx, owner, repo, path)
+	})
+}
+
+// GetTagRef gets a Git reference for a tag
+func (c *CachingClient) GetTagRef(ctx context.Context, owner, repo, tag string) (*gogithub.Reference, error) {
+	return cached(c, fmt.Sprintf("tag/%s/%s/%s", owner, repo, tag), func() (*gogithub.Reference, error) {
+		return c.RealClient.GetTagRef(ctx, owner, repo, tag)
+	})
+}
+
+// GetCommit gets a Git commit
+func (c *CachingClient) GetCommit(ctx context.Context, owner, repo, sha string) (*gogithub.Commit, error) {
+	return cached(c, fmt.Sprintf("commit/%s/%s/%s", owner, repo, sha), func() (*gogithub.Commit, error) {
+		return c.RealClient.GetCommit(ctx, owner, repo, sha)
+	})
+}
+
+// GetPullRequest gets a single pull request
+func (c *CachingClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*gogithub.PullRequest, error) {
+	return cached(c, pullRequestKey(owner, repo, number), func() (*gogithub.PullRequest, error) {
+		return c.RealClient.GetPullRequest(ctx, owner, repo, number)
+	})
+}
+
+// GetPullRequests gets multiple pull requests, only fetching the ones which
+// are not cached
+func (c *CachingClient) GetPullRequests(ctx context.Context, owner, repo string, numbers []int) (map[int]*gogithub.PullRequest, error) {
+	result := make(map[int]*gogithub.PullRequest, len(numbers))
+	var missing []int
+	c.mu.Lock()
+	for _, number := range numbers {
+		if pr, ok := c.entries[pullRequestKey(owner, repo, number)]; ok {
+			result[number] = pr.(*gogithub.PullRequest)
+		} else {
+			missing = append(missing, number)
+		}
+	}
+	c.mu.Unlock()
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, err := c.RealClient.GetPullRequests(ctx, owner, repo, missing)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for number, pr := range fetched {
+		c.entries[pullRequestKey(owner, repo, number)] = pr
+		result[number] = pr
+	}
+	return result, nil
+}
+
+// GetIssue gets a single issue (or pull request)
+func (c *CachingClient) GetIssue(ctx context.Context, owner, repo string, number int) (*gogithub.Issue, error) {
+	return cached(c, fmt.Sprintf("issue/%s/%s/%d", owner, repo, number), func() (*gogithub.Issue, error) {
+		return c.RealClient.GetIssue(ctx, owner, repo, number)
+	})
+}
+
+// CompareCommits lists all commits reachable from head but not from base
+func (c *CachingClient) CompareCommits(ctx context.Context, owner, repo, base, head string) ([]*gogithub.RepositoryCommit, error) {
+	commits, err := cached(c, fmt.Sprintf("compare/%s/%s/%s...%s", owner, repo, base, head), func() ([]*gogithub.RepositoryCommit, error) {
+		return c.RealClient.CompareCommits(ctx, owner, repo, base, head)
+	})
+	return slices.Clone(commits), err
+}
+
+// ListPullRequestFiles lists the paths of all files changed by a pull request
+func (c *CachingClient) ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]string, error) {
+	files, err := cached(c, fmt.Sprintf("files/%s/%s/%d", owner, repo, number), func() ([]string, error) {
+		return c.RealClient.ListPullRequestFiles(ctx, owner, repo, number)
+	})
+	return slices.Clone(files), err
+}
+
+// ListTags lists the names of all tags of a repository
+func (c *CachingClient) ListTags(ctx context.Context, owner, repo string) ([]string, error) {
+	tags, err := cached(c, fmt.Sprintf("tags/%s/%s", owner, repo), func() ([]string, error) {
+		return c.RealClient.ListTags(ctx, owner, repo)
+	})
+	return slices.Clone(tags), err
+}
+
+// ListLabels lists all labels of a repository
+func (c *CachingClient) ListLabels(ctx context.Context, owner, repo string) ([]*gogithub.Label, error) {
+	labels, err := cached(c, fmt.Sprintf("labels/%s/%s", owner, repo), func() ([]*gogithub.Label, error) {
+		return c.RealClient.ListLabels(ctx, owner, repo)
+	})
+	return slices.Clone(labels), err
+}
+
+func pullRequestKey(owner, repo string, number int) string {
+	return fmt.Sprintf("pull/%s/%s/%d", owner, repo, number)
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingClient(t *testing.T) {
+	fake, realClient := newFakeGitHubClient(t)
+	client := NewCachingClient(realClient)
+	ctx := context.Background()
+	fake.AddFile("", "CHANGELOG/CHANGELOG-2.4.md", "# Changelog 2.4\n")
+	fake.AddFile("main", "go.mod", "module antrea.io/antrea\n")
+	fake.AddTag("v2.4.0", &gogithub.Commit{SHA: gogithub.Ptr("sha0")})
+	fake.AddPullRequest(&gogithub.PullRequest{Number: gogithub.Ptr(7)}, "a.go")
+
+	for i := 0; i < 2; i++ {
+		content, err := client.GetFileContent(ctx, "antrea-io", "antrea", "CHANGELOG/CHANGELOG-2.4.md")
+		require.NoError(t, err)
+		assert.Equal(t, "# Changelog 2.4\n", content)
+
+		tags, err := client.ListTags(ctx, "antrea-io", "antrea")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"v2.4.0"}, tags)
+
+		pr, err := client.GetPullRequest(ctx, "antrea-io", "antrea", 7)
+		require.NoError(t, err)
+		assert.Equal(t, 7, pr.GetNumber())
+
+		files, err := client.ListPullRequestFiles(ctx, "antrea-io", "antrea", 7)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a.go"}, files)
+	}
+	assert.Len(t, fake.Requests(), 4, "responses should be cached")
+
+	// Files are read again before committing to a branch
+	for i := 0; i < 2; i++ {
+		_, _, err := client.GetFileAtRef(ctx, "antrea-io", "antrea", "go.mod", "main")
+		require.NoError(t, err)
+	}
+	assert.Len(t, fake.Requests(), 6)
+
+	// Errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := client.GetPullRequest(ctx, "antrea-io", "antrea", 8)
+		assert.Error(t, err)
+	}
+	assert.Len(t, fake.Requests(), 8)
+}
@@ -17,6 +17,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
 	gogithub "github.com/google/go-github/v76/github"
 	"golang.org/x/oauth2"
@@ -25,27 +28,79 @@ import (
 // RealClient wraps the go-github client and implements the GitHubClient interface
 type RealClient struct {
 	client *gogithub.Client
+	// anonymous is true without a token, as GraphQL queries are then rejected
+	anonymous bool
 }
 
-// NewClient creates a new GitHub client
-func NewClient(ctx context.Context, token string) *RealClient {
-	var client *gogithub.Client
-	if token != "" {
-		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-		tc := oauth2.NewClient(ctx, ts)
-		client = gogithub.NewClient(tc)
-	} else {
-		client = gogithub.NewClient(nil)
+// ClientOption configures optional behavior of a RealClient
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	wrapTransport func(http.RoundTripper) http.RoundTripper
+	baseURL       *url.URL
+	politeness    *Politeness
+}
+
+// WithTransportWrapper wraps the HTTP transport used for all GitHub requests
+// (e.g. to trace them)
+func WithTransportWrapper(wrap func(http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(o *clientOptions) {
+		o.wrapTransport = wrap
 	}
+}
 
-	return &RealClient{client: client}
+// WithBaseURL sends all GitHub requests to another API endpoint (e.g. a
+// GitHub Enterprise server or a fake server in tests)
+func WithBaseURL(baseURL *url.URL) ClientOption {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// NewClient creates a new GitHub client. The token may be a comma-separated
+// list of tokens, which are rotated when the rate limit of the current one is
+// exhausted.
+func NewClient(ctx context.Context, token string, opts ...ClientOption) *RealClient {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	httpClient := &http.Client{}
+	tokens := splitTokens(token)
+	if len(tokens) > 1 {
+		httpClient.Transport = newTokenPoolTransport(nil, tokens)
+	} else if len(tokens) == 1 {
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: tokens[0]})
+		httpClient = oauth2.NewClient(ctx, ts)
+	}
+	if o.politeness != nil {
+		httpClient.Transport = newPoliteTransport(httpClient.Transport, *o.politeness)
+	}
+	if o.wrapTransport != nil {
+		httpClient.Transport = o.wrapTransport(httpClient.Transport)
+	}
+
+	client := gogithub.NewClient(httpClient)
+	// go-github rejects the requests once a response reports that the rate
+	// limit is exhausted, before they reach the token pool which would send
+	// them with the next token
+	client.DisableRateLimitCheck = len(tokens) > 1
+	if o.baseURL != nil {
+		baseURL := *o.baseURL
+		if !strings.HasSuffix(baseURL.Path, "/") {
+			baseURL.Path += "/"
+		}
+		client.BaseURL = &baseURL
+	}
+	return &RealClient{client: client, anonymous: len(tokens) == 0}
 }
 
 // GetDirectoryContents lists contents of a directory in a repository
 func (c *RealClient) GetDirectoryContents(ctx context.Context, owner, repo, path string) ([]*gogithub.RepositoryContent, error) {
 	_, dirContent, _, err := c.client.Repositories.GetContents(ctx, owner, repo, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get directory contents: %w", err)
+		return nil, fmt.Errorf("failed to get directory contents: %w", classifyError(err))
 	}
 	return dirContent, nil
 }
@@ -54,7 +109,7 @@ func (c *RealClient) GetDirectoryContents(ctx context.Context, owner, repo, path
 func (c *RealClient) GetFileContent(ctx context.Context, owner, repo, path string) (string, error) {
 	fileContent, _, _, err := c.client.Repositories.GetContents(ctx, owner, repo, path, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to get file content: %w", err)
+		return "", fmt.Errorf("failed to get file content: %w", classifyError(err))
 	}
 
 	content, err := fileContent.GetContent()
@@ -69,7 +124,7 @@ func (c *RealClient) GetFileContent(ctx context.Context, owner, repo, path strin
 func (c *RealClient) GetTagRef(ctx context.Context, owner, repo, tag string) (*gogithub.Reference, error) {
 	ref, _, err := c.client.Git.GetRef(ctx, owner, repo, "tags/"+tag)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tag ref: %w", err)
+		return nil, fmt.Errorf("failed to get tag ref: %w", classifyError(err))
 	}
 	return ref, nil
 }
@@ -78,7 +133,7 @@ func (c *RealClient) GetTagRef(ctx context.Context, owner, repo, tag string) (*g
 func (c *RealClient) GetCommit(ctx context.Context, owner, repo, sha string) (*gogithub.Commit, error) {
 	commit, _, err := c.client.Git.GetCommit(ctx, owner, repo, sha)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get commit: %w", err)
+		return nil, fmt.Errorf("failed to get commit: %w", classifyError(err))
 	}
 	return commit, nil
 }
@@ -87,7 +142,7 @@ func (c *RealClient) GetCommit(ctx context.Context, owner, repo, sha string) (*g
 func (c *RealClient) ListPullRequests(ctx context.Context, owner, repo string, opts *gogithub.PullRequestListOptions) ([]*gogithub.PullRequest, *gogithub.Response, error) {
 	pulls, resp, err := c.client.PullRequests.List(ctx, owner, repo, opts)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to list pull requests: %w", err)
+		return nil, nil, fmt.Errorf("failed to list pull requests: %w", classifyError(err))
 	}
 	return pulls, resp, nil
 }
@@ -96,7 +151,156 @@ func (c *RealClient) ListPullRequests(ctx context.Context, owner, repo string, o
 func (c *RealClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*gogithub.PullRequest, error) {
 	pr, _, err := c.client.PullRequests.Get(ctx, owner, repo, number)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pull request: %w", err)
+		return nil, fmt.Errorf("failed to get pull request: %w", classifyError(err))
 	}
 	return pr, nil
 }
+
+// CompareCommits lists all commits reachable from head but not from base, across all pages
+func (c *RealClient) CompareCommits(ctx context.Context, owner, repo, base, head string) ([]*gogithub.RepositoryCommit, error) {
+	var commits []*gogithub.RepositoryCommit
+	opts := &gogithub.ListOptions{PerPage: 250}
+	for {
+		comparison, resp, err := c.client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare commits: %w", classifyError(err))
+		}
+		commits = append(commits, comparison.Commits...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return commits, nil
+}
+
+// ListPullRequestsWithCommit lists the pull requests associated with a commit
+func (c *RealClient) ListPullRequestsWithCommit(ctx context.Context, owner, repo, sha string) ([]*gogithub.PullRequest, error) {
+	pulls, _, err := c.client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests for commit %s: %w", sha, classifyError(err))
+	}
+	return pulls, nil
+}
+
+// ListPullRequestFiles lists the paths of all files changed by a pull request, across all pages
+func (c *RealClient) ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]string, error) {
+	var paths []string
+	opts := &gogithub.ListOptions{PerPage: 100}
+	for {
+		files, resp, err := c.client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files of pull request #%d: %w", number, classifyError(err))
+		}
+		for _, f := range files {
+			paths = append(paths, f.GetFilename())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return paths, nil
+}
+
+// ListOpenIssues lists the open issues with a label, across all pages. Pull
+// requests are not included.
+func (c *RealClient) ListOpenIssues(ctx context.Context, owner, repo, label string) ([]*gogithub.Issue, error) {
+	var issues []*gogithub.Issue
+	opts := &gogithub.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{label},
+		ListOptions: gogithub.ListOptions{PerPage: 100},
+	}
+	for {
+		page, resp, err := c.client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues with label %s: %w", label, classifyError(err))
+		}
+		for _, issue := range page {
+			if !issue.IsPullRequest() {
+				issues = append(issues, issue)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+	return issues, nil
+}
+
+// GetIssue gets a single issue (or pull request)
+func (c *RealClient) GetIssue(ctx context.Context, owner, repo string, number int) (*gogithub.Issue, error) {
+	issue, _, err := c.client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", classifyError(err))
+	}
+	return issue, nil
+}
+
+// ListTags lists the names of all tags of a repository, across all pages
+func (c *RealClient) ListTags(ctx context.Context, owner, repo string) ([]string, error) {
+	var names []string
+	opts := &gogithub.ListOptions{PerPage: 100}
+	for {
+		tags, resp, err := c.client.Repositories.ListTags(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags: %w", classifyError(err))
+		}
+		for _, tag := range tags {
+			names = append(names, tag.GetName())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return names, nil
+}
+
+// ListLabels lists all labels of a repository
+func (c *RealClient) ListLabels(ctx context.Context, owner, repo string) ([]*gogithub.Label, error) {
+	var allLabels []*gogithub.Label
+	opts := &gogithub.ListOptions{PerPage: 100}
+	for {
+		labels, resp, err := c.client.Issues.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", classifyError(err))
+		}
+		allLabels = append(allLabels, labels...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return allLabels, nil
+}
+
+// GetAuthenticatedUser gets the login of the user of the token
+func (c *RealClient) GetAuthenticatedUser(ctx context.Context) (string, error) {
+	user, _, err := c.client.Users.Get(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to get the authenticated user: %w", classifyError(err))
+	}
+	return user.GetLogin(), nil
+}
+
+// ListIssueComments lists the comments of an issue (or pull request), across
+// all pages, oldest first
+func (c *RealClient) ListIssueComments(ctx context.Context, owner, repo string, number int) ([]*gogithub.IssueComment, error) {
+	var comments []*gogithub.IssueComment
+	opts := &gogithub.IssueListCommentsOptions{ListOptions: gogithub.ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := c.client.Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list comments of issue #%d: %w", number, classifyError(err))
+		}
+		comments = append(comments, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+	return comments, nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *RealClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := gogithub.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = baseURL
+	return &RealClient{client: client}
+}
+
+func TestGetPullRequests(t *testing.T) {
+	var queries []graphQLRequest
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/graphql", r.URL.Path)
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		queries = append(queries, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"data": {"repository": {
+				"pr1": {"number": 1, "title": "Add X", "body": "Body", "state": "MERGED", "mergedAt": "2025-01-30T14:30:25Z",
+					"author": {"login": "alice"}, "labels": {"nodes": [{"name": "action/release-note"}]},
+					"mergeCommit": {"oid": "3f2a9c1e"}},
+				"pr2": null
+			}},
+			"errors": [{"type": "NOT_FOUND", "message": "Could not resolve to a PullRequest with the number of 2."}]
+		}`))
+	}))
+
+	prs, err := client.GetPullRequests(context.Background(), "antrea-io", "antrea", []int{1, 2})
+	require.NoError(t, err)
+
+	require.Len(t, queries, 1, "Should send a single batched query")
+	assert.Contains(t, queries[0].Query, "pr1: pullRequest(number: 1)")
+	assert.Contains(t, queries[0].Query, "pr2: pullRequest(number: 2)")
+	assert.Equal(t, map[string]any{"owner": "antrea-io", "name": "antrea"}, queries[0].Variables)
+
+	require.Len(t, prs, 1)
+	pr := prs[1]
+	assert.Equal(t, "Add X", pr.GetTitle())
+	assert.Equal(t, "alice", pr.User.GetLogin())
+	assert.Equal(t, "closed", pr.GetState(), "MERGED should be mapped to the REST state")
+	assert.Equal(t, "action/release-note", pr.Labels[0].GetName())
+	assert.Equal(t, "2025-01-30T14:30:25Z", pr.GetMergedAt().UTC().Format("2006-01-02T15:04:05Z"))
+	assert.Equal(t, "3f2a9c1e", pr.GetMergeCommitSHA())
+}
+
+func TestGetPullRequests_Error(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": null, "errors": [{"type": "RATE_LIMITED", "message": "API rate limit exceeded"}]}`))
+	}))
+
+	_, err := client.GetPullRequests(context.Background(), "antrea-io", "antrea", []int{1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API rate limit exceeded")
+}
+
+func TestGetPullRequests_RESTFallback(t *testing.T) {
+	for name, tc := range map[string]struct {
+		anonymous bool
+		status    int
+	}{
+		"without token":         {anonymous: true},
+		"rejected token":        {status: http.StatusUnauthorized},
+		"GraphQL not available": {status: http.StatusNotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var graphQLQueries int
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/graphql":
+					graphQLQueries++
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(`{"message": "GraphQL rejected"}`))
+				case "/repos/antrea-io/antrea/pulls/1":
+					_, _ = w.Write([]byte(`{"number": 1, "title": "Add X", "state": "closed", "user": {"login": "alice"}}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}
+			}))
+			client.anonymous = tc.anonymous
+
+			prs, err := client.GetPullRequests(context.Background(), "antrea-io", "antrea", []int{1, 2})
+			require.NoError(t, err)
+
+			if tc.anonymous {
+				assert.Zero(t, graphQLQueries, "GraphQL should not be used without a token")
+			} else {
+				assert.Equal(t, 1, graphQLQueries)
+			}
+			require.Len(t, prs, 1, "Missing pull requests should be omitted")
+			assert.Equal(t, "Add X", prs[1].GetTitle())
+			assert.Equal(t, "closed", prs[1].GetState())
+		})
+	}
+}
+
+func TestCreateDiscussion(t *testing.T) {
+	var queries []graphQLRequest
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		queries = append(queries, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(queries) == 1 {
+			_, _ = w.Write([]byte(`{"data": {"repository": {"id": "R_1", "discussionCategories": {"nodes": [
+				{"id": "C_1", "name": "General"}, {"id": "C_2", "name": "Announcements"}]}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"createDiscussion": {"discussion": {"url": "https://github.com/antrea-io/antrea/discussions/1"}}}}`))
+	}))
+
+	url, err := client.CreateDiscussion(context.Background(), "antrea-io", "antrea", "announcements", "Title", "Body")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/antrea-io/antrea/discussions/1", url)
+	require.Len(t, queries, 2)
+	assert.Equal(t, map[string]any{"repositoryId": "R_1", "categoryId": "C_2", "title": "Title", "body": "Body"}, queries[1].Variables)
+
+	_, err = client.CreateDiscussion(context.Background(), "antrea-io", "antrea", "Releases", "Title", "Body")
+	assert.ErrorContains(t, err, `discussion category "Releases" not found`)
+}
+
+func TestListOpenIssues(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/antrea-io/antrea/issues", r.URL.Path)
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		assert.Equal(t, "known-issue/v2.5", r.URL.Query().Get("labels"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"number": 1, "title": "Egress IP is lost on agent restart"},
+			{"number": 2, "title": "Fix Egress IP", "pull_request": {"url": "https://api.github.com/repos/antrea-io/antrea/pulls/2"}}
+		]`))
+	}))
+
+	issues, err := client.ListOpenIssues(context.Background(), "antrea-io", "antrea", "known-issue/v2.5")
+	require.NoError(t, err)
+	require.Len(t, issues, 1, "Pull requests should be excluded")
+	assert.Equal(t, 1, issues[0].GetNumber())
+}
+
+func TestListLabels(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/antrea-io/antrea/labels", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name": "kind/bug", "description": "Categorizes issue or PR as related to a bug."},
+			{"name": "area/ovs", "description": ""}
+		]`))
+	}))
+
+	labels, err := client.ListLabels(context.Background(), "antrea-io", "antrea")
+	require.NoError(t, err)
+	require.Len(t, labels, 2)
+	assert.Equal(t, "kind/bug", labels[0].GetName())
+	assert.Equal(t, "Categorizes issue or PR as related to a bug.", labels[0].GetDescription())
+}
+
+func TestClassifyError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/repos/antrea-io/antrea/git/ref/tags/v9.9.9":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		case "/repos/antrea-io/antrea/git/ref/tags/v5.0.0":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`{"message": "Server Error"}`))
+		default:
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "4102444800")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+		}
+	}))
+
+	_, err := client.GetTagRef(context.Background(), "antrea-io", "antrea", "v9.9.9")
+	var notFoundErr *types.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.False(t, types.IsRetryable(err))
+
+	_, err = client.GetTagRef(context.Background(), "antrea-io", "antrea", "v5.0.0")
+	var githubErr *types.GitHubError
+	require.ErrorAs(t, err, &githubErr)
+	assert.False(t, types.IsRetryable(err))
+
+	_, err = client.ListTags(context.Background(), "antrea-io", "antrea")
+	var rateLimitedErr *types.RateLimitedError
+	require.ErrorAs(t, err, &rateLimitedErr)
+	assert.Equal(t, "github", rateLimitedErr.Service)
+	assert.Positive(t, rateLimitedErr.RetryAfter)
+	assert.True(t, types.IsRetryable(err))
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CreateDiscussion creates a discussion in the category with the given name
+// (e.g. Announcements) and returns its URL
+func (c *RealClient) CreateDiscussion(ctx context.Context, owner, repo, category, title, body string) (string, error) {
+	var repoData struct {
+		Repository struct {
+			ID                   string `json:"id"`
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"discussionCategories"`
+		} `json:"repository"`
+	}
+	query := `query($owner: String!, $name: String!) { repository(owner: $owner, name: $name) { id discussionCategories(first: 100) { nodes { id name } } } }`
+	if err := c.graphQL(ctx, query, map[string]any{"owner": owner, "name": repo}, &repoData); err != nil {
+		return "", fmt.Errorf("failed to get discussion categories: %w", err)
+	}
+	categoryID := ""
+	for _, n := range repoData.Repository.DiscussionCategories.Nodes {
+		if strings.EqualFold(n.Name, category) {
+			categoryID = n.ID
+			break
+		}
+	}
+	if categoryID == "" {
+		return "", fmt.Errorf("discussion category %q not found in %s/%s", category, owner, repo)
+	}
+
+	var data struct {
+		CreateDiscussion struct {
+			Discussion struct {
+				URL string `json:"url"`
+			} `json:"discussion"`
+		} `json:"createDiscussion"`
+	}
+	mutation := `mutation($repositoryId: ID!, $categoryId: ID!, $title: String!, $body: String!) {
+		createDiscussion(input: {repositoryId: $repositoryId, categoryId: $categoryId, title: $title, body: $body}) { discussion { url } } }`
+	variables := map[string]any{
+		"repositoryId": repoData.Repository.ID,
+		"categoryId":   categoryID,
+		"title":        title,
+		"body":         body,
+	}
+	if err := c.graphQL(ctx, mutation, variables, &data); err != nil {
+		return "", fmt.Errorf("failed to create discussion: %w", err)
+	}
+	return data.CreateDiscussion.Discussion.URL, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// classifyError wraps GitHub API errors into the typed errors of the types
+// package: RateLimitedError or NotFoundError when possible, GitHubError
+// otherwise
+func classifyError(err error) error {
+	var rateLimitErr *gogithub.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return &types.RateLimitedError{Service: "github", RetryAfter: time.Until(rateLimitErr.Rate.Reset.Time), Err: err}
+	}
+	var abuseErr *gogithub.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return &types.RateLimitedError{Service: "github", RetryAfter: abuseErr.GetRetryAfter(), Err: err}
+	}
+	var respErr *gogithub.ErrorResponse
+	if errors.As(err, &respErr) && respErr.Response != nil && respErr.Response.StatusCode == http.StatusNotFound {
+		return &types.NotFoundError{Err: err}
+	}
+	return &types.GitHubError{Err: err}
+}
+
+// graphQLUnavailable returns true if a GraphQL query was rejected with a 401
+// (e.g. without a token) or a 404 (e.g. a GitHub Enterprise server without the
+// GraphQL API), so that the REST API should be used instead
+func graphQLUnavailable(err error) bool {
+	var respErr *gogithub.ErrorResponse
+	if !errors.As(err, &respErr) || respErr.Response == nil {
+		return false
+	}
+	return respErr.Response.StatusCode == http.StatusUnauthorized || respErr.Response.StatusCode == http.StatusNotFound
+}
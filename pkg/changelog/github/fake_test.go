@@ -0,0 +1,116 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/testsupport"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func newFakeGitHubClient(t *testing.T) (*testsupport.FakeGitHub, *RealClient) {
+	fake := testsupport.NewFakeGitHub(t, "antrea-io", "antrea")
+	fake.MaxPerPage = 2
+	return fake, NewClient(context.Background(), "", WithBaseURL(fake.URL()))
+}
+
+func TestRealClient_Pagination(t *testing.T) {
+	fake, client := newFakeGitHubClient(t)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		fake.AddTag(fmt.Sprintf("v2.%d.0", i), &gogithub.Commit{SHA: gogithub.Ptr(fmt.Sprintf("sha%d", i))})
+	}
+	fake.AddPullRequest(&gogithub.PullRequest{Number: gogithub.Ptr(7)}, "a.go", "b.go", "c.go")
+
+	tags, err := client.ListTags(ctx, "antrea-io", "antrea")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.0.0", "v2.1.0", "v2.2.0", "v2.3.0", "v2.4.0"}, tags)
+	assert.Len(t, fake.Requests(), 3, "tags should be listed in 3 pages")
+
+	files, err := client.ListPullRequestFiles(ctx, "antrea-io", "antrea", 7)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.go", "b.go", "c.go"}, files)
+
+	_, err = client.ListPullRequestFiles(ctx, "antrea-io", "antrea", 8)
+	var notFoundErr *types.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestRealClient_ListPullRequests(t *testing.T) {
+	fake, client := newFakeGitHubClient(t)
+	now := time.Now()
+	for i := 1; i <= 3; i++ {
+		fake.AddPullRequest(&gogithub.PullRequest{
+			Number:   gogithub.Ptr(i),
+			State:    gogithub.Ptr("closed"),
+			Base:     &gogithub.PullRequestBranch{Ref: gogithub.Ptr("main")},
+			MergedAt: &gogithub.Timestamp{Time: now.Add(time.Duration(i) * time.Hour)},
+		})
+	}
+	fake.AddPullRequest(&gogithub.PullRequest{
+		Number: gogithub.Ptr(4),
+		State:  gogithub.Ptr("closed"),
+		Base:   &gogithub.PullRequestBranch{Ref: gogithub.Ptr("release-2.4")},
+	})
+
+	opts := &gogithub.PullRequestListOptions{State: "closed", Base: "main", Sort: "updated", Direction: "desc", ListOptions: gogithub.ListOptions{PerPage: 100}}
+	pulls, resp, err := client.ListPullRequests(context.Background(), "antrea-io", "antrea", opts)
+	require.NoError(t, err)
+	require.Len(t, pulls, 2)
+	assert.Equal(t, []int{3, 2}, []int{pulls[0].GetNumber(), pulls[1].GetNumber()})
+	require.Equal(t, 2, resp.NextPage)
+
+	opts.Page = resp.NextPage
+	pulls, resp, err = client.ListPullRequests(context.Background(), "antrea-io", "antrea", opts)
+	require.NoError(t, err)
+	require.Len(t, pulls, 1)
+	assert.Equal(t, 1, pulls[0].GetNumber())
+	assert.Equal(t, 0, resp.NextPage)
+}
+
+func TestRealClient_Contents(t *testing.T) {
+	fake, client := newFakeGitHubClient(t)
+	ctx := context.Background()
+	fake.AddFile("", "CHANGELOG/CHANGELOG-2.4.md", "# Changelog 2.4\n")
+	fake.AddFile("", "CHANGELOG/README.md", "CHANGELOG files\n")
+	fake.AddFile("v2.4.0", "go.mod", "module antrea.io/antrea\n")
+
+	entries, err := client.GetDirectoryContents(ctx, "antrea-io", "antrea", "CHANGELOG")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "CHANGELOG-2.4.md", entries[0].GetName())
+
+	content, err := client.GetFileContent(ctx, "antrea-io", "antrea", "CHANGELOG/CHANGELOG-2.4.md")
+	require.NoError(t, err)
+	assert.Equal(t, "# Changelog 2.4\n", content)
+
+	content, sha, err := client.GetFileAtRef(ctx, "antrea-io", "antrea", "go.mod", "v2.4.0")
+	require.NoError(t, err)
+	assert.Equal(t, "module antrea.io/antrea\n", content)
+	assert.NotEmpty(t, sha)
+
+	// Missing files are reported with an empty SHA
+	_, sha, err = client.GetFileAtRef(ctx, "antrea-io", "antrea", "go.mod", "main")
+	require.NoError(t, err)
+	assert.Empty(t, sha)
+}
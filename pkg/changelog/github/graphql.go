@@ -0,0 +1,183 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// pullRequestBatchSize is the maximum number of pull requests fetched per GraphQL query
+const pullRequestBatchSize = 50
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphQLError  `json:"errors"`
+}
+
+type graphQLPullRequest struct {
+	Number   int        `json:"number"`
+	Title    string     `json:"title"`
+	Body     string     `json:"body"`
+	State    string     `json:"state"`
+	MergedAt *time.Time `json:"mergedAt"`
+	Author   *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	MergeCommit *struct {
+		OID string `json:"oid"`
+	} `json:"mergeCommit"`
+}
+
+const pullRequestFields = `number title body state mergedAt author { login } labels(first: 100) { nodes { name } } mergeCommit { oid }`
+
+// graphQL sends a GraphQL query and decodes its data into v. Errors of type
+// NOT_FOUND are ignored, other errors are returned.
+func (c *RealClient) graphQL(ctx context.Context, query string, variables map[string]any, v any) error {
+	req, err := c.client.NewRequest("POST", "graphql", &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to create GraphQL request: %w", err)
+	}
+	var resp graphQLResponse
+	if _, err := c.client.Do(ctx, req, &resp); err != nil {
+		return fmt.Errorf("GraphQL request failed: %w", classifyError(err))
+	}
+	for _, e := range resp.Errors {
+		switch e.Type {
+		case "NOT_FOUND":
+			// Missing pull requests are omitted from the result
+		case "RATE_LIMITED":
+			return &types.RateLimitedError{Service: "github", Err: fmt.Errorf("GraphQL error: %s", e.Message)}
+		default:
+			return &types.GitHubError{Err: fmt.Errorf("GraphQL error: %s", e.Message)}
+		}
+	}
+	if err := json.Unmarshal(resp.Data, v); err != nil {
+		return fmt.Errorf("failed to decode GraphQL response: %w", err)
+	}
+	return nil
+}
+
+// GetPullRequests gets multiple pull requests using batched GraphQL queries.
+// As GraphQL requires a token, the pull requests are fetched one by one with
+// the REST API without a token, if the token is rejected, or if the server has
+// no GraphQL API.
+func (c *RealClient) GetPullRequests(ctx context.Context, owner, repo string, numbers []int) (map[int]*gogithub.PullRequest, error) {
+	if c.anonymous {
+		return c.getPullRequestsREST(ctx, owner, repo, numbers, make(map[int]*gogithub.PullRequest, len(numbers)))
+	}
+	result := make(map[int]*gogithub.PullRequest, len(numbers))
+	for start := 0; start < len(numbers); start += pullRequestBatchSize {
+		batch := numbers[start:min(start+pullRequestBatchSize, len(numbers))]
+
+		var sb strings.Builder
+		sb.WriteString("query($owner: String!, $name: String!) { repository(owner: $owner, name: $name) {")
+		for _, number := range batch {
+			sb.WriteString(fmt.Sprintf(" pr%d: pullRequest(number: %d) { %s }", number, number, pullRequestFields))
+		}
+		sb.WriteString(" } }")
+
+		var data struct {
+			Repository map[string]*graphQLPullRequest `json:"repository"`
+		}
+		if err := c.graphQL(ctx, sb.String(), map[string]any{"owner": owner, "name": repo}, &data); err != nil {
+			if graphQLUnavailable(err) {
+				log.Printf("Warning: GraphQL query rejected, fetching the remaining %d pull requests one by one: %v", len(numbers)-start, err)
+				return c.getPullRequestsREST(ctx, owner, repo, numbers[start:], result)
+			}
+			return nil, fmt.Errorf("failed to get pull requests: %w", err)
+		}
+		for _, pr := range data.Repository {
+			if pr == nil {
+				continue
+			}
+			result[pr.Number] = pr.toPullRequest()
+		}
+	}
+	return result, nil
+}
+
+// getPullRequestsREST gets pull requests one by one with the REST API, adding
+// them to result. Like with GraphQL, missing pull requests are omitted, and so
+// are the ones which cannot be fetched, with a warning.
+func (c *RealClient) getPullRequestsREST(ctx context.Context, owner, repo string, numbers []int, result map[int]*gogithub.PullRequest) (map[int]*gogithub.PullRequest, error) {
+	for _, number := range numbers {
+		pr, err := c.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			var notFoundErr *types.NotFoundError
+			if !errors.As(err, &notFoundErr) {
+				log.Printf("Warning: failed to get pull request #%d: %v", number, err)
+			}
+			continue
+		}
+		result[number] = pr
+	}
+	return result, nil
+}
+
+func (pr *graphQLPullRequest) toPullRequest() *gogithub.PullRequest {
+	// GraphQL has a MERGED state, which is a closed pull request for REST
+	state := strings.ToLower(pr.State)
+	if state == "merged" {
+		state = "closed"
+	}
+	out := &gogithub.PullRequest{
+		Number: gogithub.Ptr(pr.Number),
+		Title:  gogithub.Ptr(pr.Title),
+		Body:   gogithub.Ptr(pr.Body),
+		State:  gogithub.Ptr(state),
+		User:   &gogithub.User{},
+	}
+	if pr.Author != nil {
+		out.User.Login = gogithub.Ptr(pr.Author.Login)
+	}
+	if pr.MergedAt != nil {
+		out.MergedAt = &gogithub.Timestamp{Time: *pr.MergedAt}
+	}
+	if pr.MergeCommit != nil {
+		out.MergeCommitSHA = gogithub.Ptr(pr.MergeCommit.OID)
+	}
+	for _, l := range pr.Labels.Nodes {
+		out.Labels = append(out.Labels, &gogithub.Label{Name: gogithub.Ptr(l.Name)})
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Politeness limits the load put on a GitHub token shared with other
+// automation. Requests are spaced out, and pause when the rate limit of the
+// token runs low, so that the other clients keep some of it.
+type Politeness struct {
+	// MinInterval is the minimum time between the starts of two requests
+	MinInterval time.Duration
+	// MaxConcurrent is the maximum number of requests in flight (0 for no limit)
+	MaxConcurrent int
+	// Reserve is the fraction of the rate limit left to other clients
+	Reserve float64
+}
+
+// WithPoliteness limits the rate and concurrency of the GitHub requests
+func WithPoliteness(p Politeness) ClientOption {
+	return func(o *clientOptions) {
+		o.politeness = &p
+	}
+}
+
+// politeTransport enforces a Politeness
+type politeTransport struct {
+	base  http.RoundTripper
+	p     Politeness
+	slots chan struct{}
+
+	mutex sync.Mutex
+	// next is the earliest start of the next request
+	next time.Time
+	// now and sleep are replaced in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newPoliteTransport(base http.RoundTripper, p Politeness) *politeTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &politeTransport{base: base, p: p, now: time.Now, sleep: sleepContext}
+	if p.MaxConcurrent > 0 {
+		t.slots = make(chan struct{}, p.MaxConcurrent)
+	}
+	return t
+}
+
+// RoundTrip waits for a free slot and for the request's turn before sending it
+func (t *politeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	t.mutex.Lock()
+	now := t.now()
+	start := now
+	if t.next.After(start) {
+		start = t.next
+	}
+	t.next = start.Add(t.p.MinInterval)
+	t.mutex.Unlock()
+	if wait := start.Sub(now); wait > 0 {
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	t.checkReserve(resp)
+	return resp, nil
+}
+
+// checkReserve delays the next requests until the rate limit resets when the
+// remaining requests of the token drop below the reserve
+func (t *politeTransport) checkReserve(resp *http.Response) {
+	limit, err1 := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	remaining, err2 := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, err3 := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || float64(remaining) >= t.p.Reserve*float64(limit) {
+		return
+	}
+	resetTime := time.Unix(reset, 0)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if resetTime.After(t.next) {
+		log.Printf("Polite mode: %d of %d GitHub requests left for the token, pausing until the rate limit resets at %s", remaining, limit, resetTime.Format(time.RFC3339))
+		t.next = resetTime
+	}
+}
+
+// sleepContext sleeps for d, or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoliteTransport(t *testing.T) {
+	reset := time.Unix(5000, 0)
+	var remaining atomic.Int64
+	remaining.Store(100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(remaining.Load(), 10))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newPoliteTransport(nil, Politeness{MinInterval: 2 * time.Second, MaxConcurrent: 1, Reserve: 0.25})
+	now := time.Unix(1000, 0)
+	var sleeps []time.Duration
+	transport.now = func() time.Time { return now }
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		now = now.Add(d)
+		return nil
+	}
+	client := &http.Client{Transport: transport}
+	get := func() {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// Requests are spaced out by the minimum interval
+	get()
+	get()
+	now = now.Add(5 * time.Second)
+	get()
+	assert.Equal(t, []time.Duration{2 * time.Second}, sleeps)
+
+	// Requests pause until the reset once the reserve is reached
+	remaining.Store(10)
+	get()
+	get()
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second, reset.Sub(time.Unix(1009, 0))}, sleeps)
+}
+
+func TestPoliteTransport_Canceled(t *testing.T) {
+	transport := newPoliteTransport(nil, Politeness{MinInterval: time.Hour})
+	transport.next = time.Now().Add(time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gogithub "github.com/google/go-github/v76/github"
+)
+
+// GetBranchSHA gets the SHA of the head commit of a branch
+func (c *RealClient) GetBranchSHA(ctx context.Context, owner, repo, branch string) (string, error) {
+	ref, _, err := c.client.Git.GetRef(ctx, owner, repo, "heads/"+branch)
+	if err != nil {
+		return "", fmt.Errorf("failed to get branch %s: %w", branch, classifyError(err))
+	}
+	return ref.GetObject().GetSHA(), nil
+}
+
+// CreateBranch creates a branch pointing to a commit
+func (c *RealClient) CreateBranch(ctx context.Context, owner, repo, branch, sha string) error {
+	_, _, err := c.client.Git.CreateRef(ctx, owner, repo, gogithub.CreateRef{Ref: "refs/heads/" + branch, SHA: sha})
+	if err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", branch, classifyError(err))
+	}
+	return nil
+}
+
+// GetFileAtRef gets the content and blob SHA of a file at a ref. An empty SHA
+// is returned if the file does not exist.
+func (c *RealClient) GetFileAtRef(ctx context.Context, owner, repo, path, ref string) (string, string, error) {
+	fileContent, _, resp, err := c.client.Repositories.GetContents(ctx, owner, repo, path, &gogithub.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("failed to get file content: %w", classifyError(err))
+	}
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode file content: %w", err)
+	}
+	return content, fileContent.GetSHA(), nil
+}
+
+// CommitFile creates or updates a file on a branch. The sha is the blob SHA
+// of the file being replaced, or empty for a new file.
+func (c *RealClient) CommitFile(ctx context.Context, owner, repo, branch, path, message string, content []byte, sha string) error {
+	opts := &gogithub.RepositoryContentFileOptions{
+		Message: gogithub.Ptr(message),
+		Content: content,
+		Branch:  gogithub.Ptr(branch),
+	}
+	if sha != "" {
+		opts.SHA = gogithub.Ptr(sha)
+	}
+	if _, _, err := c.client.Repositories.CreateFile(ctx, owner, repo, path, opts); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", path, classifyError(err))
+	}
+	return nil
+}
+
+// CreatePullRequest opens a pull request
+func (c *RealClient) CreatePullRequest(ctx context.Context, owner, repo string, pull *gogithub.NewPullRequest) (*gogithub.PullRequest, error) {
+	pr, _, err := c.client.PullRequests.Create(ctx, owner, repo, pull)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", classifyError(err))
+	}
+	return pr, nil
+}
+
+// CreateReviewComment posts a review with a comment body (and no approval or
+// change request) on a pull request
+func (c *RealClient) CreateReviewComment(ctx context.Context, owner, repo string, number int, body string) error {
+	_, _, err := c.client.PullRequests.CreateReview(ctx, owner, repo, number, &gogithub.PullRequestReviewRequest{
+		Body:  gogithub.Ptr(body),
+		Event: gogithub.Ptr("COMMENT"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post review on pull request #%d: %w", number, classifyError(err))
+	}
+	return nil
+}
+
+// RequestReviewers requests reviews on a pull request from users and teams
+func (c *RealClient) RequestReviewers(ctx context.Context, owner, repo string, number int, users, teams []string) error {
+	_, _, err := c.client.PullRequests.RequestReviewers(ctx, owner, repo, number, gogithub.ReviewersRequest{
+		Reviewers:     users,
+		TeamReviewers: teams,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to request reviewers on pull request #%d: %w", number, classifyError(err))
+	}
+	return nil
+}
+
+// CreateIssue opens an issue and returns its URL
+func (c *RealClient) CreateIssue(ctx context.Context, owner, repo, title, body string) (string, error) {
+	issue, _, err := c.client.Issues.Create(ctx, owner, repo, &gogithub.IssueRequest{
+		Title: gogithub.Ptr(title),
+		Body:  gogithub.Ptr(body),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create issue: %w", classifyError(err))
+	}
+	return issue.GetHTMLURL(), nil
+}
+
+// CreateIssueComment posts a comment on an issue
+func (c *RealClient) CreateIssueComment(ctx context.Context, owner, repo string, number int, body string) error {
+	_, _, err := c.client.Issues.CreateComment(ctx, owner, repo, number, &gogithub.IssueComment{
+		Body: gogithub.Ptr(body),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to comment on issue #%d: %w", number, classifyError(err))
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	gogithub "github.com/google/go-github/v76/github"
+)
+
+// GenerateReleaseNotes returns the body of the release notes GitHub
+// generates for a tag. The previous tag and the target (used when the tag does
+// not exist yet) are optional.
+func (c *RealClient) GenerateReleaseNotes(ctx context.Context, owner, repo, tag, previousTag, target string) (string, error) {
+	opts := &gogithub.GenerateNotesOptions{TagName: tag}
+	if previousTag != "" {
+		opts.PreviousTagName = gogithub.Ptr(previousTag)
+	}
+	if target != "" {
+		opts.TargetCommitish = gogithub.Ptr(target)
+	}
+	notes, _, err := c.client.Repositories.GenerateReleaseNotes(ctx, owner, repo, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate release notes: %w", classifyError(err))
+	}
+	return notes.Body, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// splitTokens splits a comma-separated list of tokens, ignoring empty items
+func splitTokens(s string) []string {
+	var tokens []string
+	for _, token := range strings.Split(s, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// tokenPoolTransport authenticates requests with the current token of a
+// pool, and rotates to the next token when the rate limit of the current one
+// is exhausted, so that a run can continue past the hourly limit of a single
+// token. It rotates as soon as a response reports no remaining requests, and a
+// rate-limited request is retried with the next token.
+type tokenPoolTransport struct {
+	base    http.RoundTripper
+	tokens  []string
+	mutex   sync.Mutex
+	current int
+}
+
+func newTokenPoolTransport(base http.RoundTripper, tokens []string) *tokenPoolTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tokenPoolTransport{base: base, tokens: tokens}
+}
+
+// RoundTrip sends the request with the current token, trying each token of
+// the pool at most once
+func (t *tokenPoolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	start := t.current
+	t.mutex.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		i := (start + attempt) % len(t.tokens)
+		authReq := req.Clone(req.Context())
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to replay request body: %w", err)
+			}
+			authReq.Body = body
+		}
+		authReq.Header.Set("Authorization", "Bearer "+t.tokens[i])
+
+		resp, err := t.base.RoundTrip(authReq)
+		if err != nil {
+			return resp, err
+		}
+		if !rateLimitExhausted(resp) {
+			// The last request allowed by the rate limit succeeded, the next
+			// ones are sent with the next token
+			if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+				t.rotate(i)
+			}
+			return resp, nil
+		}
+		// The request cannot be retried if its body cannot be replayed
+		if attempt == len(t.tokens)-1 || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		t.rotate(i)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+}
+
+// rotate makes the token after token i the current one, unless another
+// request already rotated away from token i
+func (t *tokenPoolTransport) rotate(i int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.current != i {
+		return
+	}
+	t.current = (i + 1) % len(t.tokens)
+	log.Printf("Warning: GitHub token %d of %d is rate limited, rotating to token %d", i+1, len(t.tokens), t.current+1)
+}
+
+// rateLimitExhausted returns true if the response reports that the primary
+// rate limit of the token is exhausted. Secondary rate limits are not
+// considered, as they apply to the user rather than to the token.
+func rateLimitExhausted(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return resp.Header.Get("X-RateLimit-Remaining") == "0"
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestSplitTokens(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, splitTokens(" a, ,b "))
+	assert.Nil(t, splitTokens(""))
+}
+
+func TestNewClient_TokenPool(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		seen = append(seen, token)
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), "query")
+		}
+		if token == "exhausted" {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/graphql" {
+			_, _ = w.Write([]byte(`{"data": {"repository": {}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ref": "refs/tags/v2.4.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(context.Background(), "exhausted,valid")
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.client.BaseURL = baseURL
+
+	ref, err := client.GetTagRef(context.Background(), "antrea-io", "antrea", "v2.4.0")
+	require.NoError(t, err)
+	assert.Equal(t, "refs/tags/v2.4.0", ref.GetRef())
+	assert.Equal(t, []string{"exhausted", "valid"}, seen)
+
+	// The next requests use the next token directly, and POST requests are
+	// replayed with their body
+	seen = nil
+	_, err = client.GetPullRequests(context.Background(), "antrea-io", "antrea", []int{1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"valid"}, seen)
+}
+
+func TestNewClient_TokenPoolExhausted(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(context.Background(), "a,b")
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.client.BaseURL = baseURL
+
+	_, err = client.GetTagRef(context.Background(), "antrea-io", "antrea", "v2.4.0")
+	var rateLimitedErr *types.RateLimitedError
+	assert.ErrorAs(t, err, &rateLimitedErr)
+	assert.Equal(t, 2, requests, "each token should be tried once")
+}
+
+func TestNewClient_TokenPoolRemainingZero(t *testing.T) {
+	var seen []string
+	reset := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		seen = append(seen, token)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Reset", reset)
+		if token == "a" {
+			// The last request allowed for the first token succeeds
+			w.Header().Set("X-RateLimit-Remaining", "0")
+		} else {
+			w.Header().Set("X-RateLimit-Remaining", "4999")
+		}
+		_, _ = w.Write([]byte(`{"ref": "refs/tags/v2.4.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(context.Background(), "a,b")
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.client.BaseURL = baseURL
+
+	for range 3 {
+		_, err := client.GetTagRef(context.Background(), "antrea-io", "antrea", "v2.4.0")
+		require.NoError(t, err, "the requests after the rate limit of the first token is exhausted should use the next token")
+	}
+	assert.Equal(t, []string{"a", "b", "b"}, seen)
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package golden compares rendered output with golden files checked in under
+// testdata, so that rendering changes are reviewed as diffs.
+//
+// Run the tests with -golden-update to rewrite the golden files from the
+// current output:
+//
+//	go test ./pkg/changelog/ -run Golden -golden-update
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var update = flag.Bool("golden-update", false, "rewrite the golden files with the current output")
+
+// Updating returns true when the tests were run with -golden-update
+func Updating() bool {
+	return *update
+}
+
+// Assert fails the test when actual differs from the content of the golden
+// file at path, reporting a unified diff. With -golden-update, the golden
+// file is rewritten instead.
+func Assert(t testing.TB, path string, actual string) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Fatalf("failed to write golden file %s: %v", path, err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s (run the tests with -golden-update to create it): %v", path, err)
+	}
+	if string(expected) == actual {
+		return
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(expected)),
+		B:        difflib.SplitLines(actual),
+		FromFile: path,
+		ToFile:   "actual",
+		Context:  3,
+	})
+	if err != nil {
+		t.Fatalf("failed to diff golden file %s: %v", path, err)
+	}
+	t.Errorf("output differs from golden file %s (run the tests with -golden-update to accept it):\n%s", path, diff)
+}
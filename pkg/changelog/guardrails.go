@@ -0,0 +1,64 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// checkGuardrails returns the categories whose number of rendered entries is
+// outside of the limits configured for the kind of release (minor or patch).
+// Unreleased changes are checked against the minor release limits, since they
+// are collected for the next minor release.
+func checkGuardrails(response *types.ModelResponse, ver *version.Version, unreleased bool, guardrails config.Guardrails, categories []config.Category, thresholds config.Thresholds) []types.GuardrailViolation {
+	limits, kind := guardrails.Minor, "minor"
+	if !unreleased && ver.Patch() > 0 {
+		limits, kind = guardrails.Patch, "patch"
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || !isKnownCategory(change.Category, categories) {
+			continue
+		}
+		counts[strings.ToUpper(change.Category)]++
+	}
+
+	var violations []types.GuardrailViolation
+	for _, category := range config.ModelCategories {
+		l, ok := limits[category]
+		if !ok {
+			continue
+		}
+		count := counts[category]
+		if (l.Min != nil && count < *l.Min) || (l.Max != nil && count > *l.Max) {
+			violations = append(violations, types.GuardrailViolation{
+				Category: category,
+				Release:  kind,
+				Count:    count,
+				Min:      l.Min,
+				Max:      l.Max,
+			})
+		}
+	}
+	return violations
+}
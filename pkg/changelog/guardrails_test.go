@@ -0,0 +1,60 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestCheckGuardrails(t *testing.T) {
+	minAdded, maxAdded, maxFixed := 2, 0, 10
+	guardrails := config.Guardrails{
+		Action: config.GuardrailActionWarn,
+		Minor:  map[string]config.EntryLimits{"ADDED": {Min: &minAdded}},
+		Patch:  map[string]config.EntryLimits{"ADDED": {Max: &maxAdded}, "FIXED": {Max: &maxFixed}},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90},
+			{PRNumber: 101, Category: "ADDED", IncludeScore: 10},
+			{PRNumber: 102, Category: "FIXED", IncludeScore: 80},
+		},
+	}
+
+	minor, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	violations := checkGuardrails(response, minor, false, guardrails, config.DefaultCategories(), config.DefaultThresholds())
+	require.Len(t, violations, 1)
+	assert.Equal(t, "1 ADDED entries, expected at least 2 for a minor release", violations[0].String(), "Excluded entries should not be counted")
+
+	patch, err := version.Parse("2.5.1")
+	require.NoError(t, err)
+	violations = checkGuardrails(response, patch, false, guardrails, config.DefaultCategories(), config.DefaultThresholds())
+	require.Len(t, violations, 1)
+	assert.Equal(t, "1 ADDED entries, expected at most 0 for a patch release", violations[0].String())
+
+	violations = checkGuardrails(response, patch, true, guardrails, config.DefaultCategories(), config.DefaultThresholds())
+	require.Len(t, violations, 1)
+	assert.Equal(t, "minor", violations[0].Release, "Unreleased changes should be checked against the minor release limits")
+
+	assert.Empty(t, checkGuardrails(response, patch, false, config.Guardrails{}, config.DefaultCategories(), config.DefaultThresholds()))
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// LoadPreviousResponse reads the model response saved in the output artifact
+// of a previous run, for an incremental run
+func LoadPreviousResponse(path string) (*types.ModelResponse, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read previous output: %w", err)
+	}
+	return ParsePreviousResponse(path, data)
+}
+
+// ParsePreviousResponse parses the model response saved in the output
+// artifact of a previous run, e.g. read from a remote artifact storage
+func ParsePreviousResponse(path string, data []byte) (*types.ModelResponse, error) {
+	var response types.ModelResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse previous output %s: %w", path, err)
+	}
+	return &response, nil
+}
+
+// splitIncremental returns the entries of the previous response for the PRs
+// which are still selected, and the selected PRs which none of these entries
+// covers (i.e. merged since the previous run), which are the only ones sent to
+// the model. The previous response has an entry for every PR sent to the model,
+// including the ones left out of the CHANGELOG, which are not sent again.
+func splitIncremental(previous *types.ModelResponse, prs []types.PRInfo) ([]types.ChangeEntry, []types.PRInfo) {
+	authors := make(map[int]string, len(prs))
+	for _, pr := range prs {
+		authors[pr.Number] = pr.Author
+	}
+
+	covered := make(map[int]bool)
+	var reused []types.ChangeEntry
+	for _, change := range previous.Changes {
+		if _, ok := authors[change.PRNumber]; !ok {
+			// No longer selected, e.g. excluded since the previous run
+			continue
+		}
+		covered[change.PRNumber] = true
+		// Authors are not saved in the output, and grouped PRs may no longer
+		// be selected
+		grouped := change.GroupedWith
+		change.GroupedWith, change.GroupedAuthors = nil, nil
+		for _, number := range grouped {
+			if author, ok := authors[number]; ok {
+				covered[number] = true
+				change.GroupedWith = append(change.GroupedWith, number)
+				change.GroupedAuthors = append(change.GroupedAuthors, author)
+			}
+		}
+		reused = append(reused, change)
+	}
+
+	var newPRs []types.PRInfo
+	for _, pr := range prs {
+		if !covered[pr.Number] {
+			newPRs = append(newPRs, pr)
+		}
+	}
+	return reused, newPRs
+}
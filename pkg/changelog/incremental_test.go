@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestSplitIncremental(t *testing.T) {
+	previous := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "FIXED", Description: "Fix Egress IP leak", IncludeScore: 90, GroupedWith: []int{101, 102}},
+		{PRNumber: 103, Category: "CHANGED", Description: "Update docs", IncludeScore: 10},
+		{PRNumber: 104, Category: "ADDED", Description: "Add a reverted feature", IncludeScore: 90},
+	}}
+	prs := []types.PRInfo{
+		{Number: 100, Author: "alice"},
+		{Number: 101, Author: "bob"},
+		{Number: 103, Author: "carol"},
+		{Number: 105, Author: "dave"},
+	}
+
+	reused, newPRs := splitIncremental(previous, prs)
+	require.Len(t, reused, 2, "the entries of the PRs no longer selected are dropped")
+	assert.Equal(t, []int{101}, reused[0].GroupedWith)
+	assert.Equal(t, []string{"bob"}, reused[0].GroupedAuthors)
+	assert.Equal(t, 103, reused[1].PRNumber, "the entries left out of the CHANGELOG are reused")
+	require.Len(t, newPRs, 1)
+	assert.Equal(t, 105, newPRs[0].Number)
+}
+
+func TestGenerate_Incremental(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseGitHubExpectations(t, mockGitHubClient)
+
+	previous := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 1234, Category: "ADDED", Description: "Add new feature X", IncludeScore: 100, ImportanceScore: 90},
+	}}
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		DoAndReturn(func(_ context.Context, prompt, _, _ string) (*types.ModelResponse, *types.ModelDetails, error) {
+			assert.Contains(t, prompt, "## PR #5678\n")
+			assert.NotContains(t, prompt, "## PR #1234\n", "PRs of the previous run are not sent again")
+			return &types.ModelResponse{Changes: []types.ChangeEntry{
+				{PRNumber: 5678, Category: "FIXED", Description: "Fix bug Y", IncludeScore: 100, ImportanceScore: 85},
+			}}, &types.ModelDetails{Version: "2.5.0", Model: "gemini-2.5-flash"}, nil
+		})
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient,
+		WithPreviousResponse(previous))
+	changelogText, _, modelResponse, _, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, modelResponse.Changes, 2)
+	assert.Contains(t, changelogText, "- Add new feature X. ([#1234](https://github.com/antrea-io/antrea/pull/1234), [@author1])\n")
+	assert.Contains(t, changelogText, "- Fix bug Y. ([#5678](https://github.com/antrea-io/antrea/pull/5678), [@author2])\n")
+}
+
+func TestGenerate_IncrementalNoNewPRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseGitHubExpectations(t, mockGitHubClient)
+
+	previous := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 1234, Category: "ADDED", Description: "Add new feature X", IncludeScore: 100, ImportanceScore: 90},
+		{PRNumber: 5678, Category: "FIXED", Description: "Fix bug Y", IncludeScore: 100, ImportanceScore: 85},
+	}}
+	// The model is not called
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient,
+		WithPreviousResponse(previous))
+	changelogText, _, _, modelDetails, err := generator.Generate(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, modelDetails.Calls)
+	assert.Contains(t, changelogText, "Add new feature X")
+	assert.Contains(t, changelogText, "Fix bug Y")
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// closingIssueRegex matches the GitHub keywords closing an issue of the
+// repository, e.g. "Fixes #7100", "Closes: antrea-io/antrea#7100" or
+// "resolves https://github.com/antrea-io/antrea/issues/7100"
+var closingIssueRegex = regexp.MustCompile(`(?i)\b(?:fix(?:es|ed)?|close[sd]?|resolve[sd]?)\s*:?\s*(?:https://github\.com/antrea-io/antrea/issues/|antrea-io/antrea#|#)(\d+)\b`)
+
+// fixedIssuesPrompt is added to the prompt when some PRs fix a reported issue
+const fixedIssuesPrompt = `## Fixed Issues
+
+Some PRs below fix a reported issue; the title of the issue is given with **Fixes issue**. The issue title usually describes
+the symptom the users saw (e.g. "Pods lose connectivity after agent restart"), while the PR title describes the
+implementation of the fix. For a FIXED entry, describe the fix by the symptom of the issue, in the wording of the CHANGELOG
+(e.g. "Fix Pod connectivity loss after antrea-agent restart."), rather than by the implementation detail of the PR. Do not
+mention the issue number.
+
+`
+
+// closingIssues returns the issues of the repository closed by a PR, in order
+// of appearance in its title and description
+func closingIssues(pr types.PRInfo) []int {
+	var numbers []int
+	for _, m := range closingIssueRegex.FindAllStringSubmatch(pr.Title+"\n"+pr.Body, -1) {
+		number, _ := strconv.Atoi(m[1])
+		if number != pr.Number && !slices.Contains(numbers, number) {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers
+}
+
+// detectFixedIssues records in each PR the issues it closes, with their
+// titles, so that the model can describe fixes by the symptom users reported.
+// References to pull requests and to issues which do not exist are ignored.
+func (g *ChangelogGenerator) detectFixedIssues(ctx context.Context, prs []types.PRInfo) error {
+	var count int
+	for i := range prs {
+		prs[i].FixedIssues = nil
+		for _, number := range closingIssues(prs[i]) {
+			issue, err := g.githubClient.GetIssue(ctx, repoOwner, repoName, number)
+			if err != nil {
+				var notFoundErr *types.NotFoundError
+				if errors.As(err, &notFoundErr) {
+					continue
+				}
+				return fmt.Errorf("failed to get issue #%d fixed by PR #%d: %w", number, prs[i].Number, err)
+			}
+			if issue.IsPullRequest() {
+				continue
+			}
+			prs[i].FixedIssues = append(prs[i].FixedIssues, types.IssueRef{Number: number, Title: issue.GetTitle()})
+		}
+		if len(prs[i].FixedIssues) > 0 {
+			count++
+		}
+	}
+	log.Printf("Found %d PRs fixing a reported issue", count)
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestClosingIssues(t *testing.T) {
+	tests := []struct {
+		name     string
+		pr       types.PRInfo
+		expected []int
+	}{
+		{
+			name:     "keywords",
+			pr:       types.PRInfo{Number: 100, Body: "Fixes #7000\nCloses: antrea-io/antrea#7001\nresolves https://github.com/antrea-io/antrea/issues/7002"},
+			expected: []int{7000, 7001, 7002},
+		},
+		{
+			name:     "title and duplicates",
+			pr:       types.PRInfo{Number: 100, Title: "Fix agent crash (fixes #7000)", Body: "Fixed #7000."},
+			expected: []int{7000},
+		},
+		{
+			name: "no closing keyword",
+			pr:   types.PRInfo{Number: 100, Body: "Related to #7000, see also https://github.com/antrea-io/antrea/issues/7001. Fix the prefix handling"},
+		},
+		{
+			name: "other repository",
+			pr:   types.PRInfo{Number: 100, Body: "Fixes https://github.com/kubernetes/kubernetes/issues/7000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, closingIssues(tt.pr))
+		})
+	}
+}
+
+func TestDetectFixedIssues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetIssue(gomock.Any(), "antrea-io", "antrea", 7000).
+		Return(&gogithub.Issue{Number: gogithub.Ptr(7000), Title: gogithub.Ptr("Pods lose connectivity after agent restart")}, nil)
+	mockGitHubClient.EXPECT().GetIssue(gomock.Any(), "antrea-io", "antrea", 7001).
+		Return(&gogithub.Issue{Number: gogithub.Ptr(7001), Title: gogithub.Ptr("Add flow cache"), PullRequestLinks: &gogithub.PullRequestLinks{}}, nil)
+	mockGitHubClient.EXPECT().GetIssue(gomock.Any(), "antrea-io", "antrea", 7002).
+		Return(nil, &types.NotFoundError{Err: errors.New("404 Not Found")})
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithIssueSymptoms(true))
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Reset the OVS flow cache on reconnection", Body: "Fixes #7000"},
+		{Number: 101, Title: "Follow-up of the flow cache", Body: "Fixes #7001, fixes #7002"},
+		{Number: 102, Title: "Add a metric"},
+	}
+	require.NoError(t, generator.detectFixedIssues(context.Background(), prs))
+
+	assert.Equal(t, []types.IssueRef{{Number: 7000, Title: "Pods lose connectivity after agent restart"}}, prs[0].FixedIssues)
+	assert.Empty(t, prs[1].FixedIssues)
+	assert.Empty(t, prs[2].FixedIssues)
+
+	promptText := generator.buildPrompt("", prs, nil)
+	assert.Contains(t, promptText, "## Fixed Issues")
+	assert.Contains(t, promptText, "**Fixes issue:** #7000: Pods lose connectivity after agent restart\n")
+}
+
+func TestDetectFixedIssues_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetIssue(gomock.Any(), "antrea-io", "antrea", 7000).
+		Return(nil, &types.GitHubError{Err: errors.New("500 Internal Server Error")})
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithIssueSymptoms(true))
+	err := generator.detectFixedIssues(context.Background(), []types.PRInfo{{Number: 100, Body: "Fixes #7000"}})
+	require.ErrorContains(t, err, "failed to get issue #7000 fixed by PR #100")
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// kubernetesModuleRegex extracts the minor version of the k8s.io/api
+// dependency from go.mod, which follows the Kubernetes minor version (v0.32.x
+// is Kubernetes 1.32)
+var kubernetesModuleRegex = regexp.MustCompile(`(?m)^\s*(?:require\s+)?k8s\.io/api\s+v0\.(\d+)\.`)
+
+// kubernetesVersionChange is an upgrade of the Kubernetes dependencies within
+// the release window
+type kubernetesVersionChange struct {
+	from string
+	to   string
+	// mentioned is true if a rendered CHANGED entry mentions the new version
+	mentioned bool
+}
+
+// kubernetesVersion returns the Kubernetes version (e.g. 1.32) matching the
+// k8s.io/api dependency of a go.mod file, or an empty string if there is none
+func kubernetesVersion(goMod string) string {
+	m := kubernetesModuleRegex.FindStringSubmatch(goMod)
+	if m == nil {
+		return ""
+	}
+	return "1." + m[1]
+}
+
+// detectKubernetesVersionChange compares the Kubernetes dependencies of the
+// from-release tag and of the release branch, and returns nil if they are the
+// same
+func (g *ChangelogGenerator) detectKubernetesVersionChange(ctx context.Context, fromRelease, branch string) (*kubernetesVersionChange, error) {
+	versions := make([]string, 2)
+	for i, ref := range []string{"v" + fromRelease, branch} {
+		goMod, _, err := g.githubClient.GetFileAtRef(ctx, repoOwner, repoName, "go.mod", ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get go.mod at %s: %w", ref, err)
+		}
+		versions[i] = kubernetesVersion(goMod)
+	}
+	if versions[0] == "" || versions[1] == "" {
+		g.warn(types.Warning{Kind: types.WarningKindSkippedFile, Path: "go.mod", Message: "k8s.io/api dependency not found in go.mod, skipping Kubernetes version check"})
+		return nil, nil
+	}
+	if versions[0] == versions[1] {
+		return nil, nil
+	}
+	log.Printf("Kubernetes dependencies were upgraded from %s to %s", versions[0], versions[1])
+	return &kubernetesVersionChange{from: versions[0], to: versions[1]}, nil
+}
+
+// prompt returns the prompt section asking for an entry about the upgrade
+func (c *kubernetesVersionChange) prompt() string {
+	return fmt.Sprintf(`## Supported Kubernetes Versions
+
+The Kubernetes dependencies (k8s.io/api in go.mod) were upgraded from %[1]s to %[2]s in this release, which usually changes
+the range of supported Kubernetes versions. Past CHANGELOGs often omitted this, so you MUST include a CHANGED entry about it
+(e.g. "Upgrade Kubernetes dependencies to %[2]s, which may change the range of supported Kubernetes versions"), attached to
+the PR which upgraded the dependencies if it is listed below.
+
+`, c.from, c.to)
+}
+
+// checkEntry records whether a rendered CHANGED entry mentions the new
+// Kubernetes version, and logs a warning if none does
+func (c *kubernetesVersionChange) checkEntry(response *types.ModelResponse, thresholds config.Thresholds) {
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || !strings.EqualFold(change.Category, "CHANGED") {
+			continue
+		}
+		description := strings.ToLower(change.Description)
+		if (strings.Contains(description, "kubernetes") || strings.Contains(description, "k8s")) && strings.Contains(description, c.to) {
+			c.mentioned = true
+			return
+		}
+	}
+	log.Printf("Warning: Kubernetes dependencies were upgraded from %s to %s, but no CHANGED entry mentions it; add one before releasing", c.from, c.to)
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func goModWithKubernetes(apiVersion string) string {
+	return "module antrea.io/antrea\n\ngo 1.24\n\nrequire (\n\tgithub.com/spf13/cobra v1.8.1\n\tk8s.io/api " + apiVersion + "\n\tk8s.io/apimachinery " + apiVersion + "\n)\n"
+}
+
+func TestKubernetesVersion(t *testing.T) {
+	assert.Equal(t, "1.32", kubernetesVersion(goModWithKubernetes("v0.32.3")))
+	assert.Equal(t, "1.31", kubernetesVersion("module foo\n\nrequire k8s.io/api v0.31.0\n"))
+	assert.Empty(t, kubernetesVersion("module foo\n\nrequire k8s.io/apimachinery v0.31.0\n"))
+}
+
+func TestDetectKubernetesVersionChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "go.mod", "v2.4.0").
+		Return(goModWithKubernetes("v0.31.4"), "sha1", nil)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "go.mod", "main").
+		Return(goModWithKubernetes("v0.32.1"), "sha2", nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithKubernetesVersionCheck(true))
+	change, err := generator.detectKubernetesVersionChange(context.Background(), "2.4.0", "main")
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, "1.31", change.from)
+	assert.Equal(t, "1.32", change.to)
+
+	generator.kubernetesChange = change
+	promptText := generator.buildPrompt("", nil, nil)
+	assert.Contains(t, promptText, "## Supported Kubernetes Versions")
+	assert.Contains(t, promptText, "upgraded from 1.31 to 1.32")
+
+	thresholds := config.DefaultThresholds()
+	change.checkEntry(&types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "FIXED", IncludeScore: 90, Description: "Fix Kubernetes 1.32 compatibility"},
+	}}, thresholds)
+	assert.False(t, change.mentioned, "Only CHANGED entries count")
+	assert.Contains(t, generator.ReviewSummary(&types.ModelResponse{}), "no CHANGED entry mentions it")
+
+	change.checkEntry(&types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 101, Category: "CHANGED", IncludeScore: 60, Description: "Upgrade K8s dependencies to 1.32"},
+	}}, thresholds)
+	assert.True(t, change.mentioned)
+}
+
+func TestDetectKubernetesVersionChange_Unchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "go.mod", gomock.Any()).
+		Return(goModWithKubernetes("v0.32.1"), "sha", nil).Times(2)
+
+	generator := NewChangelogGenerator("2.4.1", "", false, "gemini-2.5-flash", nil, mockGitHubClient)
+	change, err := generator.detectKubernetesVersionChange(context.Background(), "2.4.0", "release-2.4")
+	require.NoError(t, err)
+	assert.Nil(t, change)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// legendLabelPrefixes are the label families explained to the model
+var legendLabelPrefixes = []string{"area/", "kind/", "action/"}
+
+// fetchLabelLegend builds a prompt section explaining the labels used by the
+// PRs of the release, from the label descriptions of the repository. Labels
+// without a description are left out.
+func (g *ChangelogGenerator) fetchLabelLegend(ctx context.Context, prs []types.PRInfo) (string, error) {
+	used := make(map[string]bool)
+	for _, pr := range prs {
+		for _, l := range pr.Labels {
+			for _, prefix := range legendLabelPrefixes {
+				if strings.HasPrefix(l, prefix) {
+					used[l] = true
+				}
+			}
+		}
+	}
+	if len(used) == 0 {
+		return "", nil
+	}
+
+	labels, err := g.githubClient.ListLabels(ctx, repoOwner, repoName)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch labels: %w", err)
+	}
+	var lines []string
+	for _, l := range labels {
+		if !used[l.GetName()] || strings.TrimSpace(l.GetDescription()) == "" {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("- `%s`: %s\n", l.GetName(), strings.TrimSpace(l.GetDescription())))
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+	sort.Strings(lines)
+	log.Printf("Explaining %d labels in the prompt", len(lines))
+
+	var sb strings.Builder
+	sb.WriteString("## Label Legend\n\n")
+	sb.WriteString("The PRs below are labeled with the Antrea label taxonomy (area/* for the affected component, kind/* for the type of change, ")
+	sb.WriteString("action/* for release process actions). Use these descriptions to interpret the labels, e.g. when choosing a category, ")
+	sb.WriteString("instead of guessing from the label names:\n\n")
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+	sb.WriteString("\n")
+	return sb.String(), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestFetchLabelLegend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().ListLabels(gomock.Any(), "antrea-io", "antrea").Return([]*gogithub.Label{
+		{Name: gogithub.Ptr("kind/bug"), Description: gogithub.Ptr("Categorizes issue or PR as related to a bug.")},
+		{Name: gogithub.Ptr("area/egress"), Description: gogithub.Ptr("Issues or PRs related to Egress.")},
+		{Name: gogithub.Ptr("area/ovs"), Description: gogithub.Ptr("")},
+		{Name: gogithub.Ptr("kind/feature"), Description: gogithub.Ptr("Categorizes issue or PR as related to a new feature.")},
+	}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient, WithLabelLegend(true))
+	prs := []types.PRInfo{
+		{Number: 100, Labels: []string{"kind/bug", "area/egress", "lgtm"}},
+		{Number: 101, Labels: []string{"area/ovs"}},
+	}
+	legend, err := generator.fetchLabelLegend(context.Background(), prs)
+	require.NoError(t, err)
+	assert.Contains(t, legend, "## Label Legend")
+	assert.Contains(t, legend, "- `area/egress`: Issues or PRs related to Egress.\n- `kind/bug`: Categorizes issue or PR as related to a bug.\n")
+	assert.NotContains(t, legend, "area/ovs", "Labels without a description should be left out")
+	assert.NotContains(t, legend, "kind/feature", "Labels not used by the PRs should be left out")
+
+	// The labels are not fetched when no PR has a label of the legend families
+	legend, err = generator.fetchLabelLegend(context.Background(), []types.PRInfo{{Number: 102, Labels: []string{"lgtm"}}})
+	require.NoError(t, err)
+	assert.Empty(t, legend)
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LinkStyle controls how the PR and author links of the entries are written
+type LinkStyle string
+
+const (
+	// LinkStyleAuto uses the style of the CHANGELOG file merged into, and
+	// LinkStyleMixed for new files
+	LinkStyleAuto LinkStyle = "auto"
+	// LinkStyleMixed writes PR links inline and author links as reference
+	// definitions, the convention of the Antrea CHANGELOG files
+	LinkStyleMixed LinkStyle = "mixed"
+	// LinkStyleInline writes all links inline
+	LinkStyleInline LinkStyle = "inline"
+	// LinkStyleReference writes all links as reference definitions gathered
+	// at the bottom of the release section
+	LinkStyleReference LinkStyle = "reference"
+)
+
+// ParseLinkStyle parses a link style name
+func ParseLinkStyle(s string) (LinkStyle, error) {
+	switch style := LinkStyle(s); style {
+	case LinkStyleAuto, LinkStyleMixed, LinkStyleInline, LinkStyleReference:
+		return style, nil
+	}
+	return "", fmt.Errorf("invalid link style %q, must be one of: auto, mixed, inline, reference", s)
+}
+
+var (
+	// entryLinkRegex matches the PR, issue and author links of an entry,
+	// inline ([#123](url), [@author](url)) or not ([#123], [@author])
+	entryLinkRegex = regexp.MustCompile(`\[([#@][^\]\s]+)\](?:\(([^)\s]+)\))?`)
+	// numberDefRegex matches PR and issue link definitions: [#123]: https://github.com/antrea-io/antrea/pull/123
+	numberDefRegex = regexp.MustCompile(`^\[#(\d+)\]:\s*(\S+)\s*$`)
+)
+
+// detectLinkStyle returns the link style of the entries of a CHANGELOG, or
+// an empty style if it has no entries with links
+func detectLinkStyle(content string) LinkStyle {
+	var inlinePRs, inlineAuthors bool
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "- ") {
+			continue
+		}
+		for _, m := range entryLinkRegex.FindAllStringSubmatch(line, -1) {
+			inline := m[2] != ""
+			if strings.HasPrefix(m[1], "#") {
+				if !inline {
+					return LinkStyleReference
+				}
+				inlinePRs = true
+			} else if inline {
+				inlineAuthors = true
+			}
+		}
+	}
+	switch {
+	case inlineAuthors:
+		return LinkStyleInline
+	case inlinePRs:
+		return LinkStyleMixed
+	}
+	return ""
+}
+
+// restyleLinks rewrites the entry links of a release section in the given
+// style. Author URLs are looked up in and recorded to authorLinks, the
+// author link definitions being written by MergeChangelog.
+func restyleLinks(lines []string, style LinkStyle, authorLinks map[string]string) []string {
+	numberLinks := make(map[string]string)
+	var body []string
+	for _, line := range lines {
+		if m := numberDefRegex.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			numberLinks["#"+m[1]] = m[2]
+			continue
+		}
+		for _, m := range entryLinkRegex.FindAllStringSubmatch(line, -1) {
+			if m[2] == "" {
+				continue
+			}
+			if strings.HasPrefix(m[1], "#") {
+				numberLinks[m[1]] = m[2]
+			} else if _, ok := authorLinks[m[1][1:]]; !ok {
+				authorLinks[m[1][1:]] = m[2]
+			}
+		}
+		body = append(body, line)
+	}
+
+	referenced := make(map[string]bool)
+	for i, line := range body {
+		body[i] = entryLinkRegex.ReplaceAllStringFunc(line, func(s string) string {
+			label := entryLinkRegex.FindStringSubmatch(s)[1]
+			var url string
+			inline := style == LinkStyleInline
+			if strings.HasPrefix(label, "#") {
+				url = numberLinks[label]
+				inline = style != LinkStyleReference
+			} else {
+				url = authorLinks[label[1:]]
+				if url == "" {
+					url = "https://github.com/" + label[1:]
+				}
+			}
+			if url == "" {
+				// Unknown PR or issue URL, leave the link untouched
+				return s
+			}
+			if inline {
+				return fmt.Sprintf("[%s](%s)", label, url)
+			}
+			if strings.HasPrefix(label, "#") {
+				referenced[label] = true
+			}
+			return fmt.Sprintf("[%s]", label)
+		})
+	}
+
+	if len(referenced) == 0 {
+		return body
+	}
+	for len(body) > 0 && strings.TrimSpace(body[len(body)-1]) == "" {
+		body = body[:len(body)-1]
+	}
+	body = append(body, "")
+	body = append(body, numberDefinitions(referenced, numberLinks)...)
+	return body
+}
+
+// numberDefinitions returns the link definitions of the given PR and issue
+// labels (#123), sorted by number
+func numberDefinitions(labels map[string]bool, urls map[string]string) []string {
+	numbers := make([]int, 0, len(labels))
+	for label := range labels {
+		if n, err := strconv.Atoi(strings.TrimPrefix(label, "#")); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+	sort.Ints(numbers)
+	defs := make([]string, 0, len(numbers))
+	for _, n := range numbers {
+		defs = append(defs, fmt.Sprintf("[#%d]: %s", n, urls["#"+strconv.Itoa(n)]))
+	}
+	return defs
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestParseLinkStyle(t *testing.T) {
+	style, err := ParseLinkStyle("reference")
+	require.NoError(t, err)
+	assert.Equal(t, LinkStyleReference, style)
+	_, err = ParseLinkStyle("footnotes")
+	assert.ErrorContains(t, err, "invalid link style")
+}
+
+func TestDetectLinkStyle(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected LinkStyle
+	}{
+		{
+			name:     "mixed",
+			content:  existingPerSection,
+			expected: LinkStyleMixed,
+		},
+		{
+			name:     "inline",
+			content:  "## 2.4.0 - 2025-01-01\n\n### Added\n\n- Add feature X. ([#1](https://github.com/antrea-io/antrea/pull/1), [@alice](https://github.com/alice))\n",
+			expected: LinkStyleInline,
+		},
+		{
+			name:     "reference",
+			content:  "## 2.4.0 - 2025-01-01\n\n### Added\n\n- Add feature X. ([#1], [@alice])\n\n[#1]: https://github.com/antrea-io/antrea/pull/1\n[@alice]: https://github.com/alice\n",
+			expected: LinkStyleReference,
+		},
+		{
+			name:     "no entries",
+			content:  "# Changelog 2.4\n",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, detectLinkStyle(tt.content))
+		})
+	}
+}
+
+func TestMergeChangelog_LinkStyle(t *testing.T) {
+	const existingReference = `# Changelog 2.4
+
+## 2.4.1 - 2025-02-01
+
+### Fixed
+
+- Fix bug A. ([#2], [@bob])
+
+[#2]: https://github.com/antrea-io/antrea/pull/2
+
+[@bob]: https://github.com/bob
+`
+	merged, err := MergeChangelog(existingReference, newSection, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+	assert.Contains(t, merged, "- Fix bug B. ([#3], [@alice])\n- Fix bug C. ([#4], [@carol])\n\n[#3]: https://github.com/antrea-io/antrea/pull/3\n[#4]: https://github.com/antrea-io/antrea/pull/4\n")
+	assert.Contains(t, merged, "- Fix bug A. ([#2], [@bob])\n\n[#2]: https://github.com/antrea-io/antrea/pull/2\n")
+	assert.NoError(t, Validate(merged))
+
+	const existingInline = `# Changelog 2.4
+
+## 2.4.1 - 2025-02-01
+
+### Fixed
+
+- Fix bug A. ([#2](https://github.com/antrea-io/antrea/pull/2), [@bob](https://github.com/bob))
+`
+	merged, err = MergeChangelog(existingInline, newSection, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+	assert.Contains(t, merged, "- Fix bug B. ([#3](https://github.com/antrea-io/antrea/pull/3), [@alice](https://github.com/alice))\n")
+	assert.NotContains(t, merged, "]: ")
+	assert.NoError(t, Validate(merged))
+
+	// An explicit style takes precedence over the style of the existing file
+	merged, err = MergeChangelog(existingPerSection, newSection, LinkPlacementAuto, LinkStyleInline)
+	require.NoError(t, err)
+	assert.Contains(t, merged, "- Fix bug C. ([#4](https://github.com/antrea-io/antrea/pull/4), [@carol](https://github.com/carol))\n")
+	assert.NotContains(t, merged, "[@carol]:")
+	assert.NoError(t, Validate(merged))
+}
+
+func TestParseCHANGELOG_ReferenceLinks(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", IncludeScore: 90, Description: "Fix crash", Author: "alice"},
+		},
+	}
+	changelogText := formatChangelog(version.New(2, 5, 0), response, formatOptions{
+		thresholds: config.DefaultThresholds(),
+		linkStyle:  LinkStyleReference,
+	})
+	prCache := make(map[int]types.HistoricalPR)
+	parseCHANGELOGEntries(changelogText, config.DefaultCategories(), prCache)
+	assert.Equal(t, types.HistoricalPR{Description: "Fix crash", Category: "FIXED", Release: "2.5.0"}, prCache[100])
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LinkPlacement controls where author link definitions are written in a CHANGELOG file
+type LinkPlacement string
+
+const (
+	// LinkPlacementAuto detects the placement used by the existing file
+	LinkPlacementAuto LinkPlacement = "auto"
+	// LinkPlacementPerSection writes definitions at the end of each release section
+	LinkPlacementPerSection LinkPlacement = "per-section"
+	// LinkPlacementEndOfFile writes all definitions in a single block at the end of the file
+	LinkPlacementEndOfFile LinkPlacement = "end-of-file"
+)
+
+// ParseLinkPlacement parses a link placement policy name
+func ParseLinkPlacement(s string) (LinkPlacement, error) {
+	switch p := LinkPlacement(s); p {
+	case LinkPlacementAuto, LinkPlacementPerSection, LinkPlacementEndOfFile:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid author link placement %q, must be one of: auto, per-section, end-of-file", s)
+}
+
+var (
+	// authorDefRegex matches author link definitions: [@author]: https://github.com/author
+	authorDefRegex = regexp.MustCompile(`^\[@([^\]]+)\]:\s*(\S+)\s*$`)
+	// authorRefRegex matches author references: [@author], the second group
+	// is not empty for inline links ([@author](url)), which need no definition
+	authorRefRegex = regexp.MustCompile(`\[@([^\]]+)\](\()?`)
+)
+
+type changelogSection struct {
+	version string
+	lines   []string
+}
+
+// MergeChangelog inserts a newly generated release section at the top of an
+// existing CHANGELOG-X.Y.md file (replacing any section for the same version)
+// and consolidates author link definitions across the whole file, so that
+// each author is defined exactly once according to the placement policy.
+// With LinkPlacementPerSection, an author is defined in the oldest section
+// referencing it, so merging a new section never modifies older sections.
+// The new section is conformed to the heading levels and optional prefix of
+// the existing file, and its links are rewritten in the given style, with
+// LinkStyleAuto in the style of the existing file. The front matter of the
+// section, if any, is not merged.
+func MergeChangelog(existing, section string, placement LinkPlacement, style LinkStyle) (string, error) {
+	links := make(map[string]string)
+	section = stripFrontMatter(section)
+
+	preamble, sections := splitSections(existing, links)
+	newPreamble, newSections := splitSections(section, links)
+	if len(newSections) != 1 {
+		return "", fmt.Errorf("expected exactly one release section in generated changelog, found %d", len(newSections))
+	}
+	newSection := newSections[0]
+
+	conventions := detectConventions(existing)
+	newSection.lines = conventions.conform(newSection.lines)
+	if placement == LinkPlacementAuto {
+		placement = detectLinkPlacement(existing)
+	}
+	if style == LinkStyleAuto {
+		style = conventions.linkStyle
+	}
+	if style != "" {
+		newSection.lines = restyleLinks(newSection.lines, style, links)
+	}
+
+	// A brand new file gets the title from the generated changelog
+	if strings.TrimSpace(strings.Join(preamble, "\n")) == "" {
+		preamble = newPreamble
+	}
+
+	merged := []changelogSection{newSection}
+	for _, s := range sections {
+		if s.version == newSection.version {
+			continue
+		}
+		merged = append(merged, s)
+	}
+
+	// Assign each author to the oldest section referencing it
+	owner := make(map[string]int)
+	for i := len(merged) - 1; i >= 0; i-- {
+		for _, author := range sectionAuthors(merged[i]) {
+			if _, ok := owner[author]; !ok {
+				owner[author] = i
+			}
+		}
+	}
+
+	var sb strings.Builder
+	writeBlock(&sb, preamble)
+	for i, s := range merged {
+		writeBlock(&sb, s.lines)
+		if placement == LinkPlacementPerSection {
+			var authors []string
+			for author, idx := range owner {
+				if idx == i {
+					authors = append(authors, author)
+				}
+			}
+			writeLinkDefinitions(&sb, authors, links)
+		}
+	}
+	if placement == LinkPlacementEndOfFile {
+		var authors []string
+		for author := range owner {
+			authors = append(authors, author)
+		}
+		writeLinkDefinitions(&sb, authors, links)
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n", nil
+}
+
+// detectLinkPlacement reports LinkPlacementPerSection if any author link
+// definition appears before the last release section, and
+// LinkPlacementEndOfFile otherwise
+func detectLinkPlacement(content string) LinkPlacement {
+	level := releaseLevel(content)
+	lastSection := -1
+	firstDef := -1
+	for i, line := range strings.Split(content, "\n") {
+		if m := headingRegex.FindStringSubmatch(line); m != nil && len(m[1]) == level {
+			lastSection = i
+		} else if firstDef == -1 && authorDefRegex.MatchString(strings.TrimSpace(line)) {
+			firstDef = i
+		}
+	}
+	if firstDef == -1 {
+		return LinkPlacementPerSection
+	}
+	if firstDef < lastSection {
+		return LinkPlacementPerSection
+	}
+	return LinkPlacementEndOfFile
+}
+
+// yankedMarker is the Keep a Changelog annotation for yanked releases
+const yankedMarker = "[YANKED]"
+
+// MarkYanked appends the [YANKED] annotation to the release headers of the
+// given versions, if not already present
+func MarkYanked(content string, versions []string) string {
+	yanked := make(map[string]bool)
+	for _, v := range versions {
+		yanked[v] = true
+	}
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		m := releaseHeadingRegex.FindStringSubmatch(line)
+		if m == nil || !yanked[m[2]] || strings.Contains(line, yankedMarker) {
+			continue
+		}
+		lines[i] = strings.TrimRight(line, " ") + " " + yankedMarker
+	}
+	return strings.Join(lines, "\n")
+}
+
+// splitSections splits a CHANGELOG into its preamble (everything before the
+// first release header) and its release sections, removing author link
+// definitions and recording them in links (first definition wins). Sections
+// start at the headings of the level of the release headings.
+func splitSections(content string, links map[string]string) ([]string, []changelogSection) {
+	level := releaseLevel(content)
+	var preamble []string
+	var sections []changelogSection
+	for _, line := range strings.Split(content, "\n") {
+		if m := authorDefRegex.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			if _, ok := links[m[1]]; !ok {
+				links[m[1]] = m[2]
+			}
+			continue
+		}
+		if m := headingRegex.FindStringSubmatch(line); m != nil && len(m[1]) == level {
+			sections = append(sections, changelogSection{version: sectionVersion(m[2])})
+		}
+		if len(sections) == 0 {
+			preamble = append(preamble, line)
+		} else {
+			sections[len(sections)-1].lines = append(sections[len(sections)-1].lines, line)
+		}
+	}
+	return preamble, sections
+}
+
+// sectionVersion returns the version of a release heading title, without
+// brackets and v prefix: [v2.4.1] - 2025-02-01 is 2.4.1
+func sectionVersion(title string) string {
+	return strings.TrimPrefix(strings.Trim(strings.Fields(title)[0], "[]"), "v")
+}
+
+func sectionAuthors(s changelogSection) []string {
+	var authors []string
+	seen := make(map[string]bool)
+	for _, line := range s.lines {
+		for _, m := range authorRefRegex.FindAllStringSubmatch(line, -1) {
+			if m[2] == "" && !seen[m[1]] {
+				seen[m[1]] = true
+				authors = append(authors, m[1])
+			}
+		}
+	}
+	return authors
+}
+
+func writeBlock(sb *strings.Builder, lines []string) {
+	block := strings.Trim(strings.Join(lines, "\n"), "\n")
+	if block == "" {
+		return
+	}
+	sb.WriteString(block)
+	sb.WriteString("\n\n")
+}
+
+func writeLinkDefinitions(sb *strings.Builder, authors []string, links map[string]string) {
+	if len(authors) == 0 {
+		return
+	}
+	sort.Strings(authors)
+	for _, author := range authors {
+		url, ok := links[author]
+		if !ok {
+			url = "https://github.com/" + author
+		}
+		sb.WriteString(fmt.Sprintf("[@%s]: %s\n", author, url))
+	}
+	sb.WriteString("\n")
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const existingPerSection = `# Changelog 2.4
+
+## 2.4.1 - 2025-02-01
+
+### Fixed
+
+- Fix bug A. ([#2](https://github.com/antrea-io/antrea/pull/2), [@bob])
+
+[@bob]: https://github.com/bob
+
+## 2.4.0 - 2025-01-01
+
+### Added
+
+- Add feature X. ([#1](https://github.com/antrea-io/antrea/pull/1), [@alice], [@bob])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+`
+
+const newSection = `## 2.4.2 - 2025-03-01
+
+### Added
+
+### Changed
+
+### Fixed
+
+- Fix bug B. ([#3](https://github.com/antrea-io/antrea/pull/3), [@alice])
+- Fix bug C. ([#4](https://github.com/antrea-io/antrea/pull/4), [@carol])
+
+
+[@alice]: https://github.com/alice
+[@carol]: https://github.com/carol
+`
+
+func TestMergeChangelog_PerSection(t *testing.T) {
+	merged, err := MergeChangelog(existingPerSection, newSection, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+
+	// New section goes right after the title
+	assert.True(t, strings.HasPrefix(merged, "# Changelog 2.4\n\n## 2.4.2 - 2025-03-01"))
+	assert.Less(t, strings.Index(merged, "## 2.4.2"), strings.Index(merged, "## 2.4.1"))
+
+	// Each author is defined exactly once, in the oldest section using it
+	assert.Equal(t, 1, strings.Count(merged, "[@alice]: "))
+	assert.Equal(t, 1, strings.Count(merged, "[@bob]: "))
+	assert.Equal(t, 1, strings.Count(merged, "[@carol]: "))
+	assert.Less(t, strings.Index(merged, "[@carol]: "), strings.Index(merged, "## 2.4.1"), "New author should be defined in the new section")
+	assert.Greater(t, strings.Index(merged, "[@alice]: "), strings.Index(merged, "## 2.4.0"), "Existing author should stay in the oldest section")
+
+	require.NoError(t, Validate(merged))
+}
+
+func TestMergeChangelog_EndOfFile(t *testing.T) {
+	existing := strings.ReplaceAll(existingPerSection, "[@bob]: https://github.com/bob\n\n## 2.4.0", "## 2.4.0")
+	merged, err := MergeChangelog(existing, newSection, LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasSuffix(merged, "[@alice]: https://github.com/alice\n[@bob]: https://github.com/bob\n[@carol]: https://github.com/carol\n"))
+	assert.Greater(t, strings.Index(merged, "[@alice]: "), strings.Index(merged, "## 2.4.0"))
+	require.NoError(t, Validate(merged))
+}
+
+func TestMergeChangelog_ReplacesExistingSection(t *testing.T) {
+	merged, err := MergeChangelog(existingPerSection, strings.ReplaceAll(newSection, "2.4.2", "2.4.1"), LinkPlacementPerSection, LinkStyleAuto)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, strings.Count(merged, "## 2.4.1"))
+	assert.NotContains(t, merged, "Fix bug A")
+	require.NoError(t, Validate(merged))
+}
+
+func TestMergeChangelog_NewFile(t *testing.T) {
+	merged, err := MergeChangelog("", "# Changelog 2.5\n\n"+strings.ReplaceAll(newSection, "2.4.2", "2.5.0"), LinkPlacementAuto, LinkStyleAuto)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(merged, "# Changelog 2.5\n\n## 2.5.0"))
+	require.NoError(t, Validate(merged))
+}
+
+func TestMarkYanked(t *testing.T) {
+	marked := MarkYanked(existingPerSection, []string{"2.4.1"})
+
+	assert.Contains(t, marked, "## 2.4.1 - 2025-02-01 [YANKED]\n")
+	assert.NotContains(t, marked, "## 2.4.0 - 2025-01-01 [YANKED]")
+	assert.Equal(t, marked, MarkYanked(marked, []string{"2.4.1"}), "Marking should be idempotent")
+	require.NoError(t, Validate(marked))
+}
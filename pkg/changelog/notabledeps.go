@@ -0,0 +1,108 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// notableDependenciesPrompt is added to the prompt when some bot PRs upgrade a
+// notable dependency
+const notableDependenciesPrompt = `## Notable Dependency Upgrades
+
+Some PRs below were opened by a bot to upgrade a dependency which matters to users; they are marked with
+**Notable dependency**. Unlike other dependency bumps, each of them MUST get a CHANGED entry with an include_score of at
+least 50, naming the dependency and the new version (e.g. "Upgrade Open vSwitch to 3.3.1."), and mentioning the user
+impact stated in the PR body, if any (e.g. fixed CVEs, new kernel requirements). If a PR groups several upgrades, only
+describe the notable ones.
+
+`
+
+// matchNotableDependency returns the notable dependency whose keywords match
+// the title of a PR, or nil
+func matchNotableDependency(title string, deps []config.NotableDependency) *config.NotableDependency {
+	for i, dep := range deps {
+		for _, keyword := range dep.Keywords {
+			re := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(keyword) + `\b`)
+			if re.MatchString(title) {
+				return &deps[i]
+			}
+		}
+	}
+	return nil
+}
+
+// matchNotableDependencyFiles returns the notable dependency whose paths match
+// one of the files changed by a PR, or nil
+func matchNotableDependencyFiles(files []string, deps []config.NotableDependency) *config.NotableDependency {
+	for i, dep := range deps {
+		for _, f := range files {
+			if matchesPathPatterns(f, dep.Paths) {
+				return &deps[i]
+			}
+		}
+	}
+	return nil
+}
+
+// keepNotableDependencyBumps restores the skipped bot PRs upgrading a notable
+// dependency, detected by title first and otherwise by changed files, and
+// marks them for the model
+func (g *ChangelogGenerator) keepNotableDependencyBumps(ctx context.Context, prs []types.PRInfo, skipped []types.SkippedPR, all []types.PRInfo) ([]types.PRInfo, []types.SkippedPR, error) {
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range all {
+		byNumber[pr.Number] = pr
+	}
+
+	kept := make([]types.SkippedPR, 0, len(skipped))
+	var count int
+	for _, s := range skipped {
+		if s.Reason != types.SkipReasonBotAuthor {
+			kept = append(kept, s)
+			continue
+		}
+		pr := byNumber[s.Number]
+		dep := matchNotableDependency(pr.Title, g.notableDependencies)
+		if dep == nil {
+			files, err := g.pullRequestFiles(ctx, pr.Number)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list files of PR #%d: %w", pr.Number, err)
+			}
+			dep = matchNotableDependencyFiles(files, g.notableDependencies)
+		}
+		if dep == nil {
+			kept = append(kept, s)
+			continue
+		}
+		log.Printf("Keeping bot PR #%d upgrading %s", pr.Number, dep.Name)
+		pr.NotableDependency = dep.Name
+		prs = append(prs, pr)
+		count++
+	}
+	if count > 0 {
+		sort.SliceStable(prs, func(i, j int) bool {
+			return prs[i].MergedAt.Before(prs[j].MergedAt)
+		})
+	}
+	log.Printf("Found %d bot PRs upgrading a notable dependency", count)
+	return prs, kept, nil
+}
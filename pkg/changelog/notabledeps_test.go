@@ -0,0 +1,99 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestMatchNotableDependency(t *testing.T) {
+	deps := config.DefaultNotableDependencies()
+	for title, expected := range map[string]string{
+		"Update OVS to v3.3.1":                          "Open vSwitch",
+		"Bump Open vSwitch version to 3.3.1":            "Open vSwitch",
+		"Update containernetworking/plugins to v1.6.0":  "CNI plugins",
+		"Update dependency go to v1.24.2":               "Go",
+		"Update module golang.org/x/net to v0.38.0":     "",
+		"Update module github.com/movsim/foo to v1.0.0": "",
+	} {
+		t.Run(title, func(t *testing.T) {
+			dep := matchNotableDependency(title, deps)
+			if expected == "" {
+				assert.Nil(t, dep)
+			} else {
+				require.NotNil(t, dep)
+				assert.Equal(t, expected, dep.Name)
+			}
+		})
+	}
+
+	dep := matchNotableDependencyFiles([]string{"go.mod", "build/images/deps/cni-binaries-version"}, deps)
+	require.NotNil(t, dep)
+	assert.Equal(t, "CNI plugins", dep.Name)
+	assert.Nil(t, matchNotableDependencyFiles([]string{"go.mod", "go.sum"}, deps))
+}
+
+func TestKeepNotableDependencyBumps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	all := []types.PRInfo{
+		{Number: 100, Title: "Update OVS to v3.3.1", Author: "renovate[bot]", MergedAt: base},
+		{Number: 101, Title: "Fix agent", Author: "alice", MergedAt: base.Add(time.Hour)},
+		{Number: 102, Title: "Update all non-major dependencies", Author: "renovate[bot]", MergedAt: base.Add(2 * time.Hour)},
+		{Number: 103, Title: "Update module golang.org/x/net to v0.38.0", Author: "dependabot[bot]", MergedAt: base.Add(3 * time.Hour)},
+	}
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	// The files are only fetched for the bot PRs whose title does not match
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 102).
+		Return([]string{"go.mod", "build/images/deps/cni-binaries-version"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 103).
+		Return([]string{"go.mod", "go.sum"}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", true, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithNotableDependencies(config.DefaultNotableDependencies()))
+	prs, skipped := filterBotPRs(all)
+	prs, skipped, err := generator.keepNotableDependencyBumps(context.Background(), prs, skipped, all)
+	require.NoError(t, err)
+
+	var numbers []int
+	for _, pr := range prs {
+		numbers = append(numbers, pr.Number)
+	}
+	assert.Equal(t, []int{100, 101, 102}, numbers, "notable upgrades are kept in merge order")
+	assert.Equal(t, "Open vSwitch", prs[0].NotableDependency)
+	assert.Equal(t, "CNI plugins", prs[2].NotableDependency)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, 103, skipped[0].Number)
+	assert.Equal(t, types.SkipReasonBotAuthor, skipped[0].Reason)
+
+	promptText := generator.buildPrompt("", prs, nil)
+	assert.Contains(t, promptText, "## Notable Dependency Upgrades")
+	assert.Contains(t, promptText, "## PR #100\n**Title:** Update OVS to v3.3.1\n")
+	assert.Contains(t, promptText, "**Notable dependency:** Open vSwitch\n")
+	assert.Contains(t, promptText, "**Notable dependency:** CNI plugins\n")
+}
@@ -0,0 +1,336 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// Option configures optional behavior of a ChangelogGenerator
+type Option func(*ChangelogGenerator)
+
+// WithProvenanceComment embeds an HTML comment at the top of the generated
+// release section recording the tool version, model, prompt hash and timestamp
+func WithProvenanceComment(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.provenanceComment = enabled
+	}
+}
+
+// WithFrontMatter prepends YAML front matter to the generated release section
+// with its version, date, entry counts per category and the tool version
+func WithFrontMatter(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.frontMatter = enabled
+	}
+}
+
+// WithExcludedLabels excludes PRs with any of the given labels from the CHANGELOG
+func WithExcludedLabels(labels []string) Option {
+	return func(g *ChangelogGenerator) {
+		g.excludedLabels = labels
+	}
+}
+
+// WithPRFilter only sends the PRs matching the filter expression to the model
+func WithPRFilter(filter *PRFilter) Option {
+	return func(g *ChangelogGenerator) {
+		g.prFilter = filter
+	}
+}
+
+// WithCategories sets the categories to render, in order, and their headers
+func WithCategories(categories []config.Category) Option {
+	return func(g *ChangelogGenerator) {
+		g.categories = categories
+	}
+}
+
+// WithScoreThresholds sets the include_score thresholds used to include entries
+func WithScoreThresholds(thresholds config.Thresholds) Option {
+	return func(g *ChangelogGenerator) {
+		g.thresholds = thresholds
+	}
+}
+
+// WithIncludeOptional controls whether entries below the include threshold are
+// emitted with the *OPTIONAL* prefix (the default) or left out
+func WithIncludeOptional(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.includeOptional = enabled
+	}
+}
+
+// WithMaxDescriptionLength enforces single-sentence entry descriptions of at
+// most maxLength characters, asking the model to shorten the ones which are not
+func WithMaxDescriptionLength(maxLength int) Option {
+	return func(g *ChangelogGenerator) {
+		g.maxDescriptionLength = maxLength
+	}
+}
+
+// WithMinDescriptionConfidence uses the PR title, marked with a TODO comment,
+// instead of the descriptions whose description_confidence is below
+// minConfidence (0 to disable)
+func WithMinDescriptionConfidence(minConfidence int) Option {
+	return func(g *ChangelogGenerator) {
+		g.minDescriptionConfidence = minConfidence
+	}
+}
+
+// WithGuardrails checks the number of entries of each category against the
+// limits configured for the kind of release
+func WithGuardrails(guardrails config.Guardrails) Option {
+	return func(g *ChangelogGenerator) {
+		g.guardrails = guardrails
+	}
+}
+
+// WithAcknowledgedPatchFeatures allows the given PRs to be ADDED entries in a
+// patch release, which are reported otherwise
+func WithAcknowledgedPatchFeatures(numbers []int) Option {
+	return func(g *ChangelogGenerator) {
+		g.acknowledgedPatchFeatures = numbers
+	}
+}
+
+// WithBackportExceptions renders the entries of intentionally backported
+// features with a "(backported to X.Y)" suffix, and acknowledges them as ADDED
+// entries in the patch releases of these branches
+func WithBackportExceptions(exceptions []config.BackportException) Option {
+	return func(g *ChangelogGenerator) {
+		g.backports = backportReleases(exceptions)
+	}
+}
+
+// WithDriftReport compares the PRs of the commits on the release branch since
+// the from-release tag with the selected PRs, before calling the model
+func WithDriftReport(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.driftReport = enabled
+	}
+}
+
+// WithPROverrides forcibly includes or excludes PRs for the run
+func WithPROverrides(overrides PROverrides) Option {
+	return func(g *ChangelogGenerator) {
+		g.overrides = overrides
+	}
+}
+
+// WithEntryAnchors adds an HTML anchor derived from the PR number to each
+// entry (e.g. <a id="pr-7200"></a>), so that entries can be deep-linked
+func WithEntryAnchors(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.entryAnchors = enabled
+	}
+}
+
+// WithLinkStyle sets the style of the PR and author links of the generated
+// changelog, LinkStyleAuto rendering LinkStyleMixed
+func WithLinkStyle(style LinkStyle) Option {
+	return func(g *ChangelogGenerator) {
+		g.linkStyle = style
+	}
+}
+
+// WithStyleProfile sets the style profile of the descriptions: the prompt
+// instructions, the rewriting of the leading verb of the descriptions, and the
+// links rendered with LinkStyleAuto
+func WithStyleProfile(style config.StyleProfile) Option {
+	return func(g *ChangelogGenerator) {
+		g.style = style
+	}
+}
+
+// WithCorrections adds the most recent corrections of past generated entries
+// to the prompt, as examples of the edits release managers make
+func WithCorrections(corrections []types.Correction) Option {
+	return func(g *ChangelogGenerator) {
+		g.corrections = corrections
+	}
+}
+
+// WithClock sets the clock the release date and the artifact timestamps are
+// derived from (default: the system clock)
+func WithClock(clock Clock) Option {
+	return func(g *ChangelogGenerator) {
+		g.clock = clock
+	}
+}
+
+// WithWindowsCallout repeats the entries selected by the callout (e.g. the
+// Windows-specific ones) in a dedicated section after the categories
+func WithWindowsCallout(callout *config.Callout) Option {
+	return func(g *ChangelogGenerator) {
+		g.windowsCallout = callout
+	}
+}
+
+// WithKnownIssuesLabel renders a Known Issues section listing the open issues
+// with the given label (e.g. known-issue/v2.5)
+func WithKnownIssuesLabel(label string) Option {
+	return func(g *ChangelogGenerator) {
+		g.knownIssuesLabel = label
+	}
+}
+
+// WithYankedReleases skips the given releases (X.Y.Z) when calculating the
+// from-release, so that their changes are included in the next release
+func WithYankedReleases(versions []string) Option {
+	return func(g *ChangelogGenerator) {
+		g.yankedReleases = versions
+	}
+}
+
+// WithPlatformHints lists the changed files matching the build paths (e.g.
+// Dockerfiles, CI matrices) in the prompt and asks the model to call out newly
+// supported platforms and architectures
+func WithPlatformHints(buildPaths []string) Option {
+	return func(g *ChangelogGenerator) {
+		g.buildPaths = buildPaths
+	}
+}
+
+// WithNotableDependencies keeps the bot PRs upgrading one of the notable
+// dependencies (e.g. Open vSwitch), and asks the model for a CHANGED entry
+// about each of them
+func WithNotableDependencies(deps []config.NotableDependency) Option {
+	return func(g *ChangelogGenerator) {
+		g.notableDependencies = deps
+	}
+}
+
+// WithFollowUpGrouping detects the PRs following up on another PR of the
+// release, asks the model to describe the change once, and groups their
+// entries with the entry of the original PR
+func WithFollowUpGrouping(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.groupFollowUps = enabled
+	}
+}
+
+// WithIssueSymptoms fetches the titles of the issues closed by the PRs
+// ("Fixes #123"), and asks the model to describe FIXED entries by the symptom
+// users reported rather than by the implementation of the fix
+func WithIssueSymptoms(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.issueSymptoms = enabled
+	}
+}
+
+// WithDocsOnlyPRs detects the PRs which only change files matching the
+// documentation paths, and marks or excludes them depending on the mode
+func WithDocsOnlyPRs(mode DocsOnlyMode, docsPaths []string) Option {
+	return func(g *ChangelogGenerator) {
+		g.docsOnly = mode
+		g.docsPaths = docsPaths
+	}
+}
+
+// WithConfigDefaultsCheck detects changed defaults of the Helm values and of
+// the agent and controller config templates, asks the model for a CHANGED entry
+// about each of them, and warns about the changes no entry mentions
+func WithConfigDefaultsCheck(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.configDefaultsCheck = enabled
+	}
+}
+
+// WithCLIFlagsCheck detects the flags of antctl and of the agent and
+// controller added, deprecated or removed in the release, and lists them in the
+// prompt. With section, they are also rendered in their own section.
+func WithCLIFlagsCheck(enabled, section bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.cliFlagsCheck = enabled
+		g.cliFlagsSection = enabled && section
+	}
+}
+
+// WithDeprecationsCheck detects the Prometheus metrics and CRD fields
+// deprecated or removed in the release, and lists them in a Deprecated section
+func WithDeprecationsCheck(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.deprecationsCheck = enabled
+	}
+}
+
+// WithKubernetesVersionCheck detects upgrades of the Kubernetes dependencies
+// within the release window, asks the model for a CHANGED entry about the
+// supported Kubernetes versions, and warns if there is none
+func WithKubernetesVersionCheck(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.kubernetesVersionCheck = enabled
+	}
+}
+
+// WithLabelLegend explains the area/*, kind/* and action/* labels of the PRs
+// in the prompt, using the label descriptions of the repository
+func WithLabelLegend(enabled bool) Option {
+	return func(g *ChangelogGenerator) {
+		g.labelLegend = enabled
+	}
+}
+
+// WithLinkTemplates sets the URL templates of the PR, issue and author links
+// (default: antrea-io/antrea on GitHub)
+func WithLinkTemplates(links config.LinkTemplates) Option {
+	return func(g *ChangelogGenerator) {
+		g.links = links
+	}
+}
+
+// WithStageTimeouts sets the deadlines of the fetch and model stages, so that
+// a stuck connection fails the run with the stage attributed
+func WithStageTimeouts(timeouts StageTimeouts) Option {
+	return func(g *ChangelogGenerator) {
+		g.timeouts = timeouts
+	}
+}
+
+// WithWindowLimits enables sanity checks of the release window. When a limit
+// is exceeded, a warning is logged and confirm is called to decide whether to
+// continue; a nil confirm always aborts.
+func WithWindowLimits(limits WindowLimits, confirm ConfirmFunc) Option {
+	return func(g *ChangelogGenerator) {
+		g.windowLimits = limits
+		g.confirm = confirm
+	}
+}
+
+// WithPRSource selects how the PRs of the release are discovered
+func WithPRSource(source PRSource) Option {
+	return func(g *ChangelogGenerator) {
+		g.prSource = source
+	}
+}
+
+// WithPreviousResponse makes the run incremental: the entries of the model
+// response of a previous run are reused, and only the PRs it does not cover
+// (e.g. late cherry-picks merged since) are sent to the model
+func WithPreviousResponse(response *types.ModelResponse) Option {
+	return func(g *ChangelogGenerator) {
+		g.previousResponse = response
+	}
+}
+
+// WithCodeOwners routes the review of each entry to the code owners of most of
+// the files changed by its PRs (fetches the files of every rendered entry)
+func WithCodeOwners(codeOwners *CodeOwners) Option {
+	return func(g *ChangelogGenerator) {
+		g.codeOwners = codeOwners
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// PROverrides forcibly includes or excludes PRs for a run, e.g. to include a
+// bot PR which actually matters to users, like a major dependency bump
+type PROverrides struct {
+	// Include are the PRs sent to the model regardless of the author, label,
+	// revert and filter exclusions, and of the release note label
+	Include []int
+	// Exclude are the PRs never sent to the model
+	Exclude []int
+}
+
+// fetchForcedPRs adds the force-included PRs which were not selected (e.g.
+// because they lack the release note label) to the PRs of the release
+func (g *ChangelogGenerator) fetchForcedPRs(ctx context.Context, prs []types.PRInfo) ([]types.PRInfo, error) {
+	selected := make(map[int]bool)
+	for _, pr := range prs {
+		selected[pr.Number] = true
+	}
+	var missing []int
+	for _, number := range g.overrides.Include {
+		if !selected[number] {
+			missing = append(missing, number)
+		}
+	}
+	if len(missing) == 0 {
+		return prs, nil
+	}
+
+	pulls, err := g.githubClient.GetPullRequests(ctx, repoOwner, repoName, missing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch force-included PRs: %w", err)
+	}
+	for _, number := range missing {
+		pull, ok := pulls[number]
+		if !ok || pull.MergedAt == nil {
+			return nil, fmt.Errorf("force-included PR #%d was not found or is not merged", number)
+		}
+		var labels []string
+		for _, l := range pull.Labels {
+			labels = append(labels, l.GetName())
+		}
+		pr := types.PRInfo{
+			Number:         pull.GetNumber(),
+			Title:          pull.GetTitle(),
+			Body:           pull.GetBody(),
+			Author:         prAuthor(pull),
+			Labels:         labels,
+			MergedAt:       pull.MergedAt.Time,
+			MergeCommitSHA: pull.GetMergeCommitSHA(),
+		}
+		prs = append(prs, pr)
+		g.recordOverride(pr, true, "not selected for the release, fetched")
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].MergedAt.Before(prs[j].MergedAt)
+	})
+	return prs, nil
+}
+
+// applyOverrides restores the force-included PRs which were skipped by the
+// filters, and skips the force-excluded PRs
+func (g *ChangelogGenerator) applyOverrides(prs []types.PRInfo, skipped []types.SkippedPR, all []types.PRInfo) ([]types.PRInfo, []types.SkippedPR) {
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range all {
+		byNumber[pr.Number] = pr
+	}
+
+	kept := make([]types.SkippedPR, 0, len(skipped))
+	restored := false
+	for _, s := range skipped {
+		if !slices.Contains(g.overrides.Include, s.Number) {
+			kept = append(kept, s)
+			continue
+		}
+		pr := byNumber[s.Number]
+		prs = append(prs, pr)
+		restored = true
+		g.recordOverride(pr, true, fmt.Sprintf("bypassed %s (%s)", s.Reason, s.Detail))
+	}
+	if restored {
+		sort.SliceStable(prs, func(i, j int) bool {
+			return prs[i].MergedAt.Before(prs[j].MergedAt)
+		})
+	}
+
+	filtered := make([]types.PRInfo, 0, len(prs))
+	for _, pr := range prs {
+		if slices.Contains(g.overrides.Exclude, pr.Number) {
+			kept = append(kept, newSkippedPR(pr, types.SkipReasonOverride, ""))
+			g.recordOverride(pr, false, "")
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered, kept
+}
+
+// includeForcedEntries raises the include_score of the entries of
+// force-included PRs to the include threshold, so that they are rendered even
+// if the model would leave them out
+func (g *ChangelogGenerator) includeForcedEntries(response *types.ModelResponse, thresholds config.Thresholds) {
+	for i := range response.Changes {
+		change := &response.Changes[i]
+		if change.IncludeScore >= thresholds.Include {
+			continue
+		}
+		for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+			if slices.Contains(g.overrides.Include, number) {
+				log.Printf("Raising the include_score of force-included PR #%d from %d to %d", change.PRNumber, change.IncludeScore, thresholds.Include)
+				change.IncludeScore = thresholds.Include
+				break
+			}
+		}
+	}
+}
+
+func (g *ChangelogGenerator) recordOverride(pr types.PRInfo, included bool, detail string) {
+	action := "Force-included"
+	if !included {
+		action = "Force-excluded"
+	}
+	if detail != "" {
+		log.Printf("%s PR #%d: %s", action, pr.Number, detail)
+	} else {
+		log.Printf("%s PR #%d", action, pr.Number)
+	}
+	g.appliedOverrides = append(g.appliedOverrides, types.PROverride{
+		Number:   pr.Number,
+		Title:    pr.Title,
+		Author:   pr.Author,
+		Included: included,
+		Detail:   detail,
+	})
+}
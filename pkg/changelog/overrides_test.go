@@ -0,0 +1,84 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestPROverrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	// #103 lacks the release note label, so it was not selected
+	mockGitHubClient.EXPECT().
+		GetPullRequests(gomock.Any(), "antrea-io", "antrea", []int{103}).
+		Return(map[int]*gogithub.PullRequest{103: newMergedPR(103)}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithPROverrides(PROverrides{Include: []int{100, 103}, Exclude: []int{102}}))
+	now := time.Now()
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Bump Go to 1.25", Author: "renovate[bot]", MergedAt: now.Add(-3 * time.Hour)},
+		{Number: 101, Title: "Bump golang.org/x/net", Author: "renovate[bot]", MergedAt: now.Add(-2 * time.Hour)},
+		{Number: 102, Title: "Add feature", Author: "alice", MergedAt: now.Add(-time.Hour)},
+	}
+
+	prs, err := generator.fetchForcedPRs(context.Background(), prs)
+	require.NoError(t, err)
+	require.Len(t, prs, 4)
+	selected := prs
+	prs, skipped := filterBotPRs(prs)
+	prs, skipped = generator.applyOverrides(prs, skipped, selected)
+
+	var numbers []int
+	for _, pr := range prs {
+		numbers = append(numbers, pr.Number)
+	}
+	assert.Equal(t, []int{100, 103}, numbers)
+	assert.Equal(t, []types.SkippedPR{
+		{Number: 101, Title: "Bump golang.org/x/net", Author: "renovate[bot]", Reason: types.SkipReasonBotAuthor, Detail: "renovate[bot]"},
+		{Number: 102, Title: "Add feature", Author: "alice", Reason: types.SkipReasonOverride},
+	}, skipped)
+	assert.Equal(t, []types.PROverride{
+		{Number: 103, Title: "PR title", Author: "author", Included: true, Detail: "not selected for the release, fetched"},
+		{Number: 100, Title: "Bump Go to 1.25", Author: "renovate[bot]", Included: true, Detail: "bypassed bot author (renovate[bot])"},
+		{Number: 102, Title: "Add feature", Author: "alice"},
+	}, generator.AppliedOverrides())
+
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "CHANGED", IncludeScore: 10},
+			{PRNumber: 104, Category: "FIXED", IncludeScore: 20, GroupedWith: []int{103}},
+			{PRNumber: 105, Category: "FIXED", IncludeScore: 20},
+		},
+	}
+	generator.includeForcedEntries(response, config.DefaultThresholds())
+	assert.Equal(t, 50, response.Changes[0].IncludeScore)
+	assert.Equal(t, 50, response.Changes[1].IncludeScore, "Entries grouping a force-included PR should be included")
+	assert.Equal(t, 20, response.Changes[2].IncludeScore)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// platformHintsPrompt is added to the prompt when some PRs change build files
+const platformHintsPrompt = `## Platform Support
+
+Some PRs below change build matrices, CI workflows or Dockerfiles; their changed build files are listed under **Build changes**.
+Users care about newly supported platforms, operating systems and architectures (e.g. arm64 images, a new Windows Server version),
+but PR bodies often bury them. If such a PR adds (or drops) support for a platform, OS or architecture, state it explicitly in the
+description (e.g. "Add arm64 support for the antrea-agent image"). Build changes which do not affect supported platforms (e.g. CI
+refactoring or version bumps) do not need to mention them.
+
+`
+
+// pullRequestFiles returns the files changed by a PR, caching results so that
+// each PR's files are fetched at most once
+func (g *ChangelogGenerator) pullRequestFiles(ctx context.Context, number int) ([]string, error) {
+	if files, ok := g.prFiles[number]; ok {
+		return files, nil
+	}
+	files, err := g.githubClient.ListPullRequestFiles(ctx, repoOwner, repoName, number)
+	if err != nil {
+		return nil, err
+	}
+	if g.prFiles == nil {
+		g.prFiles = make(map[int][]string)
+	}
+	g.prFiles[number] = files
+	return files, nil
+}
+
+// detectBuildChanges records in each PR the changed files matching the build
+// paths (build matrices, Dockerfiles), so that the model can call out
+// platform support changes
+func (g *ChangelogGenerator) detectBuildChanges(ctx context.Context, prs []types.PRInfo) error {
+	var count int
+	for i := range prs {
+		files, err := g.pullRequestFiles(ctx, prs[i].Number)
+		if err != nil {
+			return fmt.Errorf("failed to list files of PR #%d: %w", prs[i].Number, err)
+		}
+		for _, f := range files {
+			if matchesPathPatterns(f, g.buildPaths) {
+				prs[i].BuildFiles = append(prs[i].BuildFiles, f)
+			}
+		}
+		if len(prs[i].BuildFiles) > 0 {
+			count++
+		}
+	}
+	log.Printf("Found %d PRs changing build files", count)
+	return nil
+}
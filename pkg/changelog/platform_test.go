@@ -0,0 +1,56 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDetectBuildChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 100).
+		Return([]string{"build/images/Dockerfile.build.ubuntu", ".github/workflows/build.yml", "pkg/agent/agent.go"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 101).
+		Return([]string{"pkg/agent/agent.go"}, nil)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithPlatformHints(config.DefaultBuildPaths()))
+	prs := []types.PRInfo{{Number: 100, Title: "Build arm64 images"}, {Number: 101, Title: "Fix agent"}}
+	require.NoError(t, generator.detectBuildChanges(context.Background(), prs))
+
+	assert.Equal(t, []string{"build/images/Dockerfile.build.ubuntu", ".github/workflows/build.yml"}, prs[0].BuildFiles)
+	assert.Empty(t, prs[1].BuildFiles)
+
+	promptText := generator.buildPrompt("", prs, nil)
+	assert.Contains(t, promptText, "## Platform Support")
+	assert.Contains(t, promptText, "**Build changes:** build/images/Dockerfile.build.ubuntu, .github/workflows/build.yml\n")
+
+	// Files are fetched once per PR
+	files, err := generator.pullRequestFiles(context.Background(), 101)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pkg/agent/agent.go"}, files)
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// detectPatchFeatures returns the rendered ADDED entries of a patch release
+// which were not acknowledged. Per Antrea policy, features do not land in
+// patch releases, so these entries are either misclassified or the result of
+// a mistaken cherry-pick. A grouped entry is acknowledged if any of its PRs is.
+func detectPatchFeatures(response *types.ModelResponse, ver *version.Version, unreleased bool, acknowledged []int, categories []config.Category, thresholds config.Thresholds) []types.ChangeEntry {
+	if unreleased || ver.Patch() == 0 {
+		return nil
+	}
+	acked := make(map[int]bool)
+	for _, number := range acknowledged {
+		acked[number] = true
+	}
+
+	var features []types.ChangeEntry
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || !strings.EqualFold(change.Category, "ADDED") || !isKnownCategory(change.Category, categories) {
+			continue
+		}
+		isAcked := false
+		for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+			isAcked = isAcked || acked[number]
+		}
+		if !isAcked {
+			features = append(features, change)
+		}
+	}
+	return features
+}
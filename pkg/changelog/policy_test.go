@@ -0,0 +1,48 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestDetectPatchFeatures(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature"},
+			{PRNumber: 101, Category: "added", IncludeScore: 80, Description: "Add backported feature", GroupedWith: []int{102}},
+			{PRNumber: 103, Category: "ADDED", IncludeScore: 10, Description: "Add test"},
+			{PRNumber: 104, Category: "FIXED", IncludeScore: 90, Description: "Fix crash"},
+		},
+	}
+
+	patch, err := version.Parse("2.5.1")
+	require.NoError(t, err)
+	features := detectPatchFeatures(response, patch, false, []int{102}, config.DefaultCategories(), config.DefaultThresholds())
+	require.Len(t, features, 1, "Excluded and acknowledged entries should not be reported")
+	assert.Equal(t, 100, features[0].PRNumber)
+
+	minor, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	assert.Empty(t, detectPatchFeatures(response, minor, false, nil, config.DefaultCategories(), config.DefaultThresholds()))
+	assert.Empty(t, detectPatchFeatures(response, patch, true, nil, config.DefaultCategories(), config.DefaultThresholds()))
+}
@@ -20,3 +20,10 @@ import (
 
 //go:embed PROMPT.md
 var Template string
+
+// ShortenTemplate is the prompt used to ask the model to shorten entries
+// which do not follow the length and sentence constraints. The
+// {{MAX_LENGTH}} placeholder is replaced with the maximum length.
+//
+//go:embed SHORTEN.md
+var ShortenTemplate string
@@ -0,0 +1,479 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// PublishOptions configures the CHANGELOG pull request
+type PublishOptions struct {
+	// Release is the release version (X.Y.Z)
+	Release string
+	// HeadOwner and HeadRepo are the repository the branch is pushed to,
+	// usually a fork (default: antrea-io/antrea)
+	HeadOwner string
+	HeadRepo  string
+	// Branch is the name of the branch to create (default: changelog-vX.Y.Z)
+	Branch string
+	// LinkPlacement is the author link placement used to merge the new section
+	LinkPlacement LinkPlacement
+	// LinkStyle is the link style of the new section in the CHANGELOG file
+	LinkStyle LinkStyle
+	// YankedReleases are annotated with [YANKED] in the CHANGELOG file
+	YankedReleases []string
+	// ReviewComment is posted as a review on the new pull request, if not empty
+	ReviewComment string
+	// Summary is added to the description of the new pull request, if not empty
+	Summary string
+	// Reviewers are the code owners (@user or @org/team) whose review is
+	// requested on the new pull request
+	Reviewers []string
+}
+
+// publishPlan contains what PublishChangelog submits, derived from the options
+type publishPlan struct {
+	base      string
+	path      string
+	headOwner string
+	headRepo  string
+	branch    string
+	head      string
+	title     string
+	body      string
+}
+
+func newPublishPlan(opts PublishOptions) (*publishPlan, error) {
+	ver, err := version.Parse(opts.Release)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release version: %w", err)
+	}
+	p := &publishPlan{
+		base:      determineBranch(ver),
+		path:      fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", ver.Major(), ver.Minor()),
+		headOwner: opts.HeadOwner,
+		headRepo:  opts.HeadRepo,
+		branch:    opts.Branch,
+		title:     fmt.Sprintf("Add CHANGELOG for v%s", opts.Release),
+		body:      fmt.Sprintf("Add CHANGELOG for v%s.\n\n", opts.Release),
+	}
+	if opts.Summary != "" {
+		p.body += opts.Summary + "\n"
+	}
+	p.body += fmt.Sprintf("Generated with antrea-releaser %s.\n", ToolVersion())
+	if p.headOwner == "" {
+		p.headOwner, p.headRepo = repoOwner, repoName
+	}
+	if p.branch == "" {
+		p.branch = fmt.Sprintf("changelog-v%s", opts.Release)
+	}
+	p.head = p.branch
+	if p.headOwner != repoOwner {
+		p.head = p.headOwner + ":" + p.branch
+	}
+	return p, nil
+}
+
+// merge merges the changelog into the existing content of the CHANGELOG file
+func (p *publishPlan) merge(existing, changelogText string, opts PublishOptions) (string, error) {
+	merged, err := MergeChangelog(existing, changelogText, opts.LinkPlacement, opts.LinkStyle)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge changelog into %s: %w", p.path, err)
+	}
+	merged = MarkYanked(merged, opts.YankedReleases)
+	if err := Validate(merged); err != nil {
+		log.Printf("Warning: merged %s has markdown issues: %v", p.path, err)
+	}
+	return merged, nil
+}
+
+// PublishChangelog merges the generated changelog into the CHANGELOG-X.Y.md
+// file of the release branch, commits it to a new branch and opens a pull
+// request against antrea-io/antrea
+func PublishChangelog(ctx context.Context, client types.PRPublisher, changelogText string, opts PublishOptions) (*gogithub.PullRequest, error) {
+	p, err := newPublishPlan(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseSHA, err := client.GetBranchSHA(ctx, repoOwner, repoName, p.base)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.CreateBranch(ctx, p.headOwner, p.headRepo, p.branch, baseSHA); err != nil {
+		return nil, err
+	}
+	log.Printf("Created branch %s in %s/%s from %s", p.branch, p.headOwner, p.headRepo, p.base)
+
+	existing, blobSHA, err := client.GetFileAtRef(ctx, p.headOwner, p.headRepo, p.path, p.branch)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := p.merge(existing, changelogText, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.CommitFile(ctx, p.headOwner, p.headRepo, p.branch, p.path, p.title, []byte(merged), blobSHA); err != nil {
+		return nil, err
+	}
+
+	pr, err := client.CreatePullRequest(ctx, repoOwner, repoName, &gogithub.NewPullRequest{
+		Title: gogithub.Ptr(p.title),
+		Head:  gogithub.Ptr(p.head),
+		Base:  gogithub.Ptr(p.base),
+		Body:  gogithub.Ptr(p.body),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Created pull request %s", pr.GetHTMLURL())
+
+	if opts.ReviewComment != "" {
+		if err := client.CreateReviewComment(ctx, repoOwner, repoName, pr.GetNumber(), opts.ReviewComment); err != nil {
+			return pr, err
+		}
+		log.Printf("Posted review summary on pull request #%d", pr.GetNumber())
+	}
+	if users, teams := splitReviewers(opts.Reviewers); len(users)+len(teams) > 0 {
+		// Best effort, e.g. the review of the author of the pull request
+		// cannot be requested
+		if err := client.RequestReviewers(ctx, repoOwner, repoName, pr.GetNumber(), users, teams); err != nil {
+			log.Printf("Warning: %v", err)
+		} else {
+			log.Printf("Requested reviews from %s on pull request #%d", strings.Join(opts.Reviewers, ", "), pr.GetNumber())
+		}
+	}
+	return pr, nil
+}
+
+// PreviewChangelogPR renders what PublishChangelog would submit, including
+// the diff of the CHANGELOG-X.Y.md file against the release branch, without
+// making any change. It only reads from the repository, so it can be used to
+// check the automation before granting it write access.
+func PreviewChangelogPR(ctx context.Context, client types.PRPublisher, changelogText string, opts PublishOptions) (string, error) {
+	p, err := newPublishPlan(opts)
+	if err != nil {
+		return "", err
+	}
+	existing, _, err := client.GetFileAtRef(ctx, repoOwner, repoName, p.path, p.base)
+	if err != nil {
+		return "", err
+	}
+	merged, err := p.merge(existing, changelogText, opts)
+	if err != nil {
+		return "", err
+	}
+
+	fromFile := "a/" + p.path
+	if existing == "" {
+		fromFile = "/dev/null"
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(existing),
+		B:        difflib.SplitLines(merged),
+		FromFile: fromFile,
+		ToFile:   "b/" + p.path,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute the diff of %s: %w", p.path, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Dry run: the following pull request would be opened against %s/%s\n\n", repoOwner, repoName))
+	sb.WriteString(fmt.Sprintf("Branch: %s (created in %s/%s from %s)\n", p.branch, p.headOwner, p.headRepo, p.base))
+	sb.WriteString(fmt.Sprintf("Head: %s\nBase: %s\nTitle: %s\n\n", p.head, p.base, p.title))
+	sb.WriteString("--- Body ---\n")
+	sb.WriteString(p.body)
+	if users, teams := splitReviewers(opts.Reviewers); len(users)+len(teams) > 0 {
+		var reviewers []string
+		for _, user := range users {
+			reviewers = append(reviewers, "@"+user)
+		}
+		for _, team := range teams {
+			reviewers = append(reviewers, fmt.Sprintf("@%s/%s", repoOwner, team))
+		}
+		sb.WriteString(fmt.Sprintf("\nReviewers: %s\n", strings.Join(reviewers, ", ")))
+	}
+	if opts.ReviewComment != "" {
+		sb.WriteString("\n--- Review comment ---\n")
+		sb.WriteString(opts.ReviewComment)
+	}
+	sb.WriteString(fmt.Sprintf("\n--- Commit %q ---\n", p.title))
+	sb.WriteString(diff)
+	return sb.String(), nil
+}
+
+// WebsiteOptions configures the pull request adding the release notes page to
+// the website repository
+type WebsiteOptions struct {
+	// Release is the release version (X.Y.Z)
+	Release string
+	// Owner and Repo are the website repository
+	Owner string
+	Repo  string
+	// Base is the branch of the website repository to open the pull request against
+	Base string
+	// Path is the path of the page in the website repository
+	Path string
+	// HeadOwner and HeadRepo are the repository the branch is pushed to,
+	// usually a fork (default: the website repository)
+	HeadOwner string
+	HeadRepo  string
+	// Branch is the name of the branch to create (default: release-notes-vX.Y.Z)
+	Branch string
+}
+
+// PublishWebsitePage commits the release notes page (see FormatHugoPage) to a
+// new branch and opens a pull request against the website repository
+func PublishWebsitePage(ctx context.Context, client types.PRPublisher, page string, opts WebsiteOptions) (*gogithub.PullRequest, error) {
+	headOwner, headRepo := opts.HeadOwner, opts.HeadRepo
+	if headOwner == "" {
+		headOwner, headRepo = opts.Owner, opts.Repo
+	}
+	branch := opts.Branch
+	if branch == "" {
+		branch = fmt.Sprintf("release-notes-v%s", opts.Release)
+	}
+
+	baseSHA, err := client.GetBranchSHA(ctx, opts.Owner, opts.Repo, opts.Base)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.CreateBranch(ctx, headOwner, headRepo, branch, baseSHA); err != nil {
+		return nil, err
+	}
+	log.Printf("Created branch %s in %s/%s from %s", branch, headOwner, headRepo, opts.Base)
+
+	// The page is replaced if it already exists, e.g. when publishing again after a fix
+	_, blobSHA, err := client.GetFileAtRef(ctx, headOwner, headRepo, opts.Path, branch)
+	if err != nil {
+		return nil, err
+	}
+	title := fmt.Sprintf("Add release notes for Antrea v%s", opts.Release)
+	if err := client.CommitFile(ctx, headOwner, headRepo, branch, opts.Path, title, []byte(page), blobSHA); err != nil {
+		return nil, err
+	}
+
+	head := branch
+	if headOwner != opts.Owner {
+		head = headOwner + ":" + branch
+	}
+	pr, err := client.CreatePullRequest(ctx, opts.Owner, opts.Repo, &gogithub.NewPullRequest{
+		Title: gogithub.Ptr(title),
+		Head:  gogithub.Ptr(head),
+		Base:  gogithub.Ptr(opts.Base),
+		Body:  gogithub.Ptr(fmt.Sprintf("Add the release notes page for Antrea v%s.\n\nGenerated with antrea-releaser %s.\n", opts.Release, ToolVersion())),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Created website pull request %s", pr.GetHTMLURL())
+	return pr, nil
+}
+
+// nearThresholdMargin is the include_score margin below the inclusion threshold
+// within which excluded entries are listed for review
+const nearThresholdMargin = 10
+
+// ReviewSummary renders a review comment for the CHANGELOG pull request,
+// summarizing coverage stats and the low-confidence entries which need human
+// judgment
+func (g *ChangelogGenerator) ReviewSummary(response *types.ModelResponse) string {
+	thresholds := g.effectiveThresholds()
+
+	var analyzed, included, optional, reused int
+	var lowConfidence, excluded, titleFallbacks []types.ChangeEntry
+	for _, change := range response.Changes {
+		analyzed += 1 + len(change.GroupedWith)
+		if change.IncludeScore < thresholds.Optional || !isKnownCategory(change.Category, g.categories) {
+			if change.IncludeScore >= thresholds.Optional-nearThresholdMargin && change.IncludeScore < thresholds.Optional {
+				excluded = append(excluded, change)
+			}
+			continue
+		}
+		included += 1 + len(change.GroupedWith)
+		if change.TitleFallback {
+			titleFallbacks = append(titleFallbacks, change)
+		}
+		if change.ReusedFromHistory {
+			reused++
+		}
+		if change.IncludeScore < thresholds.Include {
+			optional++
+			lowConfidence = append(lowConfidence, change)
+		}
+	}
+	considered := analyzed + len(g.skipped) - countSkippedAfterModel(g.skipped)
+
+	var sb strings.Builder
+	sb.WriteString("### Release notes review summary\n\n")
+	sb.WriteString("| | PRs |\n|---|---|\n")
+	sb.WriteString(fmt.Sprintf("| Considered | %d |\n", considered))
+	sb.WriteString(fmt.Sprintf("| Analyzed by the model | %d |\n", analyzed))
+	sb.WriteString(fmt.Sprintf("| Included | %d (%s) |\n", included, percent(included, analyzed)))
+	sb.WriteString(fmt.Sprintf("| Marked *OPTIONAL* | %d |\n", optional))
+	sb.WriteString(fmt.Sprintf("| Reused from history | %d |\n", reused))
+	sb.WriteString(fmt.Sprintf("| Skipped | %d |\n\n", len(g.skipped)))
+
+	if c := g.kubernetesChange; c != nil && !c.mentioned {
+		sb.WriteString(fmt.Sprintf("**The Kubernetes dependencies were upgraded from %s to %s, but no CHANGED entry mentions it. Please add one.**\n\n", c.from, c.to))
+	}
+
+	var unexplained []configDefaultChange
+	for _, c := range g.configDefaultChanges {
+		if !c.mentioned {
+			unexplained = append(unexplained, c)
+		}
+	}
+	if len(unexplained) > 0 {
+		sb.WriteString("#### Configuration defaults changed without a CHANGED entry (please add one, or confirm the change is not user-visible)\n\n")
+		for _, c := range unexplained {
+			sb.WriteString(fmt.Sprintf("- %s\n", c))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(g.patchFeatures) > 0 {
+		sb.WriteString("#### ADDED entries in a patch release (features should not land in patch releases, please check the cherry-picks)\n\n")
+		for _, f := range g.patchFeatures {
+			sb.WriteString(fmt.Sprintf("- #%d: %s\n", f.PRNumber, f.Description))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(g.guardrailViolations) > 0 {
+		sb.WriteString("#### Unexpected number of entries (please check the classification and the release scope)\n\n")
+		for _, v := range g.guardrailViolations {
+			sb.WriteString(fmt.Sprintf("- %s\n", v))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(g.duplicates) > 0 {
+		sb.WriteString("#### Possible duplicates of released changes (please check the PRs were not re-opened)\n\n")
+		for _, d := range g.duplicates {
+			sb.WriteString(fmt.Sprintf("- #%d: %s (similar to #%d in %s: %s)\n", d.Number, d.Description, d.HistoricalNumber, d.HistoricalRelease, d.HistoricalDescription))
+		}
+		sb.WriteString("\n")
+	}
+
+	writeEntries := func(title string, entries []types.ChangeEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].IncludeScore < entries[j].IncludeScore
+		})
+		sb.WriteString(fmt.Sprintf("#### %s\n\n", title))
+		for _, e := range entries {
+			sb.WriteString(fmt.Sprintf("- #%d (%s, include_score %d): %s\n", e.PRNumber, strings.ToUpper(e.Category), e.IncludeScore, e.Description))
+		}
+		sb.WriteString("\n")
+	}
+	writeEntries("Low-confidence entries (please confirm or remove)", lowConfidence)
+	writeEntries("Entries using the PR title, because of a very low description confidence (please rewrite)", titleFallbacks)
+	writeEntries("Entries just below the inclusion threshold (please check nothing important was dropped)", excluded)
+	if len(g.reviewRoutes) > 0 {
+		sb.WriteString("#### Review routing (entries by code owner)\n\n")
+		for _, owner := range ReviewOwners(g.reviewRoutes) {
+			var numbers []string
+			for _, route := range g.reviewRoutes {
+				if slices.Contains(route.Owners, owner) {
+					numbers = append(numbers, fmt.Sprintf("#%d", route.PRNumber))
+				}
+			}
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", owner, strings.Join(numbers, ", ")))
+		}
+		sb.WriteString("\n")
+	}
+	if len(lowConfidence) == 0 && len(excluded) == 0 && len(titleFallbacks) == 0 && len(g.duplicates) == 0 && len(g.guardrailViolations) == 0 && len(g.patchFeatures) == 0 && len(unexplained) == 0 {
+		sb.WriteString("No low-confidence entries.\n")
+	}
+	return sb.String()
+}
+
+// PRDescriptionSummary renders a compact table of the CHANGELOG stats for the
+// description of the CHANGELOG pull request, to give reviewers context at a
+// glance: the entries per category, the contributors, the coverage (the share
+// of the PRs analyzed by the model which have an entry) and the model cost
+func (g *ChangelogGenerator) PRDescriptionSummary(response *types.ModelResponse, details *types.ModelDetails) string {
+	thresholds := g.effectiveThresholds()
+	// Same selection as formatChangelog
+	byCategory, _ := capEntries(groupChanges(response, g.categories, thresholds), g.categories)
+
+	var analyzed, included int
+	for _, change := range response.Changes {
+		analyzed += 1 + len(change.GroupedWith)
+		if change.IncludeScore >= thresholds.Optional && isKnownCategory(change.Category, g.categories) {
+			included += 1 + len(change.GroupedWith)
+		}
+	}
+	contributors := make(map[string]bool)
+	var headers, values []string
+	for _, category := range g.categories {
+		headers = append(headers, category.Header)
+		values = append(values, fmt.Sprintf("%d", len(byCategory[category.Name])))
+		for _, change := range byCategory[category.Name] {
+			for _, author := range append([]string{change.Author}, change.GroupedAuthors...) {
+				if !isDeletedAuthor(author) {
+					contributors[author] = true
+				}
+			}
+		}
+	}
+	cost := "n/a"
+	if details != nil && details.EstimatedCostUSD > 0 {
+		cost = fmt.Sprintf("$%.4f", details.EstimatedCostUSD)
+	}
+	headers = append(headers, "Contributors", "Coverage", "Model cost")
+	values = append(values, fmt.Sprintf("%d", len(contributors)), fmt.Sprintf("%s (%d/%d PRs)", percent(included, analyzed), included, analyzed), cost)
+
+	var sb strings.Builder
+	sb.WriteString("| " + strings.Join(headers, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat("---|", len(headers)) + "\n")
+	sb.WriteString("| " + strings.Join(values, " | ") + " |\n")
+	return sb.String()
+}
+
+// countSkippedAfterModel returns the number of skipped PRs which were sent to the model
+func countSkippedAfterModel(skipped []types.SkippedPR) int {
+	var n int
+	for _, s := range skipped {
+		if s.Reason == types.SkipReasonLowIncludeScore || s.Reason == types.SkipReasonUnknownCategory {
+			n++
+		}
+	}
+	return n
+}
+
+func percent(n, total int) string {
+	if total == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.0f%%", float64(n)*100/float64(total))
+}
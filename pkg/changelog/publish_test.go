@@ -0,0 +1,175 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestPublishChangelog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	changelogText := "## 2.4.1 - 2025-10-01\n\n### Fixed\n\n- Fix crash. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n[@alice]: https://github.com/alice\n"
+	mockPublisher := mocks.NewMockPRPublisher(ctrl)
+	gomock.InOrder(
+		mockPublisher.EXPECT().GetBranchSHA(gomock.Any(), "antrea-io", "antrea", "release-2.4").Return("abc123", nil),
+		mockPublisher.EXPECT().CreateBranch(gomock.Any(), "alice", "antrea", "changelog-v2.4.1", "abc123").Return(nil),
+		mockPublisher.EXPECT().GetFileAtRef(gomock.Any(), "alice", "antrea", "CHANGELOG/CHANGELOG-2.4.md", "changelog-v2.4.1").
+			Return("# Changelog 2.4\n\n## 2.4.0 - 2025-09-01\n", "def456", nil),
+		mockPublisher.EXPECT().CommitFile(gomock.Any(), "alice", "antrea", "changelog-v2.4.1", "CHANGELOG/CHANGELOG-2.4.md",
+			"Add CHANGELOG for v2.4.1", gomock.Any(), "def456").
+			DoAndReturn(func(_ context.Context, _, _, _, _, _ string, content []byte, _ string) error {
+				assert.Contains(t, string(content), "## 2.4.1 - 2025-10-01")
+				assert.Contains(t, string(content), "## 2.4.0 - 2025-09-01 [YANKED]")
+				return nil
+			}),
+		mockPublisher.EXPECT().CreatePullRequest(gomock.Any(), "antrea-io", "antrea", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, pull *gogithub.NewPullRequest) (*gogithub.PullRequest, error) {
+				assert.Equal(t, "alice:changelog-v2.4.1", pull.GetHead())
+				assert.Equal(t, "release-2.4", pull.GetBase())
+				return &gogithub.PullRequest{Number: gogithub.Ptr(200)}, nil
+			}),
+		mockPublisher.EXPECT().CreateReviewComment(gomock.Any(), "antrea-io", "antrea", 200, "summary").Return(nil),
+		mockPublisher.EXPECT().RequestReviewers(gomock.Any(), "antrea-io", "antrea", 200, []string{"bob"}, []string{"network-maintainers"}).Return(nil),
+	)
+
+	pr, err := PublishChangelog(context.Background(), mockPublisher, changelogText, PublishOptions{
+		Release:        "2.4.1",
+		HeadOwner:      "alice",
+		HeadRepo:       "antrea",
+		LinkPlacement:  LinkPlacementAuto,
+		YankedReleases: []string{"2.4.0"},
+		ReviewComment:  "summary",
+		// Emails and teams of other organizations cannot be requested
+		Reviewers: []string{"@antrea-io/network-maintainers", "@bob", "carol@example.com", "@other-org/team"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, pr.GetNumber())
+}
+
+func TestPublishWebsitePage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	page := "---\ntitle: \"Antrea v2.5.0 Release Notes\"\n---\n\n## Fixed\n\n- Fix crash.\n"
+	mockPublisher := mocks.NewMockPRPublisher(ctrl)
+	gomock.InOrder(
+		mockPublisher.EXPECT().GetBranchSHA(gomock.Any(), "antrea-io", "website", "main").Return("abc123", nil),
+		mockPublisher.EXPECT().CreateBranch(gomock.Any(), "alice", "website", "release-notes-v2.5.0", "abc123").Return(nil),
+		mockPublisher.EXPECT().GetFileAtRef(gomock.Any(), "alice", "website", "content/releases/v2.5.0.md", "release-notes-v2.5.0").
+			Return("", "", nil),
+		mockPublisher.EXPECT().CommitFile(gomock.Any(), "alice", "website", "release-notes-v2.5.0", "content/releases/v2.5.0.md",
+			"Add release notes for Antrea v2.5.0", []byte(page), "").Return(nil),
+		mockPublisher.EXPECT().CreatePullRequest(gomock.Any(), "antrea-io", "website", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, pull *gogithub.NewPullRequest) (*gogithub.PullRequest, error) {
+				assert.Equal(t, "alice:release-notes-v2.5.0", pull.GetHead())
+				assert.Equal(t, "main", pull.GetBase())
+				return &gogithub.PullRequest{Number: gogithub.Ptr(300)}, nil
+			}),
+	)
+
+	pr, err := PublishWebsitePage(context.Background(), mockPublisher, page, WebsiteOptions{
+		Release:   "2.5.0",
+		Owner:     "antrea-io",
+		Repo:      "website",
+		Base:      "main",
+		Path:      "content/releases/v2.5.0.md",
+		HeadOwner: "alice",
+		HeadRepo:  "website",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 300, pr.GetNumber())
+}
+
+func TestReviewSummary(t *testing.T) {
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil)
+	generator.skipped = []types.SkippedPR{
+		{Number: 104, Reason: types.SkipReasonLowIncludeScore},
+		{Number: 105, Reason: types.SkipReasonExcludedLabel},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature", GroupedWith: []int{101}},
+			{PRNumber: 102, Category: "FIXED", IncludeScore: 30, Description: "Fix minor bug"},
+			{PRNumber: 103, Category: "CHANGED", IncludeScore: 20, Description: "Bump dependency"},
+			{PRNumber: 104, Category: "CHANGED", IncludeScore: 5, Description: "Refactor tests"},
+		},
+	}
+
+	summary := generator.ReviewSummary(response)
+	assert.Contains(t, summary, "| Considered | 6 |\n")
+	assert.Contains(t, summary, "| Analyzed by the model | 5 |\n")
+	assert.Contains(t, summary, "| Included | 3 (60%) |\n")
+	assert.Contains(t, summary, "| Marked *OPTIONAL* | 1 |\n")
+	assert.Contains(t, summary, "- #102 (FIXED, include_score 30): Fix minor bug\n")
+	assert.Contains(t, summary, "- #103 (CHANGED, include_score 20): Bump dependency\n")
+	assert.NotContains(t, summary, "#104")
+}
+
+func TestPRDescriptionSummary(t *testing.T) {
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil)
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature", Author: "alice", GroupedWith: []int{101}, GroupedAuthors: []string{"bob"}},
+			{PRNumber: 102, Category: "FIXED", IncludeScore: 80, Description: "Fix bug", Author: "alice"},
+			{PRNumber: 103, Category: "FIXED", IncludeScore: 70, Description: "Fix crash", Author: "ghost"},
+			{PRNumber: 104, Category: "CHANGED", IncludeScore: 5, Description: "Refactor tests", Author: "carol"},
+		},
+	}
+
+	summary := generator.PRDescriptionSummary(response, &types.ModelDetails{EstimatedCostUSD: 0.0123})
+	assert.Equal(t, "| Added | Changed | Fixed | Contributors | Coverage | Model cost |\n"+
+		"|---|---|---|---|---|---|\n"+
+		"| 1 | 0 | 2 | 2 | 80% (4/5 PRs) | $0.0123 |\n", summary)
+
+	summary = generator.PRDescriptionSummary(response, &types.ModelDetails{})
+	assert.Contains(t, summary, "| n/a |\n")
+}
+
+func TestPreviewChangelogPR(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	changelogText := "## 2.4.1 - 2025-10-01\n\n### Fixed\n\n- Fix crash. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n[@alice]: https://github.com/alice\n"
+	mockPublisher := mocks.NewMockPRPublisher(ctrl)
+	// Only reads are expected
+	mockPublisher.EXPECT().GetFileAtRef(gomock.Any(), "antrea-io", "antrea", "CHANGELOG/CHANGELOG-2.4.md", "release-2.4").
+		Return("# Changelog 2.4\n\n## 2.4.0 - 2025-09-01\n", "def456", nil)
+
+	preview, err := PreviewChangelogPR(context.Background(), mockPublisher, changelogText, PublishOptions{
+		Release:       "2.4.1",
+		HeadOwner:     "alice",
+		HeadRepo:      "antrea",
+		LinkPlacement: LinkPlacementAuto,
+		ReviewComment: "summary\n",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, preview, "Head: alice:changelog-v2.4.1\nBase: release-2.4\nTitle: Add CHANGELOG for v2.4.1\n")
+	assert.Contains(t, preview, "--- Review comment ---\nsummary\n")
+	assert.Contains(t, preview, "--- a/CHANGELOG/CHANGELOG-2.4.md\n+++ b/CHANGELOG/CHANGELOG-2.4.md\n")
+	assert.Contains(t, preview, "+## 2.4.1 - 2025-10-01\n")
+	assert.Contains(t, preview, "+- Fix crash.")
+	assert.Contains(t, preview, " ## 2.4.0 - 2025-09-01\n")
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// Weights of the quality score components, adding up to 100
+const (
+	coverageWeight        = 40
+	confidenceWeight      = 30
+	lintWeight            = 15
+	reuseComplianceWeight = 15
+)
+
+// lintDescriptionLength is the maximum description length checked by the
+// quality score when max_description_length is not set
+const lintDescriptionLength = 200
+
+// computeQualityScore scores the included entries of the model response.
+// Descriptions are checked against maxLength, or against lintDescriptionLength
+// if it is 0.
+func computeQualityScore(response *types.ModelResponse, prs []types.PRInfo, prCache map[int]types.HistoricalPR, categories []config.Category, thresholds config.Thresholds, maxLength int) types.QualityScore {
+	if maxLength <= 0 {
+		maxLength = lintDescriptionLength
+	}
+
+	included := make(map[int]bool)
+	var entries, totalScore, lintViolations, historical, reused int
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || !isKnownCategory(change.Category, categories) {
+			continue
+		}
+		entries++
+		totalScore += change.IncludeScore
+		if descriptionViolation(change.Description, maxLength) != "" {
+			lintViolations++
+		}
+		inHistory := false
+		for _, number := range append([]int{change.PRNumber}, change.GroupedWith...) {
+			included[number] = true
+			if _, ok := prCache[number]; ok {
+				inHistory = true
+			}
+		}
+		if inHistory {
+			historical++
+			if change.ReusedFromHistory {
+				reused++
+			}
+		}
+	}
+
+	var labeled, covered int
+	for _, pr := range prs {
+		if !slices.Contains(pr.Labels, releaseNoteLabel) {
+			continue
+		}
+		labeled++
+		if included[pr.Number] {
+			covered++
+		}
+	}
+
+	q := types.QualityScore{
+		Coverage:        ratio(covered, labeled),
+		Confidence:      ratio(totalScore, entries*100),
+		LintViolations:  lintViolations,
+		ReuseCompliance: ratio(reused, historical),
+	}
+	score := coverageWeight*q.Coverage +
+		confidenceWeight*q.Confidence +
+		lintWeight*ratio(entries-lintViolations, entries) +
+		reuseComplianceWeight*q.ReuseCompliance
+	q.Score = int(math.Round(score))
+	return q
+}
+
+// ratio returns n/total, or 1 if total is 0
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 1
+	}
+	return float64(n) / float64(total)
+}
+
+// FormatQualityScore renders the quality score as a single line
+func FormatQualityScore(q types.QualityScore) string {
+	return fmt.Sprintf("%d/100 (coverage: %.0f%%, confidence: %.0f%%, lint violations: %d, reuse compliance: %.0f%%)",
+		q.Score, q.Coverage*100, q.Confidence*100, q.LintViolations, q.ReuseCompliance*100)
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestComputeQualityScore(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, Labels: []string{"action/release-note"}},
+		{Number: 101, Labels: []string{"action/release-note"}},
+		{Number: 102, Labels: []string{"action/release-note"}},
+		{Number: 103, Labels: []string{"action/release-note"}},
+		{Number: 104},
+	}
+	prCache := map[int]types.HistoricalPR{
+		100: {Description: "Add feature", Category: "ADDED"},
+		102: {Description: "Fix a crash", Category: "FIXED"},
+	}
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature", ReusedFromHistory: true, GroupedWith: []int{101}},
+			{PRNumber: 102, Category: "FIXED", IncludeScore: 70, Description: "Fix a crash. Also refactor the agent"},
+			{PRNumber: 103, Category: "CHANGED", IncludeScore: 10, Description: "Bump dependency"},
+			{PRNumber: 104, Category: "FIXED", IncludeScore: 80, Description: "Fix typo"},
+		},
+	}
+
+	q := computeQualityScore(response, prs, prCache, config.DefaultCategories(), config.DefaultThresholds(), 0)
+	assert.Equal(t, 0.75, q.Coverage)
+	assert.Equal(t, 0.8, q.Confidence)
+	assert.Equal(t, 1, q.LintViolations)
+	assert.Equal(t, 0.5, q.ReuseCompliance)
+	// 40*0.75 + 30*0.8 + 15*(2/3) + 15*0.5
+	assert.Equal(t, 72, q.Score)
+	assert.Equal(t, "72/100 (coverage: 75%, confidence: 80%, lint violations: 1, reuse compliance: 50%)", FormatQualityScore(q))
+}
+
+func TestComputeQualityScore_Empty(t *testing.T) {
+	q := computeQualityScore(&types.ModelResponse{}, nil, nil, config.DefaultCategories(), config.DefaultThresholds(), 0)
+	assert.Equal(t, 100, q.Score)
+}
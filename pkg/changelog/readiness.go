@@ -0,0 +1,162 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// Penalties of the readiness checks of a PR description, out of 100
+const (
+	emptyBodyPenalty     = 50
+	shortBodyPenalty     = 25
+	noReleaseNotePenalty = 30
+	vagueTitlePenalty    = 20
+)
+
+// minBodyLength is the length under which a PR description is too short to
+// explain a change, HTML comments (e.g. of the PR template) excluded
+const minBodyLength = 80
+
+var (
+	htmlCommentRegex = regexp.MustCompile(`(?s)<!--.*?-->`)
+	// releaseNoteFenceRegex matches a ```release-note block
+	releaseNoteFenceRegex = regexp.MustCompile("(?s)```release-notes?[ \\t]*\\n(.*?)```")
+	// releaseNoteHeadingRegex matches a "Release note" heading or label, with
+	// the note on the same line if any
+	releaseNoteHeadingRegex = regexp.MustCompile(`(?i)^(?:#{1,6}\s*)?\**release[- ]notes?\**\s*:?\s*\**\s*(.*)$`)
+	// vagueTitleRegex matches titles which do not say what changed
+	vagueTitleRegex = regexp.MustCompile(`(?i)^(?:wip\b.*|(?:minor |small |some )?(?:fix(?:es)?|updates?|updated|changes?|bump|cleanup|clean up|refactor|improvements?|tweaks?|misc)(?:\s+(?:a |the |some )?(?:bugs?|issues?|code|tests?|stuff|things|typos?|it|this))?)\.?$`)
+)
+
+// PRReadiness scores the descriptions of the PRs of the release for
+// release-note readiness, without calling the model, so that contributors can
+// improve them before the CHANGELOG is generated
+func (g *ChangelogGenerator) PRReadiness(ctx context.Context) ([]types.PRReadiness, error) {
+	fetchCtx, cancel := stageContext(ctx, g.timeouts.Fetch)
+	defer cancel()
+	inputs, err := g.fetchInputs(fetchCtx)
+	if err != nil {
+		return nil, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+	}
+	return assessPRReadiness(inputs.prs), nil
+}
+
+// assessPRReadiness scores each PR, the least ready first
+func assessPRReadiness(prs []types.PRInfo) []types.PRReadiness {
+	readiness := make([]types.PRReadiness, 0, len(prs))
+	for _, pr := range prs {
+		r := types.PRReadiness{Number: pr.Number, Title: pr.Title, Author: pr.Author, Score: 100}
+		penalize := func(penalty int, issue string) {
+			r.Score -= penalty
+			r.Issues = append(r.Issues, issue)
+		}
+		body := strings.TrimSpace(htmlCommentRegex.ReplaceAllString(pr.Body, ""))
+		switch {
+		case body == "":
+			penalize(emptyBodyPenalty, "empty description")
+		case len(body) < minBodyLength:
+			penalize(shortBodyPenalty, "very short description")
+		}
+		// Only the PRs labeled for the CHANGELOG need a release note
+		if slices.Contains(pr.Labels, releaseNoteLabel) && !hasReleaseNote(body) {
+			penalize(noReleaseNotePenalty, "no release-note block")
+		}
+		if isVagueTitle(pr.Title) {
+			penalize(vagueTitlePenalty, "vague title")
+		}
+		r.Score = max(r.Score, 0)
+		readiness = append(readiness, r)
+	}
+	sort.SliceStable(readiness, func(i, j int) bool {
+		if readiness[i].Score != readiness[j].Score {
+			return readiness[i].Score < readiness[j].Score
+		}
+		return readiness[i].Number < readiness[j].Number
+	})
+	return readiness
+}
+
+// hasReleaseNote returns true if a PR description has a release note which is
+// not NONE: a ```release-note block, or the text of a "Release note" heading
+func hasReleaseNote(body string) bool {
+	isNote := func(s string) bool {
+		s = strings.TrimSpace(s)
+		return s != "" && !strings.EqualFold(s, "none") && !strings.EqualFold(s, "n/a")
+	}
+	if m := releaseNoteFenceRegex.FindStringSubmatch(body); m != nil {
+		return isNote(m[1])
+	}
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		m := releaseNoteHeadingRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		if strings.TrimSpace(m[1]) != "" {
+			return isNote(m[1])
+		}
+		// The note is the next paragraph
+		for _, next := range lines[i+1:] {
+			if next = strings.TrimSpace(next); next != "" {
+				return !strings.HasPrefix(next, "#") && isNote(next)
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// isVagueTitle returns true for PR titles which do not say what changed,
+// e.g. "Fix bug" or "Update"
+func isVagueTitle(title string) bool {
+	title = strings.TrimSpace(title)
+	return len(strings.Fields(title)) < 3 || vagueTitleRegex.MatchString(title)
+}
+
+// FormatPRReadinessReport renders the release-note readiness of the PRs of a
+// release as a markdown report, to be shared with the contributors
+func FormatPRReadinessReport(release string, readiness []types.PRReadiness) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Release-note readiness of the PRs of the %s CHANGELOG\n\n", release))
+	var notReady []types.PRReadiness
+	for _, r := range readiness {
+		if len(r.Issues) > 0 {
+			notReady = append(notReady, r)
+		}
+	}
+	if len(notReady) == 0 {
+		sb.WriteString(fmt.Sprintf("The descriptions of all %d PRs are ready for the release notes.\n", len(readiness)))
+		return sb.String()
+	}
+	sb.WriteString(fmt.Sprintf("%d of %d PRs are ready for the release notes. ", len(readiness)-len(notReady), len(readiness)))
+	sb.WriteString("The CHANGELOG entries are drafted from the PR titles and descriptions, ")
+	sb.WriteString("please improve the PRs below so that the release notes do not have to guess: ")
+	sb.WriteString("describe the user-facing change in a `release-note` block, and use a title saying what changed.\n\n")
+	sb.WriteString("| PR | Author | Score | Issues |\n")
+	sb.WriteString("|----|--------|-------|--------|\n")
+	for _, r := range notReady {
+		sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) %s | @%s | %d/100 | %s |\n",
+			r.Number, repoOwner, repoName, r.Number, escapeTableCell(r.Title), r.Author, r.Score, strings.Join(r.Issues, ", ")))
+	}
+	return sb.String()
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestHasReleaseNote(t *testing.T) {
+	tests := map[string]bool{
+		"":           false,
+		"Fixes #123": false,
+		"```release-note\nAdd the --foo flag.\n```": true,
+		"```release-note\nNONE\n```":                false,
+		"## Release Note\n\nAdd the --foo flag.":    true,
+		"## Release Note\n\n## Testing":             false,
+		"**Release note**: Add the --foo flag.":     true,
+		"Release note: N/A":                         false,
+	}
+	for body, expected := range tests {
+		assert.Equal(t, expected, hasReleaseNote(body), body)
+	}
+}
+
+func TestIsVagueTitle(t *testing.T) {
+	for _, title := range []string{"Fix bug", "Update", "Minor fixes", "Fix the issue", "WIP: Egress", "Some improvements."} {
+		assert.True(t, isVagueTitle(title), title)
+	}
+	for _, title := range []string{"Fix crash of the agent on Egress IP removal", "Bump Go to 1.25", "Add the --foo flag"} {
+		assert.False(t, isVagueTitle(title), title)
+	}
+}
+
+func TestAssessPRReadiness(t *testing.T) {
+	longBody := "This PR fixes the crash of the agent which happened when the Egress IP was removed while the Egress was being reconciled.\n\n"
+	readiness := assessPRReadiness([]types.PRInfo{
+		{Number: 1, Title: "Fix crash of the agent on Egress IP removal", Author: "alice", Labels: []string{"action/release-note"},
+			Body: longBody + "```release-note\nFix crash of the agent on Egress IP removal.\n```"},
+		{Number: 2, Title: "Fix bug", Author: "bob", Labels: []string{"action/release-note"}, Body: "<!-- Describe your change -->\n"},
+		{Number: 3, Title: "Refactor the Egress controller", Author: "carol", Body: "Split the controller."},
+		{Number: 4, Title: "Add the --foo flag to antctl", Author: "dave", Labels: []string{"action/release-note"}, Body: longBody},
+	})
+	assert.Equal(t, []types.PRReadiness{
+		{Number: 2, Title: "Fix bug", Author: "bob", Score: 0, Issues: []string{"empty description", "no release-note block", "vague title"}},
+		{Number: 4, Title: "Add the --foo flag to antctl", Author: "dave", Score: 70, Issues: []string{"no release-note block"}},
+		// PRs without the action/release-note label need no release note
+		{Number: 3, Title: "Refactor the Egress controller", Author: "carol", Score: 75, Issues: []string{"very short description"}},
+		{Number: 1, Title: "Fix crash of the agent on Egress IP removal", Author: "alice", Score: 100},
+	}, readiness)
+}
+
+func TestFormatPRReadinessReport(t *testing.T) {
+	report := FormatPRReadinessReport("2.5.0", []types.PRReadiness{
+		{Number: 2, Title: "Fix bug | agent", Author: "bob", Score: 0, Issues: []string{"empty description", "vague title"}},
+		{Number: 1, Title: "Fix crash of the agent", Author: "alice", Score: 100},
+	})
+	assert.Equal(t, "# Release-note readiness of the PRs of the 2.5.0 CHANGELOG\n\n"+
+		"1 of 2 PRs are ready for the release notes. The CHANGELOG entries are drafted from the PR titles and descriptions, "+
+		"please improve the PRs below so that the release notes do not have to guess: "+
+		"describe the user-facing change in a `release-note` block, and use a title saying what changed.\n\n"+
+		"| PR | Author | Score | Issues |\n"+
+		"|----|--------|-------|--------|\n"+
+		"| [#2](https://github.com/antrea-io/antrea/pull/2) Fix bug \\| agent | @bob | 0/100 | empty description, vague title |\n", report)
+
+	assert.Equal(t, "# Release-note readiness of the PRs of the 2.5.0 CHANGELOG\n\nThe descriptions of all 1 PRs are ready for the release notes.\n",
+		FormatPRReadinessReport("2.5.0", []types.PRReadiness{{Number: 1, Title: "Fix crash of the agent", Author: "alice", Score: 100}}))
+}
+
+func TestPRReadiness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The model is not called
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	setupMinorReleaseGitHubExpectations(t, mockGitHubClient)
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, mockGitHubClient)
+	readiness, err := generator.PRReadiness(context.Background())
+	require.NoError(t, err)
+	require.Len(t, readiness, 2)
+	assert.Equal(t, 1234, readiness[0].Number)
+	assert.Equal(t, []string{"very short description", "no release-note block"}, readiness[0].Issues)
+	assert.Equal(t, 5678, readiness[1].Number)
+}
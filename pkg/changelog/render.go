@@ -0,0 +1,222 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// OutputFormat is the format of the generated changelog output
+type OutputFormat string
+
+const (
+	// OutputFormatMarkdown is the CHANGELOG-X.Y.md release section
+	OutputFormatMarkdown OutputFormat = "markdown"
+	// OutputFormatHugo is a page with Hugo front matter for the antrea.io website
+	OutputFormatHugo OutputFormat = "hugo"
+	// OutputFormatEmail is a plain-text rendering for the announce mailing list
+	OutputFormatEmail OutputFormat = "email"
+)
+
+// emailWidth is the column at which the email format is wrapped
+const emailWidth = 72
+
+// ParseOutputFormat parses an output format name
+func ParseOutputFormat(s string) (OutputFormat, error) {
+	switch f := OutputFormat(s); f {
+	case OutputFormatMarkdown, OutputFormatHugo, OutputFormatEmail:
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid output format %q, must be one of: markdown, hugo, email", s)
+}
+
+// releaseHeaderRegex extracts the version and date of a release header: ## X.Y.Z - YYYY-MM-DD
+var releaseHeaderRegex = regexp.MustCompile(`(?m)^##\s+(\S+)(?:\s+-\s+(\S+))?\s*$`)
+
+var (
+	// inlineLinkRegex matches markdown inline links: [text](url)
+	inlineLinkRegex = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	// linkDefRegex matches markdown link reference definitions: [label]: url
+	linkDefRegex = regexp.MustCompile(`^\[([^\]]+)\]:\s*(\S+)\s*$`)
+	// linkRefRegex matches markdown reference links: [label]
+	linkRefRegex = regexp.MustCompile(`\[([^\]]+)\]`)
+)
+
+// RenderOutput renders a generated release section in the given format
+func RenderOutput(format OutputFormat, changelogText string) (string, error) {
+	switch format {
+	case OutputFormatHugo:
+		return FormatHugoPage(changelogText)
+	case OutputFormatEmail:
+		return FormatEmail(changelogText), nil
+	default:
+		return changelogText, nil
+	}
+}
+
+// FormatHugoPage renders a generated release section as a Hugo page for the
+// antrea.io website. The release header is replaced with front matter, and
+// the other headings are promoted by one level. Pages are weighted so that
+// newer releases are listed first.
+func FormatHugoPage(changelogText string) (string, error) {
+	changelogText = stripFrontMatter(changelogText)
+	m := releaseHeaderRegex.FindStringSubmatchIndex(changelogText)
+	if m == nil {
+		return "", fmt.Errorf("no release header found in changelog")
+	}
+	versionStr := changelogText[m[2]:m[3]]
+	ver, err := version.Parse(versionStr)
+	if err != nil {
+		return "", fmt.Errorf("a released version is required for a Hugo page: %w", err)
+	}
+	date := ""
+	if m[4] >= 0 {
+		date = changelogText[m[4]:m[5]]
+	}
+	weight := 1_000_000 - int(ver.Major()*10_000+ver.Minor()*100+ver.Patch())
+
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	sb.WriteString(fmt.Sprintf("title: \"Antrea v%s Release Notes\"\n", versionStr))
+	if date != "" {
+		sb.WriteString(fmt.Sprintf("date: %s\n", date))
+	}
+	sb.WriteString(fmt.Sprintf("version: \"v%s\"\n", versionStr))
+	sb.WriteString(fmt.Sprintf("weight: %d\n", weight))
+	sb.WriteString("---\n")
+
+	for _, line := range strings.Split(strings.TrimLeft(changelogText[m[1]:], "\n"), "\n") {
+		if strings.HasPrefix(line, "###") {
+			line = line[1:]
+		}
+		sb.WriteString("\n")
+		sb.WriteString(line)
+	}
+	return strings.TrimRight(sb.String(), "\n") + "\n", nil
+}
+
+// FormatEmail renders a generated release section as plain text for the
+// announce mailing list. Headings are underlined, paragraphs and list items
+// are wrapped at 72 columns, and links are replaced with numbered footnotes
+// listed at the end.
+func FormatEmail(changelogText string) string {
+	lines := strings.Split(strings.TrimRight(stripFrontMatter(changelogText), "\n"), "\n")
+
+	// Reference definitions are resolved in place and not rendered
+	defs := make(map[string]string)
+	var body []string
+	for _, line := range lines {
+		if m := linkDefRegex.FindStringSubmatch(line); m != nil {
+			defs[m[1]] = m[2]
+			continue
+		}
+		body = append(body, line)
+	}
+
+	var urls []string
+	footnotes := make(map[string]int)
+	footnote := func(text, url string) string {
+		n, ok := footnotes[url]
+		if !ok {
+			urls = append(urls, url)
+			n = len(urls)
+			footnotes[url] = n
+		}
+		return fmt.Sprintf("%s [%d]", text, n)
+	}
+	replaceLinks := func(line string) string {
+		line = inlineLinkRegex.ReplaceAllStringFunc(line, func(s string) string {
+			m := inlineLinkRegex.FindStringSubmatch(s)
+			return footnote(m[1], m[2])
+		})
+		return linkRefRegex.ReplaceAllStringFunc(line, func(s string) string {
+			label := linkRefRegex.FindStringSubmatch(s)[1]
+			if url, ok := defs[label]; ok {
+				return footnote(label, url)
+			}
+			return s
+		})
+	}
+
+	var sb strings.Builder
+	for i, line := range body {
+		// Entry anchors are only meaningful in HTML
+		line = entryAnchorRegex.ReplaceAllString(line, "")
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "## "):
+			title := strings.TrimSpace(strings.TrimPrefix(trimmed, "## "))
+			if m := releaseHeaderRegex.FindStringSubmatch(trimmed); m != nil && m[1] != UnreleasedRelease {
+				title = "Antrea v" + strings.TrimPrefix(title, "v")
+			}
+			sb.WriteString(title + "\n" + strings.Repeat("=", len(title)) + "\n")
+		case strings.HasPrefix(trimmed, "### "):
+			title := strings.TrimSpace(strings.TrimPrefix(trimmed, "### "))
+			sb.WriteString(title + "\n" + strings.Repeat("-", len(title)) + "\n")
+		case strings.HasPrefix(trimmed, "#### "):
+			title := strings.TrimSpace(strings.TrimPrefix(trimmed, "#### "))
+			sb.WriteString(title + "\n" + strings.Repeat("~", len(title)) + "\n")
+		case trimmed == "":
+			// Consecutive blank lines, e.g. left by the removed definitions, are collapsed
+			if i > 0 && strings.TrimSpace(body[i-1]) != "" {
+				sb.WriteString("\n")
+			}
+		default:
+			indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+			hanging := indent
+			if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
+				trimmed = "- " + strings.TrimSpace(trimmed[2:])
+				hanging = indent + "  "
+			}
+			sb.WriteString(wrapText(replaceLinks(trimmed), indent, hanging, emailWidth))
+		}
+	}
+
+	email := strings.TrimRight(sb.String(), "\n") + "\n"
+	if len(urls) > 0 {
+		email += "\n"
+		for i, url := range urls {
+			email += fmt.Sprintf("[%d] %s\n", i+1, url)
+		}
+	}
+	return email
+}
+
+// wrapText wraps text at width columns, prefixing the first line with indent
+// and the following lines with hanging. Words longer than a line are not
+// broken.
+func wrapText(text, indent, hanging string, width int) string {
+	var sb strings.Builder
+	line := indent
+	empty := true
+	for _, word := range strings.Fields(text) {
+		if !empty && len(line)+1+len(word) > width {
+			sb.WriteString(line + "\n")
+			line = hanging
+			empty = true
+		}
+		if !empty {
+			line += " "
+		}
+		line += word
+		empty = false
+	}
+	sb.WriteString(line + "\n")
+	return sb.String()
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatHugoPage(t *testing.T) {
+	changelogText := `## 2.5.0 - 2025-01-30
+
+### Added
+
+- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+### Fixed
+
+- Fix crash. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+`
+	expected := `---
+title: "Antrea v2.5.0 Release Notes"
+date: 2025-01-30
+version: "v2.5.0"
+weight: 979500
+---
+
+## Added
+
+- Add feature. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+## Fixed
+
+- Fix crash. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+`
+	page, err := RenderOutput(OutputFormatHugo, changelogText)
+	require.NoError(t, err)
+	assert.Equal(t, expected, page)
+	require.NoError(t, Validate(page[len("---\n"):]), "The page body should be valid markdown")
+
+	// Newer releases have a lower weight, to be listed first
+	newer, err := FormatHugoPage("## 2.5.1 - 2025-02-15\n\n### Fixed\n\n- Fix crash.\n")
+	require.NoError(t, err)
+	assert.Contains(t, newer, "weight: 979499\n")
+
+	_, err = FormatHugoPage("## Unreleased\n\n### Fixed\n\n- Fix crash.\n")
+	assert.ErrorContains(t, err, "a released version is required")
+
+	markdown, err := RenderOutput(OutputFormatMarkdown, changelogText)
+	require.NoError(t, err)
+	assert.Equal(t, changelogText, markdown)
+}
+
+func TestFormatEmail(t *testing.T) {
+	changelogText := `## 2.5.0 - 2025-01-30
+
+### Added
+
+- Add support for configuring the MTU of the tunnel interface through the Antrea ConfigMap, which was previously hard-coded. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+### Fixed
+
+- Fix crash. ([#101](https://github.com/antrea-io/antrea/pull/101), [@alice])
+
+[@alice]: https://github.com/alice
+`
+	expected := `Antrea v2.5.0 - 2025-01-30
+==========================
+
+Added
+-----
+
+- Add support for configuring the MTU of the tunnel interface through
+  the Antrea ConfigMap, which was previously hard-coded. (#100 [1],
+  @alice [2])
+
+Fixed
+-----
+
+- Fix crash. (#101 [3], @alice [2])
+
+[1] https://github.com/antrea-io/antrea/pull/100
+[2] https://github.com/alice
+[3] https://github.com/antrea-io/antrea/pull/101
+`
+	email, err := RenderOutput(OutputFormatEmail, changelogText)
+	require.NoError(t, err)
+	assert.Equal(t, expected, email)
+	for _, line := range strings.Split(email, "\n") {
+		assert.LessOrEqual(t, len(line), emailWidth)
+	}
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	for _, name := range []string{"markdown", "hugo", "email"} {
+		format, err := ParseOutputFormat(name)
+		require.NoError(t, err)
+		assert.Equal(t, OutputFormat(name), format)
+	}
+	_, err := ParseOutputFormat("html")
+	assert.ErrorContains(t, err, "invalid output format")
+}
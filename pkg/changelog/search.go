@@ -0,0 +1,238 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// SearchQuery selects the entries of the historical CHANGELOGs. All the set
+// criteria must match.
+type SearchQuery struct {
+	// PRNumber matches the entries linking the PR
+	PRNumber int
+	// Author matches the entries of an author (GitHub login, case-insensitive)
+	Author string
+	// Keywords match the entries whose description contains all of them
+	// (case-insensitive)
+	Keywords []string
+}
+
+// ParseSearchQuery parses search terms: #123 (or 123) for a PR, @login for an
+// author, and keywords otherwise
+func ParseSearchQuery(terms []string) (SearchQuery, error) {
+	var query SearchQuery
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		switch {
+		case term == "":
+			continue
+		case strings.HasPrefix(term, "@"):
+			if query.Author != "" {
+				return SearchQuery{}, fmt.Errorf("only one author can be searched, got @%s and %s", query.Author, term)
+			}
+			query.Author = strings.TrimPrefix(term, "@")
+		default:
+			if number, err := strconv.Atoi(strings.TrimPrefix(term, "#")); err == nil && number > 0 {
+				if query.PRNumber != 0 {
+					return SearchQuery{}, fmt.Errorf("only one PR can be searched, got #%d and %s", query.PRNumber, term)
+				}
+				query.PRNumber = number
+				continue
+			}
+			query.Keywords = append(query.Keywords, term)
+		}
+	}
+	if query.PRNumber == 0 && query.Author == "" && len(query.Keywords) == 0 {
+		return SearchQuery{}, fmt.Errorf("empty search, expected a PR number, an @author or keywords")
+	}
+	return query, nil
+}
+
+// String returns the search terms of the query
+func (q SearchQuery) String() string {
+	var terms []string
+	if q.PRNumber != 0 {
+		terms = append(terms, fmt.Sprintf("#%d", q.PRNumber))
+	}
+	if q.Author != "" {
+		terms = append(terms, "@"+q.Author)
+	}
+	for _, keyword := range q.Keywords {
+		terms = append(terms, strconv.Quote(keyword))
+	}
+	return strings.Join(terms, " ")
+}
+
+// matches returns true if the entry matches all the criteria of the query
+func (q SearchQuery) matches(m types.SearchMatch) bool {
+	if q.PRNumber != 0 && !slices.Contains(m.PRNumbers, q.PRNumber) {
+		return false
+	}
+	if q.Author != "" && !slices.ContainsFunc(m.Authors, func(author string) bool { return strings.EqualFold(author, q.Author) }) {
+		return false
+	}
+	description := strings.ToLower(m.Description)
+	for _, keyword := range q.Keywords {
+		if !strings.Contains(description, strings.ToLower(keyword)) {
+			return false
+		}
+	}
+	return true
+}
+
+// FetchCHANGELOGs returns the content of the CHANGELOG-X.Y.md files of the
+// CHANGELOG directory of antrea-io/antrea, by file name
+func FetchCHANGELOGs(ctx context.Context, githubClient types.GitHubClient) (map[string]string, error) {
+	dirContent, err := githubClient.GetDirectoryContents(ctx, repoOwner, repoName, "CHANGELOG")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CHANGELOG directory: %w", err)
+	}
+	files := make(map[string]string)
+	for _, file := range dirContent {
+		name := file.GetName()
+		if !IsCHANGELOGFile(name) {
+			continue
+		}
+		content, err := githubClient.GetFileContent(ctx, repoOwner, repoName, "CHANGELOG/"+name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", name, err)
+		}
+		files[name] = content
+	}
+	return files, nil
+}
+
+// ReadCHANGELOGs returns the content of the CHANGELOG-X.Y.md files of a local
+// CHANGELOG directory (e.g. of an antrea checkout), by file name
+func ReadCHANGELOGs(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CHANGELOG directory: %w", err)
+	}
+	files := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() || !IsCHANGELOGFile(entry.Name()) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+		files[entry.Name()] = string(data)
+	}
+	return files, nil
+}
+
+// IsCHANGELOGFile returns true for the names of the CHANGELOG files of minor
+// releases: CHANGELOG-X.Y.md
+func IsCHANGELOGFile(name string) bool {
+	minor, ok := strings.CutPrefix(name, "CHANGELOG-")
+	if !ok {
+		return false
+	}
+	minor, ok = strings.CutSuffix(minor, ".md")
+	if !ok {
+		return false
+	}
+	_, err := version.Parse(minor + ".0")
+	return err == nil
+}
+
+// SearchCHANGELOGs returns the entries of the CHANGELOGs (by file name)
+// matching the query, most recent release first. A PR listed in several
+// releases (e.g. a fix backported to patch releases of several minors) has a
+// match per release.
+func SearchCHANGELOGs(files map[string]string, categories []config.Category, query SearchQuery) []types.SearchMatch {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var matches []types.SearchMatch
+	for _, name := range names {
+		forEachCHANGELOGEntry(files[name], categories, func(release, category, line string) {
+			m := types.SearchMatch{File: name, Release: release, Category: category}
+			for _, pr := range entryPRRegex.FindAllStringSubmatch(line, -1) {
+				number, _ := strconv.Atoi(pr[1])
+				m.PRNumbers = append(m.PRNumbers, number)
+			}
+			if len(m.PRNumbers) == 0 {
+				return
+			}
+			for _, author := range authorRefRegex.FindAllStringSubmatch(line, -1) {
+				m.Authors = append(m.Authors, author[1])
+			}
+			if descEnd := strings.Index(line, "([#"); descEnd > 0 {
+				m.Description = entryDescription(line[:descEnd])
+			}
+			if query.matches(m) {
+				matches = append(matches, m)
+			}
+		})
+	}
+
+	// Releases which are not versions (e.g. Unreleased) come first
+	sort.SliceStable(matches, func(i, j int) bool {
+		vi, erri := version.Parse(matches[i].Release)
+		vj, errj := version.Parse(matches[j].Release)
+		if erri != nil || errj != nil {
+			return erri != nil && errj == nil
+		}
+		return vi.GreaterThan(vj)
+	})
+	return matches
+}
+
+// FormatSearchResults renders the matches of a search as a markdown report,
+// the PRs and authors being linked with the link templates
+func FormatSearchResults(query SearchQuery, matches []types.SearchMatch, links config.LinkTemplates) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# CHANGELOG search: %s\n\n", query))
+	if len(matches) == 0 {
+		sb.WriteString("No entry of the historical CHANGELOGs matches the search.\n")
+		return sb.String()
+	}
+	var releases []string
+	for _, m := range matches {
+		if !slices.Contains(releases, m.Release) {
+			releases = append(releases, m.Release)
+		}
+	}
+	sb.WriteString(fmt.Sprintf("Found in %s.\n\n", strings.Join(releases, ", ")))
+	for _, m := range matches {
+		refs := make([]string, 0, len(m.PRNumbers)+len(m.Authors))
+		for _, number := range m.PRNumbers {
+			refs = append(refs, fmt.Sprintf("[#%d](%s)", number, links.PRURL(number)))
+		}
+		for _, author := range m.Authors {
+			refs = append(refs, fmt.Sprintf("[@%s](%s)", author, links.AuthorURL(author)))
+		}
+		sb.WriteString(fmt.Sprintf("- **%s** (%s, %s): %s. (%s)\n", m.Release, m.Category, m.File, m.Description, strings.Join(refs, ", ")))
+	}
+	return sb.String()
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+var searchChangelogs = map[string]string{
+	"CHANGELOG-2.3.md": `# Changelog 2.3
+
+## 2.3.2 - 2025-03-03
+
+### Fixed
+
+- Fix crash of the agent when the Egress IP is removed. ([#7010](https://github.com/antrea-io/antrea/pull/7010), [@alice])
+
+## 2.3.0 - 2025-01-10
+
+### Added
+
+- Add Egress bandwidth limiting. ([#6800](https://github.com/antrea-io/antrea/pull/6800) [#6850](https://github.com/antrea-io/antrea/pull/6850), [@Bob])
+
+### Known Issues
+
+- Egress IPs may be lost on restart. ([#7010](https://github.com/antrea-io/antrea/pull/7010), [@alice])
+
+[@alice]: https://github.com/alice
+[@Bob]: https://github.com/Bob
+`,
+	"CHANGELOG-2.4.md": `# Changelog 2.4
+
+## 2.4.1 - 2025-03-01
+
+### Fixed
+
+- *OPTIONAL* Fix crash of the agent when the Egress IP is removed. ([#7010](https://github.com/antrea-io/antrea/pull/7010), [@alice](https://github.com/alice))
+- Fix the FQDN policy cache. ([#7020](https://github.com/antrea-io/antrea/pull/7020), [@bob](https://github.com/bob))
+`,
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	query, err := ParseSearchQuery([]string{"#7010", "@alice", "egress", "IP"})
+	require.NoError(t, err)
+	assert.Equal(t, SearchQuery{PRNumber: 7010, Author: "alice", Keywords: []string{"egress", "IP"}}, query)
+	assert.Equal(t, `#7010 @alice "egress" "IP"`, query.String())
+
+	query, err = ParseSearchQuery([]string{"6800"})
+	require.NoError(t, err)
+	assert.Equal(t, SearchQuery{PRNumber: 6800}, query)
+
+	for _, terms := range [][]string{{}, {" "}, {"#1", "#2"}, {"@alice", "@bob"}} {
+		_, err := ParseSearchQuery(terms)
+		assert.Error(t, err, terms)
+	}
+}
+
+func TestSearchCHANGELOGs(t *testing.T) {
+	categories := config.Default().Categories
+
+	// The fix is in the patch releases of both minors, Known Issues only
+	// repeat entries
+	matches := SearchCHANGELOGs(searchChangelogs, categories, SearchQuery{PRNumber: 7010})
+	assert.Equal(t, []types.SearchMatch{
+		{File: "CHANGELOG-2.4.md", Release: "2.4.1", Category: "FIXED", PRNumbers: []int{7010}, Authors: []string{"alice"}, Description: "Fix crash of the agent when the Egress IP is removed"},
+		{File: "CHANGELOG-2.3.md", Release: "2.3.2", Category: "FIXED", PRNumbers: []int{7010}, Authors: []string{"alice"}, Description: "Fix crash of the agent when the Egress IP is removed"},
+	}, matches)
+
+	// Grouped follow-up PRs are found too
+	matches = SearchCHANGELOGs(searchChangelogs, categories, SearchQuery{PRNumber: 6850})
+	require.Len(t, matches, 1)
+	assert.Equal(t, "2.3.0", matches[0].Release)
+
+	// Authors are case-insensitive, keywords must all match
+	matches = SearchCHANGELOGs(searchChangelogs, categories, SearchQuery{Author: "bob"})
+	require.Len(t, matches, 2)
+	assert.Equal(t, []int{7020}, matches[0].PRNumbers)
+	assert.Equal(t, []int{6800, 6850}, matches[1].PRNumbers)
+	matches = SearchCHANGELOGs(searchChangelogs, categories, SearchQuery{Keywords: []string{"egress", "bandwidth"}})
+	require.Len(t, matches, 1)
+	assert.Equal(t, "ADDED", matches[0].Category)
+
+	assert.Empty(t, SearchCHANGELOGs(searchChangelogs, categories, SearchQuery{PRNumber: 7020, Author: "alice"}))
+}
+
+func TestFormatSearchResults(t *testing.T) {
+	query := SearchQuery{PRNumber: 7010}
+	matches := SearchCHANGELOGs(searchChangelogs, config.Default().Categories, query)
+	report := FormatSearchResults(query, matches, config.DefaultLinkTemplates())
+	assert.Equal(t, `# CHANGELOG search: #7010
+
+Found in 2.4.1, 2.3.2.
+
+- **2.4.1** (FIXED, CHANGELOG-2.4.md): Fix crash of the agent when the Egress IP is removed. ([#7010](https://github.com/antrea-io/antrea/pull/7010), [@alice](https://github.com/alice))
+- **2.3.2** (FIXED, CHANGELOG-2.3.md): Fix crash of the agent when the Egress IP is removed. ([#7010](https://github.com/antrea-io/antrea/pull/7010), [@alice](https://github.com/alice))
+`, report)
+
+	assert.Equal(t, "# CHANGELOG search: @carol\n\nNo entry of the historical CHANGELOGs matches the search.\n",
+		FormatSearchResults(SearchQuery{Author: "carol"}, nil, config.DefaultLinkTemplates()))
+
+	links := config.DefaultLinkTemplates()
+	links.PR = "https://github.example.com/antrea/antrea/pull/{{.Number}}"
+	links.Author = "https://github.example.com/{{.Author}}"
+	assert.Contains(t, FormatSearchResults(query, matches, links),
+		"([#7010](https://github.example.com/antrea/antrea/pull/7010), [@alice](https://github.example.com/alice))\n")
+}
+
+func TestFetchCHANGELOGs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().
+		GetDirectoryContents(gomock.Any(), "antrea-io", "antrea", "CHANGELOG").
+		Return([]*gogithub.RepositoryContent{
+			{Name: gogithub.Ptr("CHANGELOG-2.4.md")},
+			{Name: gogithub.Ptr("CHANGELOG-2.3.md")},
+			{Name: gogithub.Ptr("README.md")},
+			{Name: gogithub.Ptr("CHANGELOG-2.4.zh-CN.md")},
+		}, nil)
+	mockGitHubClient.EXPECT().
+		GetFileContent(gomock.Any(), "antrea-io", "antrea", "CHANGELOG/CHANGELOG-2.4.md").
+		Return(searchChangelogs["CHANGELOG-2.4.md"], nil)
+	mockGitHubClient.EXPECT().
+		GetFileContent(gomock.Any(), "antrea-io", "antrea", "CHANGELOG/CHANGELOG-2.3.md").
+		Return(searchChangelogs["CHANGELOG-2.3.md"], nil)
+
+	files, err := FetchCHANGELOGs(context.Background(), mockGitHubClient)
+	require.NoError(t, err)
+	assert.Equal(t, searchChangelogs, files)
+}
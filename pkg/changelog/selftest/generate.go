@@ -0,0 +1,396 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// Days between the generated releases. The PRs of the release are merged
+// after the previous minor release, and its patch release is in between.
+const (
+	fixtureMinorInterval = 60
+	fixturePatchDaysAgo  = 20
+)
+
+// fixtureArea is a component of the generated PRs
+type fixtureArea struct {
+	label     string
+	component string
+	file      string
+}
+
+var fixtureAreas = []fixtureArea{
+	{"area/network-policy", "NetworkPolicy", "pkg/agent/controller/networkpolicy/reconciler.go"},
+	{"area/egress", "Egress", "pkg/agent/controller/egress/egress_controller.go"},
+	{"area/flow-visibility", "FlowExporter", "pkg/agent/flowexporter/exporter.go"},
+	{"area/proxy", "AntreaProxy", "pkg/agent/proxy/proxier.go"},
+	{"area/ipam", "AntreaIPAM", "pkg/agent/cniserver/ipam/antrea_ipam.go"},
+	{"area/multicast", "Multicast", "pkg/agent/multicast/mcast_controller.go"},
+	{"area/transit/ipsec", "IPsec", "pkg/agent/route/route_linux.go"},
+	{"area/antctl", "antctl", "pkg/antctl/antctl.go"},
+	{"area/multi-cluster", "Multi-cluster Gateway", "multicluster/controllers/multicluster/gateway_controller.go"},
+	{"area/secondary-network", "SecondaryNetwork", "pkg/agent/secondarynetwork/init.go"},
+}
+
+// fixtureTemplates are the titles of the generated PRs by category, each
+// completed with a component and a subject
+var fixtureTemplates = map[string][]string{
+	"ADDED": {
+		"Support %[2]s in %[1]s",
+		"Add %[2]s to %[1]s",
+		"Add an option to enable %[2]s in %[1]s",
+	},
+	"CHANGED": {
+		"Improve the performance of %[1]s with %[2]s",
+		"Reduce the memory usage of %[1]s for %[2]s",
+		"Change the default behavior of %[1]s with %[2]s",
+	},
+	"FIXED": {
+		"Fix %[1]s ignoring %[2]s after an antrea-agent restart",
+		"Fix a race condition in %[1]s when handling %[2]s",
+		"Fix %[1]s not updating %[2]s when the Node IP changes",
+	},
+}
+
+var fixtureSubjects = []string{
+	"IPv6 addresses", "dual-stack clusters", "Windows Nodes", "named ports", "FQDN rules",
+	"Pod labels", "stale flows", "Service health checks", "Prometheus metrics", "large clusters",
+	"ExternalIPPools", "L7 rules", "hostNetwork Pods", "overlapping CIDRs", "Node taints",
+}
+
+var fixtureKindLabels = map[string]string{
+	"ADDED":   "kind/feature",
+	"CHANGED": "kind/enhancement",
+	"FIXED":   "kind/bug",
+}
+
+var fixtureCategoryHeaders = map[string]string{
+	"ADDED":   "Added",
+	"CHANGED": "Changed",
+	"FIXED":   "Fixed",
+}
+
+// Shares of the generated PRs, in percent
+const (
+	fixtureBotShare        = 5
+	fixtureUnlabeledShare  = 10
+	fixtureLowScoreShare   = 10
+	fixtureBackportShare   = 20 // Of the FIXED PRs
+	fixtureBeforeFromShare = 5
+)
+
+// FixtureOptions configures a generated fixture
+type FixtureOptions struct {
+	// Release is the minor release of the fixture, e.g. 2.5.0
+	Release string
+	// PRs is the number of PRs merged since the previous minor release
+	PRs int
+	// HistoricalReleases is the number of previous minor releases with a
+	// historical CHANGELOG
+	HistoricalReleases int
+	// Seed selects the generated data, the same options always generate the
+	// same fixture
+	Seed uint64
+}
+
+// fixtureGenerator holds the state of GenerateFixture
+type fixtureGenerator struct {
+	rng     *rand.Rand
+	now     time.Time
+	number  int
+	authors []string
+	titles  map[string]bool
+}
+
+// GenerateFixture synthesizes a fixture of a minor release: realistic PRs,
+// with bot, unlabeled, backported and minor PRs, the historical CHANGELOGs of
+// the previous minor releases and the model response. Authors are made up,
+// so that the fixture can be shared and used without any access token. now
+// is used for the dates of the historical CHANGELOGs.
+func GenerateFixture(opts FixtureOptions, now time.Time) (*Fixture, error) {
+	release, err := version.Parse(opts.Release)
+	if err != nil {
+		return nil, err
+	}
+	if release.Patch() != 0 {
+		return nil, fmt.Errorf("fixtures are generated for minor releases, got: %s", release)
+	}
+	if opts.PRs < 1 {
+		return nil, fmt.Errorf("a fixture needs at least 1 PR, got: %d", opts.PRs)
+	}
+	if opts.HistoricalReleases < 1 || uint64(opts.HistoricalReleases) > release.Minor() {
+		return nil, fmt.Errorf("the number of historical releases of %s must be between 1 and %d, got: %d", release, release.Minor(), opts.HistoricalReleases)
+	}
+
+	g := &fixtureGenerator{
+		rng:    rand.New(rand.NewPCG(opts.Seed, opts.Seed)),
+		now:    now,
+		number: 1000,
+		titles: make(map[string]bool),
+	}
+	for i := 1; i <= max(5, opts.PRs/8); i++ {
+		g.authors = append(g.authors, fmt.Sprintf("contributor-%02d", i))
+	}
+
+	fixture := &Fixture{
+		Description: fmt.Sprintf("Generated minor release with %d PRs since the previous minor release and %d historical releases (seed %d)", opts.PRs, opts.HistoricalReleases, opts.Seed),
+		Release:     release.String(),
+		Files:       make(map[string]string),
+		Response:    &types.ModelResponse{},
+	}
+
+	// The historical minor releases, oldest first, each with half as many
+	// entries as the release
+	for i := opts.HistoricalReleases; i >= 1; i-- {
+		minor := version.New(release.Major(), release.Minor()-uint64(i), 0)
+		daysAgo := i * fixtureMinorInterval
+		fixture.Tags = append(fixture.Tags, FixtureTag{Name: "v" + minor.String(), DaysAgo: daysAgo})
+		var entries []FixturePull
+		for range max(3, opts.PRs/2) {
+			category := g.category()
+			entries = append(entries, g.pull(category, daysAgo+g.rng.IntN(fixtureMinorInterval)+1))
+		}
+		fixture.Files[fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", minor.Major(), minor.Minor())] = g.changelog(minor, daysAgo, entries)
+	}
+
+	// Labeled PRs merged just before the previous minor release, which are
+	// not part of the release
+	for range opts.PRs * fixtureBeforeFromShare / 100 {
+		pull := g.pull(g.category(), fixtureMinorInterval+g.rng.IntN(10)+1)
+		pull.Expected = ExpectExcluded
+		fixture.Pulls = append(fixture.Pulls, pull)
+	}
+
+	var backports []FixturePull
+	for range opts.PRs {
+		roll := g.rng.IntN(100)
+		daysAgo := g.rng.IntN(fixtureMinorInterval-1) + 1
+		switch {
+		case roll < fixtureBotShare:
+			pull := g.botPull(daysAgo)
+			fixture.Pulls = append(fixture.Pulls, pull)
+		case roll < fixtureBotShare+fixtureUnlabeledShare:
+			area := fixtureAreas[g.rng.IntN(len(fixtureAreas))]
+			pull := FixturePull{
+				Number:   g.nextNumber(),
+				Title:    g.uniqueTitle(fmt.Sprintf("Refactor the e2e tests of %s", area.component)),
+				Author:   g.author(),
+				Labels:   []string{area.label, "area/test"},
+				Files:    []string{"test/e2e/" + strings.ToLower(strings.ReplaceAll(area.component, " ", "_")) + "_test.go"},
+				DaysAgo:  daysAgo,
+				Expected: ExpectExcluded,
+			}
+			fixture.Pulls = append(fixture.Pulls, pull)
+		case roll < fixtureBotShare+fixtureUnlabeledShare+fixtureLowScoreShare:
+			// Sent to the model, which leaves it out of the CHANGELOG
+			area := fixtureAreas[g.rng.IntN(len(fixtureAreas))]
+			pull := FixturePull{
+				Number:   g.nextNumber(),
+				Title:    g.uniqueTitle(fmt.Sprintf("Fix a typo in the %s log messages", area.component)),
+				Author:   g.author(),
+				Labels:   []string{"action/release-note", area.label},
+				Files:    []string{area.file},
+				DaysAgo:  daysAgo,
+				Expected: ExpectIncluded,
+			}
+			fixture.Pulls = append(fixture.Pulls, pull)
+			fixture.Response.Changes = append(fixture.Response.Changes, types.ChangeEntry{
+				PRNumber: pull.Number, Category: "FIXED", Description: pull.Title,
+				IncludeScore: g.rng.IntN(15), ImportanceScore: g.rng.IntN(10),
+			})
+		default:
+			category := g.category()
+			pull := g.pull(category, daysAgo)
+			pull.Expected = ExpectIncluded
+			fixture.Pulls = append(fixture.Pulls, pull)
+			change := types.ChangeEntry{
+				PRNumber: pull.Number, Category: category, Description: pull.Title,
+				IncludeScore: 60 + g.rng.IntN(41), ImportanceScore: 20 + g.rng.IntN(81),
+			}
+			// Fixes merged before the patch release are backported to it,
+			// and their entry is reused
+			if category == "FIXED" && daysAgo > fixturePatchDaysAgo && g.rng.IntN(100) < fixtureBackportShare {
+				backports = append(backports, pull)
+				change.ReusedFromHistory = true
+			}
+			fixture.Response.Changes = append(fixture.Response.Changes, change)
+		}
+	}
+
+	// The patch release of the previous minor release, with the backports
+	previous := version.New(release.Major(), release.Minor()-1, 0)
+	if len(backports) > 0 {
+		patch := version.New(previous.Major(), previous.Minor(), 1)
+		fixture.Tags = append(fixture.Tags, FixtureTag{Name: "v" + patch.String(), DaysAgo: fixturePatchDaysAgo})
+		path := fmt.Sprintf("CHANGELOG/CHANGELOG-%d.%d.md", previous.Major(), previous.Minor())
+		section := g.changelog(patch, fixturePatchDaysAgo, backports)
+		fixture.Files[path] = mergeFixtureChangelogs(section, fixture.Files[path])
+	}
+	sort.Slice(fixture.Pulls, func(i, j int) bool { return fixture.Pulls[i].Number < fixture.Pulls[j].Number })
+	return fixture, nil
+}
+
+// WriteFixture writes a fixture as JSON, in the format of the embedded
+// fixtures
+func WriteFixture(fixture *Fixture) ([]byte, error) {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// LoadFixtureFile reads a fixture from a file, e.g. written by gen-fixtures
+func LoadFixtureFile(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	fixture := &Fixture{Name: strings.TrimSuffix(filepath.Base(path), ".json")}
+	if err := json.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+func (g *fixtureGenerator) nextNumber() int {
+	g.number++
+	return g.number
+}
+
+func (g *fixtureGenerator) author() string {
+	return g.authors[g.rng.IntN(len(g.authors))]
+}
+
+// category returns a random category, fixes being the most common
+func (g *fixtureGenerator) category() string {
+	switch roll := g.rng.IntN(10); {
+	case roll < 2:
+		return "ADDED"
+	case roll < 4:
+		return "CHANGED"
+	default:
+		return "FIXED"
+	}
+}
+
+// uniqueTitle returns the title, with a suffix if it was already generated
+func (g *fixtureGenerator) uniqueTitle(title string) string {
+	unique := title
+	for i := 2; g.titles[unique]; i++ {
+		unique = fmt.Sprintf("%s (part %d)", title, i)
+	}
+	g.titles[unique] = true
+	return unique
+}
+
+// pull returns a labeled PR of a category
+func (g *fixtureGenerator) pull(category string, daysAgo int) FixturePull {
+	area := fixtureAreas[g.rng.IntN(len(fixtureAreas))]
+	templates := fixtureTemplates[category]
+	subject := fixtureSubjects[g.rng.IntN(len(fixtureSubjects))]
+	title := g.uniqueTitle(fmt.Sprintf(templates[g.rng.IntN(len(templates))], area.component, subject))
+	body := fmt.Sprintf("This change affects %s with %s.\n\n```release-note\n%s\n```\n", area.component, subject, title)
+	return FixturePull{
+		Number:  g.nextNumber(),
+		Title:   title,
+		Body:    body,
+		Author:  g.author(),
+		Labels:  []string{"action/release-note", area.label, fixtureKindLabels[category]},
+		Files:   []string{area.file},
+		DaysAgo: daysAgo,
+	}
+}
+
+// botPull returns a dependency upgrade opened by a bot, which is skipped
+func (g *fixtureGenerator) botPull(daysAgo int) FixturePull {
+	minor := g.rng.IntN(30)
+	return FixturePull{
+		Number:   g.nextNumber(),
+		Title:    g.uniqueTitle(fmt.Sprintf("Bump github.com/example/lib%d from 1.%d.0 to 1.%d.0", g.rng.IntN(20), minor, minor+1)),
+		Author:   "dependabot[bot]",
+		Labels:   []string{"action/release-note"},
+		Files:    []string{"go.mod", "go.sum"},
+		DaysAgo:  daysAgo,
+		Expected: ExpectSkipped,
+	}
+}
+
+// changelog renders the CHANGELOG-X.Y.md file of a release with the entries
+// of PRs
+func (g *fixtureGenerator) changelog(ver *version.Version, daysAgo int, pulls []FixturePull) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Changelog %d.%d\n\n", ver.Major(), ver.Minor()))
+	date := g.now.AddDate(0, 0, -daysAgo).Format(time.DateOnly)
+	sb.WriteString(fmt.Sprintf("## %s - %s\n", ver, date))
+	var authors []string
+	for _, category := range []string{"ADDED", "CHANGED", "FIXED"} {
+		var lines []string
+		for _, pull := range pulls {
+			if !slices.Contains(pull.Labels, fixtureKindLabels[category]) {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("- %s. ([#%d](https://github.com/antrea-io/antrea/pull/%d), [@%s])", pull.Title, pull.Number, pull.Number, pull.Author))
+			if !slices.Contains(authors, pull.Author) {
+				authors = append(authors, pull.Author)
+			}
+		}
+		if len(lines) > 0 {
+			sb.WriteString(fmt.Sprintf("\n### %s\n\n%s\n", fixtureCategoryHeaders[category], strings.Join(lines, "\n")))
+		}
+	}
+	sort.Strings(authors)
+	sb.WriteString("\n")
+	for _, author := range authors {
+		sb.WriteString(fmt.Sprintf("[@%s]: https://github.com/%s\n", author, author))
+	}
+	return sb.String()
+}
+
+// mergeFixtureChangelogs adds the section of a patch release on top of the
+// CHANGELOG-X.Y.md file of its minor release, with the link definitions of
+// both at the end
+func mergeFixtureChangelogs(patch, minor string) string {
+	split := func(content string) (string, []string) {
+		var body, links []string
+		for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+			if strings.HasPrefix(line, "[@") {
+				links = append(links, line)
+			} else {
+				body = append(body, line)
+			}
+		}
+		return strings.TrimRight(strings.Join(body, "\n"), "\n"), links
+	}
+	patchBody, patchLinks := split(patch)
+	minorBody, minorLinks := split(minor)
+	_, minorSections, _ := strings.Cut(minorBody, "\n\n")
+	links := append(patchLinks, minorLinks...)
+	sort.Strings(links)
+	links = slices.Compact(links)
+	return patchBody + "\n\n" + minorSections + "\n\n" + strings.Join(links, "\n") + "\n"
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selftest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFixture_Run(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, opts := range []FixtureOptions{
+		{Release: "2.5.0", PRs: 1, HistoricalReleases: 1, Seed: 1},
+		{Release: "2.5.0", PRs: 40, HistoricalReleases: 3, Seed: 2},
+		{Release: "3.2.0", PRs: 300, HistoricalReleases: 2, Seed: 3},
+	} {
+		t.Run(opts.Release, func(t *testing.T) {
+			fixture, err := GenerateFixture(opts, now)
+			require.NoError(t, err)
+
+			result := Run(context.Background(), fixture, nil, "gemini-2.5-flash")
+			for _, check := range result.Checks {
+				assert.NoError(t, check.Err, "check %s failed", check.Name)
+			}
+			assert.True(t, result.Passed())
+		})
+	}
+}
+
+func TestGenerateFixture_Content(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	opts := FixtureOptions{Release: "2.5.0", PRs: 200, HistoricalReleases: 3, Seed: 42}
+	fixture, err := GenerateFixture(opts, now)
+	require.NoError(t, err)
+
+	// The same options generate the same fixture
+	again, err := GenerateFixture(opts, now)
+	require.NoError(t, err)
+	assert.Equal(t, fixture, again)
+
+	assert.Equal(t, "2.5.0", fixture.Release)
+	assert.Contains(t, fixture.Files, "CHANGELOG/CHANGELOG-2.2.md")
+	assert.Contains(t, fixture.Files, "CHANGELOG/CHANGELOG-2.4.md")
+	assert.Contains(t, fixture.Files["CHANGELOG/CHANGELOG-2.4.md"], "## 2.4.1 - 2025-05-12\n")
+	assert.Contains(t, fixture.Files["CHANGELOG/CHANGELOG-2.4.md"], "## 2.4.0 - 2025-04-02\n")
+
+	outcomes := make(map[string]int)
+	titles := make(map[string]bool)
+	for _, pull := range fixture.Pulls {
+		outcomes[pull.Expected]++
+		assert.False(t, titles[pull.Title], "duplicate title %q", pull.Title)
+		titles[pull.Title] = true
+	}
+	assert.Equal(t, 210, len(fixture.Pulls))
+	assert.Positive(t, outcomes[ExpectIncluded])
+	assert.Positive(t, outcomes[ExpectSkipped])
+	assert.Positive(t, outcomes[ExpectExcluded])
+	var reused int
+	for _, change := range fixture.Response.Changes {
+		if change.ReusedFromHistory {
+			reused++
+		}
+	}
+	assert.Positive(t, reused)
+}
+
+func TestGenerateFixture_Invalid(t *testing.T) {
+	now := time.Now()
+	_, err := GenerateFixture(FixtureOptions{Release: "2.5.1", PRs: 10, HistoricalReleases: 1}, now)
+	assert.ErrorContains(t, err, "fixtures are generated for minor releases")
+	_, err = GenerateFixture(FixtureOptions{Release: "2.5.0", PRs: 0, HistoricalReleases: 1}, now)
+	assert.ErrorContains(t, err, "at least 1 PR")
+	_, err = GenerateFixture(FixtureOptions{Release: "2.1.0", PRs: 10, HistoricalReleases: 2}, now)
+	assert.ErrorContains(t, err, "must be between 1 and 1")
+}
+
+func TestLoadFixtureFile(t *testing.T) {
+	fixture, err := GenerateFixture(FixtureOptions{Release: "2.5.0", PRs: 10, HistoricalReleases: 1, Seed: 7}, time.Now())
+	require.NoError(t, err)
+	data, err := WriteFixture(fixture)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "demo.json")
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	loaded, err := LoadFixtureFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "demo", loaded.Name)
+	assert.Equal(t, fixture.Pulls, loaded.Pulls)
+	assert.Equal(t, fixture.Files, loaded.Files)
+}
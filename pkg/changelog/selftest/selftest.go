@@ -0,0 +1,313 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selftest runs the whole changelog pipeline against recorded
+// fixtures and verifies invariants of its output, as a quick confidence check
+// after upgrading the tool. GitHub is replaced with an in-memory server
+// serving the fixture repository, and the model is either the real one or a
+// replay of the recorded response.
+package selftest
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/github"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/testsupport"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+//go:embed fixtures/*.json
+var fixtureFiles embed.FS
+
+// Expected PR outcomes of a fixture
+const (
+	// ExpectIncluded means the PR is sent to the model
+	ExpectIncluded = "included"
+	// ExpectSkipped means the PR is fetched but skipped before calling the model
+	ExpectSkipped = "skipped"
+	// ExpectExcluded means the PR is not part of the release, e.g. because it
+	// was merged before the from-release
+	ExpectExcluded = "excluded"
+)
+
+// Fixture is a recorded release of a small repository. Times are relative to
+// the start of the run, so that fixtures never get older than the maximum
+// release window.
+type Fixture struct {
+	Name        string `json:"-"`
+	Description string `json:"description"`
+	Release     string `json:"release"`
+	// Tags are the release tags of the repository
+	Tags []FixtureTag `json:"tags"`
+	// Files are the files of the default branch by path, e.g. the historical
+	// CHANGELOGs
+	Files map[string]string `json:"files"`
+	Pulls []FixturePull     `json:"pulls"`
+	// Response is the recorded model response, replayed unless the real
+	// model is used
+	Response *types.ModelResponse `json:"response"`
+}
+
+// FixtureTag is a release tag of a fixture
+type FixtureTag struct {
+	Name    string `json:"name"`
+	DaysAgo int    `json:"days_ago"`
+}
+
+// FixturePull is a merged pull request of a fixture
+type FixturePull struct {
+	Number  int      `json:"number"`
+	Title   string   `json:"title"`
+	Body    string   `json:"body"`
+	Author  string   `json:"author"`
+	Labels  []string `json:"labels"`
+	Files   []string `json:"files"`
+	DaysAgo int      `json:"days_ago"`
+	// Expected is the expected outcome of the PR: included, skipped or excluded
+	Expected string `json:"expected"`
+}
+
+// LoadFixtures returns the embedded fixtures, sorted by name
+func LoadFixtures() ([]*Fixture, error) {
+	entries, err := fixtureFiles.ReadDir("fixtures")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fixtures: %w", err)
+	}
+	var fixtures []*Fixture
+	for _, entry := range entries {
+		data, err := fixtureFiles.ReadFile(path.Join("fixtures", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture %s: %w", entry.Name(), err)
+		}
+		fixture := &Fixture{Name: strings.TrimSuffix(entry.Name(), ".json")}
+		if err := json.Unmarshal(data, fixture); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %w", entry.Name(), err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	sort.Slice(fixtures, func(i, j int) bool { return fixtures[i].Name < fixtures[j].Name })
+	return fixtures, nil
+}
+
+// Check is the outcome of an invariant check, Err is nil if it passed
+type Check struct {
+	Name string
+	Err  error
+}
+
+// Result is the outcome of running a fixture
+type Result struct {
+	Fixture string
+	Checks  []Check
+	// Changelog is the generated changelog, if any
+	Changelog string
+}
+
+// Passed returns true if all the checks passed
+func (r *Result) Passed() bool {
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Result) check(name string, err error) {
+	r.Checks = append(r.Checks, Check{Name: name, Err: err})
+}
+
+// replayCaller is a ModelCaller returning the recorded response of a fixture
+type replayCaller struct {
+	response *types.ModelResponse
+}
+
+func (c *replayCaller) Call(_ context.Context, _, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+	// The generator modifies the response, so each call gets a copy
+	data, err := json.Marshal(c.response)
+	if err != nil {
+		return nil, nil, err
+	}
+	var response types.ModelResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, nil, err
+	}
+	return &response, &types.ModelDetails{Version: version, Model: modelName, Calls: 1}, nil
+}
+
+// Run generates the changelog of a fixture and checks its invariants. The
+// recorded response is replayed if modelCaller is nil.
+func Run(ctx context.Context, fixture *Fixture, modelCaller types.ModelCaller, modelName string) *Result {
+	result := &Result{Fixture: fixture.Name}
+	if modelCaller == nil {
+		modelCaller = &replayCaller{response: fixture.Response}
+	}
+
+	fake := testsupport.NewFakeGitHubServer("antrea-io", "antrea")
+	defer fake.Close()
+	fixture.serve(fake, time.Now())
+
+	githubClient := github.NewClient(ctx, "", github.WithBaseURL(fake.URL()))
+	generator := changelog.NewChangelogGenerator(fixture.Release, "", false, modelName, modelCaller, githubClient)
+	changelogText, promptData, response, _, err := generator.Generate(ctx)
+	result.check("generate", err)
+	if err != nil {
+		return result
+	}
+	result.Changelog = changelogText
+
+	result.check("validate", changelog.Validate(changelogText))
+	result.check("release window", fixture.checkReleaseWindow(promptData.Text, generator.SkippedPRs()))
+	result.check("entries", checkEntries(changelogText, response))
+	result.check("historical reuse", checkReuse(generator.RunWarnings()))
+	result.check("merge", checkMerge(changelogText))
+	return result
+}
+
+// serve adds the fixture repository to the fake GitHub server
+func (f *Fixture) serve(fake *testsupport.FakeGitHub, now time.Time) {
+	daysAgo := func(days int) *gogithub.Timestamp {
+		return &gogithub.Timestamp{Time: now.Add(-time.Duration(days) * 24 * time.Hour)}
+	}
+	for _, tag := range f.Tags {
+		fake.AddTag(tag.Name, &gogithub.Commit{
+			SHA:       gogithub.Ptr("sha-" + tag.Name),
+			Committer: &gogithub.CommitAuthor{Date: daysAgo(tag.DaysAgo)},
+		})
+	}
+	for filePath, content := range f.Files {
+		fake.AddFile("", filePath, content)
+	}
+	for _, pull := range f.Pulls {
+		var labels []*gogithub.Label
+		for _, label := range pull.Labels {
+			labels = append(labels, &gogithub.Label{Name: gogithub.Ptr(label)})
+		}
+		fake.AddPullRequest(&gogithub.PullRequest{
+			Number:         gogithub.Ptr(pull.Number),
+			Title:          gogithub.Ptr(pull.Title),
+			Body:           gogithub.Ptr(pull.Body),
+			State:          gogithub.Ptr("closed"),
+			User:           &gogithub.User{Login: gogithub.Ptr(pull.Author)},
+			Base:           &gogithub.PullRequestBranch{Ref: gogithub.Ptr(fake.DefaultBranch)},
+			Labels:         labels,
+			MergedAt:       daysAgo(pull.DaysAgo),
+			MergeCommitSHA: gogithub.Ptr(fmt.Sprintf("merge-%d", pull.Number)),
+		}, pull.Files...)
+	}
+}
+
+// checkReleaseWindow checks that exactly the PRs of the release are sent to
+// the model, and that the skipped ones are reported
+func (f *Fixture) checkReleaseWindow(promptText string, skipped []types.SkippedPR) error {
+	// PRs dropped after calling the model are checked with the entries
+	skippedNumbers := make(map[int]bool)
+	for _, s := range skipped {
+		if s.Reason != types.SkipReasonLowIncludeScore && s.Reason != types.SkipReasonUnknownCategory {
+			skippedNumbers[s.Number] = true
+		}
+	}
+	var problems []string
+	for _, pull := range f.Pulls {
+		inPrompt := strings.Contains(promptText, fmt.Sprintf("## PR #%d\n", pull.Number))
+		var expected bool
+		switch pull.Expected {
+		case ExpectIncluded:
+			expected = inPrompt && !skippedNumbers[pull.Number]
+		case ExpectSkipped:
+			expected = !inPrompt && skippedNumbers[pull.Number]
+		case ExpectExcluded:
+			expected = !inPrompt && !skippedNumbers[pull.Number]
+		default:
+			return fmt.Errorf("invalid expected outcome %q of PR #%d", pull.Expected, pull.Number)
+		}
+		if !expected {
+			problems = append(problems, fmt.Sprintf("PR #%d should be %s", pull.Number, pull.Expected))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkEntries checks that each entry included by the model is rendered
+// exactly once, and that the other entries are not rendered
+func checkEntries(changelogText string, response *types.ModelResponse) error {
+	thresholds := config.DefaultThresholds()
+	known := make(map[string]bool)
+	for _, category := range config.DefaultCategories() {
+		known[category.Name] = true
+	}
+	var problems []string
+	for _, change := range response.Changes {
+		count := strings.Count(changelogText, fmt.Sprintf("[#%d]", change.PRNumber))
+		included := change.IncludeScore >= thresholds.Include && known[change.Category]
+		switch {
+		case included && count != 1:
+			problems = append(problems, fmt.Sprintf("PR #%d is rendered %d times instead of once", change.PRNumber, count))
+		case !included && count != 0:
+			problems = append(problems, fmt.Sprintf("PR #%d is rendered but should be dropped", change.PRNumber))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkReuse checks that the historical entries of the PRs were reused
+func checkReuse(warnings []types.Warning) error {
+	var problems []string
+	for _, w := range warnings {
+		if w.Kind == types.WarningKindReuseViolation {
+			problems = append(problems, w.Message)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkMerge checks that the changelog can be merged into a new file, and
+// that merging it again does not change the file
+func checkMerge(changelogText string) error {
+	merged, err := changelog.MergeChangelog("", changelogText, changelog.LinkPlacementAuto, changelog.LinkStyleAuto)
+	if err != nil {
+		return err
+	}
+	if err := changelog.Validate(merged); err != nil {
+		return fmt.Errorf("merged changelog is invalid: %w", err)
+	}
+	remerged, err := changelog.MergeChangelog(merged, changelogText, changelog.LinkPlacementAuto, changelog.LinkStyleAuto)
+	if err != nil {
+		return err
+	}
+	if remerged != merged {
+		return fmt.Errorf("merging the changelog twice changes the file")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selftest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Fixtures(t *testing.T) {
+	fixtures, err := LoadFixtures()
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		t.Run(fixture.Name, func(t *testing.T) {
+			result := Run(context.Background(), fixture, nil, "gemini-2.5-flash")
+			for _, check := range result.Checks {
+				assert.NoError(t, check.Err, "check %s failed", check.Name)
+			}
+			assert.True(t, result.Passed())
+		})
+	}
+}
+
+func TestRun_BrokenResponse(t *testing.T) {
+	fixtures, err := LoadFixtures()
+	require.NoError(t, err)
+	fixture := fixtures[0]
+
+	// A model which does not reuse the historical entries is caught
+	for i := range fixture.Response.Changes {
+		fixture.Response.Changes[i].ReusedFromHistory = false
+	}
+	result := Run(context.Background(), fixture, nil, "gemini-2.5-flash")
+
+	assert.False(t, result.Passed())
+	failed := make(map[string]bool)
+	for _, check := range result.Checks {
+		failed[check.Name] = check.Err != nil
+	}
+	assert.True(t, failed["historical reuse"])
+	assert.False(t, failed["entries"])
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/prompt"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// sentenceBreakRegex matches the end of a sentence followed by another one,
+// capturing the word ending the first sentence
+var sentenceBreakRegex = regexp.MustCompile(`(\S+)[.!?]\s+[A-Z]`)
+
+// abbreviations are words followed by a period which do not end a sentence
+var abbreviations = map[string]bool{
+	"e.g": true,
+	"i.e": true,
+	"etc": true,
+	"vs":  true,
+}
+
+// descriptionViolation returns why a description does not follow the length
+// and sentence constraints, or an empty string if it does
+func descriptionViolation(description string, maxLength int) string {
+	description = strings.TrimSuffix(strings.TrimSpace(description), ".")
+	if n := utf8.RuneCountInString(description); n > maxLength {
+		return fmt.Sprintf("%d characters, more than %d", n, maxLength)
+	}
+	for _, m := range sentenceBreakRegex.FindAllStringSubmatch(description, -1) {
+		if !abbreviations[strings.ToLower(m[1])] {
+			return "more than one sentence"
+		}
+	}
+	return ""
+}
+
+// enforceDescriptionConstraints asks the model to shorten the descriptions of
+// rendered entries which are longer than maxDescriptionLength or have more
+// than one sentence. Entries which still violate the constraints after
+// shortening are kept as is, with a warning, so that they can be fixed during
+// review. If the shortening call fails, all the original descriptions are
+// kept with a warning, unless ctx is done.
+func (g *ChangelogGenerator) enforceDescriptionConstraints(ctx context.Context, response *types.ModelResponse, details *types.ModelDetails, thresholds config.Thresholds) error {
+	maxLength := g.maxDescriptionLength
+	var sb strings.Builder
+	sb.WriteString(strings.ReplaceAll(prompt.ShortenTemplate, "{{MAX_LENGTH}}", strconv.Itoa(maxLength)))
+	sb.WriteString("\n")
+
+	var violations int
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional || change.TitleFallback {
+			continue
+		}
+		if reason := descriptionViolation(change.Description, maxLength); reason != "" {
+			log.Printf("Description of PR #%d does not follow constraints (%s)", change.PRNumber, reason)
+			sb.WriteString(fmt.Sprintf("- PR #%d: %s\n", change.PRNumber, change.Description))
+			violations++
+		}
+	}
+	if violations == 0 {
+		return nil
+	}
+
+	log.Printf("Asking model to shorten %d descriptions...", violations)
+	modelCtx, cancel := stageContext(ctx, g.timeouts.Model)
+	defer cancel()
+	shortened, shortenDetails, err := g.modelCaller.Call(modelCtx, sb.String(), g.release, g.model)
+	if err != nil {
+		if shortenDetails != nil {
+			details.Add(shortenDetails)
+		}
+		if ctx.Err() != nil {
+			return stageError(modelCtx, StageModel, g.timeouts.Model, &types.ModelError{Err: fmt.Errorf("failed to call AI model to shorten descriptions: %w", err)})
+		}
+		g.warn(types.Warning{Kind: types.WarningKindDescription,
+			Message: fmt.Sprintf("failed to shorten %d descriptions, keeping the originals: %v", violations, err)})
+		return nil
+	}
+	details.Add(shortenDetails)
+
+	descriptions := make(map[int]string)
+	for _, change := range shortened.Changes {
+		descriptions[change.PRNumber] = change.Description
+	}
+	for i := range response.Changes {
+		change := &response.Changes[i]
+		if change.IncludeScore < thresholds.Optional || change.TitleFallback || descriptionViolation(change.Description, maxLength) == "" {
+			continue
+		}
+		description, ok := descriptions[change.PRNumber]
+		if !ok {
+			g.warn(types.Warning{Kind: types.WarningKindDescription, PRNumber: change.PRNumber,
+				Message: fmt.Sprintf("model did not shorten the description of PR #%d, keeping it as is", change.PRNumber)})
+			continue
+		}
+		if reason := descriptionViolation(description, maxLength); reason != "" {
+			g.warn(types.Warning{Kind: types.WarningKindDescription, PRNumber: change.PRNumber,
+				Message: fmt.Sprintf("shortened description of PR #%d still does not follow constraints (%s), keeping the original", change.PRNumber, reason)})
+			continue
+		}
+		change.Description = strings.TrimSuffix(strings.TrimSpace(description), ".")
+	}
+	return nil
+}
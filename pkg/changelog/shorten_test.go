@@ -0,0 +1,122 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestDescriptionViolation(t *testing.T) {
+	tests := map[string]struct {
+		description string
+		violation   bool
+	}{
+		"valid":             {"Add support for Egress on Windows Nodes", false},
+		"trailing period":   {"Add support for Egress on Windows Nodes.", false},
+		"abbreviation":      {"Support more protocols, e.g. SCTP and ICMP", false},
+		"version":           {"Bump up OVS to v3.3.0 on Linux", false},
+		"too long":          {strings.Repeat("a", 61), true},
+		"two sentences":     {"Fix Egress IP allocation. This was broken since 2.1", true},
+		"exclamation point": {"Egress finally works! Enjoy", true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.violation, descriptionViolation(tc.description, 60) != "")
+		})
+	}
+}
+
+func TestEnforceDescriptionConstraints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	long := "Fix Egress IP allocation when the Egress is updated. This was broken since Antrea 2.1"
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 100, Category: "FIXED", Description: "Fix NetworkPolicy status update", IncludeScore: 80},
+			{PRNumber: 101, Category: "FIXED", Description: long, IncludeScore: 80},
+			{PRNumber: 102, Category: "FIXED", Description: long, IncludeScore: 80},
+			{PRNumber: 103, Category: "FIXED", Description: long, IncludeScore: 10},
+		},
+	}
+	details := &types.ModelDetails{TotalTokens: 1000, EstimatedCostUSD: 0.01, Calls: 1}
+
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		DoAndReturn(func(_ context.Context, promptText, _, _ string) (*types.ModelResponse, *types.ModelDetails, error) {
+			assert.Contains(t, promptText, "at most 60 characters")
+			assert.Contains(t, promptText, "- PR #101: ")
+			assert.Contains(t, promptText, "- PR #102: ")
+			assert.NotContains(t, promptText, "#100")
+			assert.NotContains(t, promptText, "#103", "Excluded entries should not be shortened")
+			return &types.ModelResponse{
+				Changes: []types.ChangeEntry{
+					{PRNumber: 101, Description: "Fix Egress IP allocation when the Egress is updated."},
+					{PRNumber: 102, Description: long},
+				},
+			}, &types.ModelDetails{TotalTokens: 200, EstimatedCostUSD: 0.002, Calls: 1}, nil
+		})
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, nil, WithMaxDescriptionLength(60))
+	err := generator.enforceDescriptionConstraints(context.Background(), response, details, config.DefaultThresholds())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fix NetworkPolicy status update", response.Changes[0].Description)
+	assert.Equal(t, "Fix Egress IP allocation when the Egress is updated", response.Changes[1].Description)
+	assert.Equal(t, long, response.Changes[2].Description, "Descriptions still too long should be kept as is")
+	assert.Equal(t, long, response.Changes[3].Description)
+	assert.Equal(t, int32(1200), details.TotalTokens, "Usage of the shortening call should be accounted for")
+	assert.InDelta(t, 0.012, details.EstimatedCostUSD, 1e-9)
+	assert.Equal(t, 2, details.Calls)
+}
+
+func TestEnforceDescriptionConstraints_ModelError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	long := "Fix Egress IP allocation when the Egress is updated. This was broken since Antrea 2.1"
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 101, Category: "FIXED", Description: long, IncludeScore: 80},
+		},
+	}
+	details := &types.ModelDetails{TotalTokens: 1000, Calls: 1}
+
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), gomock.Any(), "2.5.0", "gemini-2.5-flash").
+		Return(nil, nil, fmt.Errorf("quota exceeded"))
+
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", mockModelCaller, nil, WithMaxDescriptionLength(60))
+	err := generator.enforceDescriptionConstraints(context.Background(), response, details, config.DefaultThresholds())
+	require.NoError(t, err, "A failed shortening call should not fail the run")
+
+	assert.Equal(t, long, response.Changes[0].Description, "Original descriptions should be kept")
+	require.Len(t, generator.RunWarnings(), 1)
+	assert.Equal(t, types.WarningKindDescription, generator.RunWarnings()[0].Kind)
+	assert.Contains(t, generator.RunWarnings()[0].Message, "quota exceeded")
+}
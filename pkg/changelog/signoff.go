@@ -0,0 +1,106 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gogithub "github.com/google/go-github/v76/github"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// signOffItemRegex matches an item of the sign-off checklist, e.g.
+// "- [x] **network-policy**: @alice, @bob"
+var signOffItemRegex = regexp.MustCompile(`^\s*[-*] \[([ xX])\] \*\*(.+?)\*\*: (.+)$`)
+
+// signOffMarker identifies the sign-off checklist of a release among the
+// comments of the release tracking issue
+func signOffMarker(release string) string {
+	return fmt.Sprintf("<!-- release-signoff %s -->", release)
+}
+
+// FormatSignOffChecklist renders the sign-off checklist of a release, with
+// one item per area, to be posted on the release tracking issue
+func FormatSignOffChecklist(release string, signOffs []config.SignOff) string {
+	var sb strings.Builder
+	sb.WriteString(signOffMarker(release) + "\n")
+	sb.WriteString(fmt.Sprintf("## Release sign-off for %s\n\n", release))
+	sb.WriteString("Area owners, please check your area once you have verified the release (e.g. the CHANGELOG entries and " +
+		"the test results of your area). One owner per area is enough.\n\n")
+	for _, so := range signOffs {
+		sb.WriteString(fmt.Sprintf("- [ ] **%s**: %s\n", so.Area, strings.Join(so.Owners, ", ")))
+	}
+	return sb.String()
+}
+
+// FindSignOffChecklist returns the first comment with the sign-off checklist
+// of a release posted by author (the user who posts the checklists), nil if
+// there is none. The marker is visible to anyone on a public issue, so copies
+// of the checklist posted by other users (e.g. with all the areas checked) are
+// ignored.
+func FindSignOffChecklist(comments []*gogithub.IssueComment, release, author string) *gogithub.IssueComment {
+	marker := signOffMarker(release)
+	for _, comment := range comments {
+		if strings.EqualFold(comment.GetUser().GetLogin(), author) && strings.Contains(comment.GetBody(), marker) {
+			return comment
+		}
+	}
+	return nil
+}
+
+// ParseSignOffChecklist returns the items of a sign-off checklist, in order
+func ParseSignOffChecklist(body string) []types.SignOffStatus {
+	var statuses []types.SignOffStatus
+	for _, line := range strings.Split(body, "\n") {
+		m := signOffItemRegex.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if m == nil {
+			continue
+		}
+		var owners []string
+		for _, owner := range strings.Split(m[3], ",") {
+			if owner = strings.TrimSpace(owner); owner != "" {
+				owners = append(owners, owner)
+			}
+		}
+		statuses = append(statuses, types.SignOffStatus{Area: m[2], Owners: owners, SignedOff: m[1] != " "})
+	}
+	return statuses
+}
+
+// FormatSignOffStatus renders the sign-off status of a release as a markdown
+// report, listing the areas still missing a sign-off with their owners
+func FormatSignOffStatus(release, url string, statuses []types.SignOffStatus) string {
+	var sb strings.Builder
+	var missing []types.SignOffStatus
+	for _, s := range statuses {
+		if !s.SignedOff {
+			missing = append(missing, s)
+		}
+	}
+	sb.WriteString(fmt.Sprintf("# Sign-off status of %s\n\n", release))
+	sb.WriteString(fmt.Sprintf("%d of %d areas signed off (%s).\n", len(statuses)-len(missing), len(statuses), url))
+	if len(missing) == 0 {
+		return sb.String()
+	}
+	sb.WriteString("\n## Missing sign-offs\n\n")
+	for _, s := range missing {
+		sb.WriteString(fmt.Sprintf("- **%s**: %s\n", s.Area, strings.Join(s.Owners, ", ")))
+	}
+	return sb.String()
+}
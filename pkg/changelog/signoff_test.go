@@ -0,0 +1,82 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestSignOffChecklist(t *testing.T) {
+	signOffs := []config.SignOff{
+		{Area: "network-policy", Owners: []string{"@alice", "@antrea-io/np-maintainers"}},
+		{Area: "egress", Owners: []string{"@bob"}},
+		{Area: "windows", Owners: []string{"@carol"}},
+	}
+	checklist := FormatSignOffChecklist("2.5.0", signOffs)
+	assert.True(t, strings.HasPrefix(checklist, "<!-- release-signoff 2.5.0 -->\n## Release sign-off for 2.5.0\n"))
+	assert.Contains(t, checklist, "- [ ] **network-policy**: @alice, @antrea-io/np-maintainers\n- [ ] **egress**: @bob\n")
+
+	statuses := ParseSignOffChecklist(checklist)
+	assert.Equal(t, []types.SignOffStatus{
+		{Area: "network-policy", Owners: []string{"@alice", "@antrea-io/np-maintainers"}},
+		{Area: "egress", Owners: []string{"@bob"}},
+		{Area: "windows", Owners: []string{"@carol"}},
+	}, statuses)
+
+	// Items are checked by the owners on GitHub
+	checked := strings.Replace(checklist, "- [ ] **egress**", "- [x] **egress**", 1)
+	checked = strings.Replace(checked, "- [ ] **windows**", "- [X] **windows**", 1)
+	statuses = ParseSignOffChecklist(strings.ReplaceAll(checked, "\n", "\r\n"))
+	assert.False(t, statuses[0].SignedOff)
+	assert.True(t, statuses[1].SignedOff)
+	assert.True(t, statuses[2].SignedOff)
+
+	assert.Equal(t, `# Sign-off status of 2.5.0
+
+2 of 3 areas signed off (https://github.com/antrea-io/antrea/issues/7100#issuecomment-1).
+
+## Missing sign-offs
+
+- **network-policy**: @alice, @antrea-io/np-maintainers
+`, FormatSignOffStatus("2.5.0", "https://github.com/antrea-io/antrea/issues/7100#issuecomment-1", statuses))
+
+	statuses[0].SignedOff = true
+	assert.Equal(t, "# Sign-off status of 2.5.0\n\n3 of 3 areas signed off (url).\n", FormatSignOffStatus("2.5.0", "url", statuses))
+}
+
+func TestFindSignOffChecklist(t *testing.T) {
+	releaser := &gogithub.User{Login: gogithub.Ptr("antrea-bot")}
+	other := &gogithub.User{Login: gogithub.Ptr("mallory")}
+	comments := []*gogithub.IssueComment{
+		{ID: gogithub.Ptr(int64(1)), User: releaser, Body: gogithub.Ptr("<!-- release-signoff 2.4.0 -->\n- [x] **egress**: @bob")},
+		{ID: gogithub.Ptr(int64(2)), User: releaser, Body: gogithub.Ptr("<!-- release-signoff 2.5.0 -->\n- [ ] **egress**: @bob")},
+		{ID: gogithub.Ptr(int64(3)), User: other, Body: gogithub.Ptr("LGTM for egress")},
+		{ID: gogithub.Ptr(int64(4)), User: releaser, Body: gogithub.Ptr("<!-- release-signoff 2.5.0 -->\n- [x] **egress**: @bob")},
+		// A forged copy with all the areas checked
+		{ID: gogithub.Ptr(int64(5)), User: other, Body: gogithub.Ptr("<!-- release-signoff 2.5.0 -->\n- [x] **egress**: @bob")},
+		{ID: gogithub.Ptr(int64(6)), User: other, Body: gogithub.Ptr("<!-- release-signoff 2.5.1 -->\n- [x] **egress**: @bob")},
+	}
+	assert.Equal(t, int64(2), FindSignOffChecklist(comments, "2.5.0", "antrea-bot").GetID(), "The first checklist of the releaser should be used")
+	assert.Equal(t, int64(2), FindSignOffChecklist(comments, "2.5.0", "Antrea-Bot").GetID(), "Logins are case-insensitive")
+	assert.Equal(t, int64(1), FindSignOffChecklist(comments, "2.4.0", "antrea-bot").GetID())
+	assert.Nil(t, FindSignOffChecklist(comments, "2.5.1", "antrea-bot"), "Checklists of other users should be ignored")
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// revertBodyRegex matches the body GitHub generates for revert PRs: "Reverts antrea-io/antrea#1234"
+var revertBodyRegex = regexp.MustCompile(`(?i)\breverts\s+(?:[\w.-]+/[\w.-]+)?#(\d+)`)
+
+func newSkippedPR(pr types.PRInfo, reason types.SkipReason, detail string) types.SkippedPR {
+	return types.SkippedPR{
+		Number: pr.Number,
+		Title:  pr.Title,
+		Author: pr.Author,
+		Reason: reason,
+		Detail: detail,
+	}
+}
+
+// filterExcludedLabels filters out PRs with any of the excluded labels
+func filterExcludedLabels(prs []types.PRInfo, excludedLabels []string) ([]types.PRInfo, []types.SkippedPR) {
+	if len(excludedLabels) == 0 {
+		return prs, nil
+	}
+	excluded := make(map[string]bool)
+	for _, l := range excludedLabels {
+		excluded[l] = true
+	}
+
+	filtered := make([]types.PRInfo, 0, len(prs))
+	var skipped []types.SkippedPR
+	for _, pr := range prs {
+		label := ""
+		for _, l := range pr.Labels {
+			if excluded[l] {
+				label = l
+				break
+			}
+		}
+		if label != "" {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonExcludedLabel, label))
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered, skipped
+}
+
+// filterRevertPairs filters out PRs reverted within the same release, along
+// with the PRs reverting them. Reverts of PRs from earlier releases are kept,
+// as they are meaningful changes for this release.
+func filterRevertPairs(prs []types.PRInfo) ([]types.PRInfo, []types.SkippedPR) {
+	byNumber := make(map[int]bool)
+	for _, pr := range prs {
+		byNumber[pr.Number] = true
+	}
+
+	// Map reverted PR number -> reverting PR number
+	reverted := make(map[int]int)
+	for _, pr := range prs {
+		if !strings.HasPrefix(pr.Title, "Revert ") {
+			continue
+		}
+		m := revertBodyRegex.FindStringSubmatch(pr.Body)
+		if m == nil {
+			continue
+		}
+		original, err := strconv.Atoi(m[1])
+		if err != nil || !byNumber[original] {
+			continue
+		}
+		reverted[original] = pr.Number
+	}
+	if len(reverted) == 0 {
+		return prs, nil
+	}
+	reverting := make(map[int]int)
+	for original, revert := range reverted {
+		reverting[revert] = original
+	}
+
+	filtered := make([]types.PRInfo, 0, len(prs))
+	var skipped []types.SkippedPR
+	for _, pr := range prs {
+		if revert, ok := reverted[pr.Number]; ok {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonRevertPair, fmt.Sprintf("reverted by #%d", revert)))
+			continue
+		}
+		if original, ok := reverting[pr.Number]; ok {
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonRevertPair, fmt.Sprintf("reverts #%d", original)))
+			continue
+		}
+		filtered = append(filtered, pr)
+	}
+	return filtered, skipped
+}
+
+// skippedFromResponse returns the model entries the formatter leaves out of the CHANGELOG
+func skippedFromResponse(response *types.ModelResponse, prs []types.PRInfo, categories []config.Category, thresholds config.Thresholds) []types.SkippedPR {
+	byNumber := make(map[int]types.PRInfo)
+	for _, pr := range prs {
+		byNumber[pr.Number] = pr
+	}
+
+	var skipped []types.SkippedPR
+	for _, change := range response.Changes {
+		pr, ok := byNumber[change.PRNumber]
+		if !ok {
+			pr = types.PRInfo{Number: change.PRNumber, Author: change.Author}
+		}
+		switch {
+		case change.IncludeScore < thresholds.Optional:
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonLowIncludeScore,
+				fmt.Sprintf("include_score %d < %d: %s", change.IncludeScore, thresholds.Optional, change.Description)))
+		case !isKnownCategory(change.Category, categories):
+			skipped = append(skipped, newSkippedPR(pr, types.SkipReasonUnknownCategory,
+				fmt.Sprintf("category %q: %s", change.Category, change.Description)))
+		}
+	}
+	return skipped
+}
+
+// entryWarnings returns the warnings about the entries of a model response:
+// entries dropped because of an unknown category, and entries of PRs found in
+// a historical CHANGELOG which were not reused
+func entryWarnings(response *types.ModelResponse, prCache map[int]types.HistoricalPR, categories []config.Category, thresholds config.Thresholds) []types.Warning {
+	var warnings []types.Warning
+	for _, change := range response.Changes {
+		if change.IncludeScore < thresholds.Optional {
+			continue
+		}
+		if !isKnownCategory(change.Category, categories) {
+			warnings = append(warnings, types.Warning{Kind: types.WarningKindDroppedEntry, PRNumber: change.PRNumber,
+				Message: fmt.Sprintf("entry of PR #%d has unknown category %q and is not rendered: %s", change.PRNumber, change.Category, change.Description)})
+			continue
+		}
+		if historical, ok := prCache[change.PRNumber]; ok && !change.ReusedFromHistory {
+			warnings = append(warnings, types.Warning{Kind: types.WarningKindReuseViolation, PRNumber: change.PRNumber,
+				Message: fmt.Sprintf("PR #%d is in the %s CHANGELOG, but its entry was not reused", change.PRNumber, historical.Release)})
+		}
+	}
+	return warnings
+}
+
+// FormatSkippedReport renders the skipped PRs as a markdown report for
+// reviewers, followed by the PR overrides of the run, if any
+func FormatSkippedReport(release string, skipped []types.SkippedPR, overrides []types.PROverride) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# PRs skipped from the %s CHANGELOG\n\n", release))
+	if len(skipped) == 0 {
+		sb.WriteString("No PRs were skipped.\n")
+	} else {
+		sb.WriteString("| PR | Title | Author | Reason | Detail |\n")
+		sb.WriteString("|----|-------|--------|--------|--------|\n")
+		for _, s := range skipped {
+			sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s | %s | %s |\n",
+				s.Number, repoOwner, repoName, s.Number, escapeTableCell(s.Title), escapeTableCell(s.Author), s.Reason, escapeTableCell(s.Detail)))
+		}
+	}
+
+	if len(overrides) > 0 {
+		sb.WriteString("\n## Overrides\n\n")
+		sb.WriteString("These PRs were forcibly included or excluded for this run with --include-prs and --exclude-prs.\n\n")
+		sb.WriteString("| PR | Title | Author | Override | Detail |\n")
+		sb.WriteString("|----|-------|--------|----------|--------|\n")
+		for _, o := range overrides {
+			override := "excluded"
+			if o.Included {
+				override = "included"
+			}
+			sb.WriteString(fmt.Sprintf("| [#%d](https://github.com/%s/%s/pull/%d) | %s | %s | %s | %s |\n",
+				o.Number, repoOwner, repoName, o.Number, escapeTableCell(o.Title), escapeTableCell(o.Author), override, escapeTableCell(o.Detail)))
+		}
+	}
+	return sb.String()
+}
+
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestFilterRevertPairs(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 100, Title: "Add feature X"},
+		{Number: 101, Title: `Revert "Add feature X"`, Body: "Reverts antrea-io/antrea#100"},
+		{Number: 102, Title: `Revert "Add feature Y"`, Body: "Reverts antrea-io/antrea#50"},
+		{Number: 103, Title: "Fix bug Z"},
+	}
+
+	filtered, skipped := filterRevertPairs(prs)
+
+	var numbers []int
+	for _, pr := range filtered {
+		numbers = append(numbers, pr.Number)
+	}
+	assert.Equal(t, []int{102, 103}, numbers, "Revert of a PR from an earlier release should be kept")
+
+	require.Len(t, skipped, 2)
+	assert.Equal(t, types.SkippedPR{Number: 100, Title: "Add feature X", Reason: types.SkipReasonRevertPair, Detail: "reverted by #101"}, skipped[0])
+	assert.Equal(t, "reverts #100", skipped[1].Detail)
+}
+
+func TestFilterExcludedLabels(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 1, Labels: []string{"area/agent", "kind/documentation"}},
+		{Number: 2, Labels: []string{"area/agent"}},
+	}
+
+	filtered, skipped := filterExcludedLabels(prs, []string{"kind/documentation"})
+
+	require.Len(t, filtered, 1)
+	assert.Equal(t, 2, filtered[0].Number)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, types.SkipReasonExcludedLabel, skipped[0].Reason)
+	assert.Equal(t, "kind/documentation", skipped[0].Detail)
+}
+
+func TestFormatSkippedReport(t *testing.T) {
+	report := FormatSkippedReport("2.5.0", []types.SkippedPR{
+		{Number: 1, Title: "Bump foo | bar", Author: "renovate[bot]", Reason: types.SkipReasonBotAuthor, Detail: "renovate[bot]"},
+	}, nil)
+
+	assert.Contains(t, report, "# PRs skipped from the 2.5.0 CHANGELOG")
+	assert.Contains(t, report, "| [#1](https://github.com/antrea-io/antrea/pull/1) | Bump foo \\| bar | renovate[bot] | bot author | renovate[bot] |")
+	assert.Contains(t, FormatSkippedReport("2.5.0", nil, nil), "No PRs were skipped.")
+	assert.NotContains(t, report, "## Overrides")
+
+	report = FormatSkippedReport("2.5.0", nil, []types.PROverride{
+		{Number: 2, Title: "Bump Go to 1.25", Author: "renovate[bot]", Included: true, Detail: "bypassed bot author (renovate[bot])"},
+	})
+	assert.Contains(t, report, "## Overrides")
+	assert.Contains(t, report, "| [#2](https://github.com/antrea-io/antrea/pull/2) | Bump Go to 1.25 | renovate[bot] | included | bypassed bot author (renovate[bot]) |")
+}
+
+func TestEntryWarnings(t *testing.T) {
+	categories := []config.Category{{Name: "ADDED"}, {Name: "FIXED"}}
+	thresholds := config.Thresholds{Include: 70, Optional: 40}
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 1, IncludeScore: 90, Category: "REMOVED", Description: "Remove X."},
+		{PRNumber: 2, IncludeScore: 90, Category: "FIXED", Description: "Fix Y."},
+		{PRNumber: 3, IncludeScore: 90, Category: "FIXED", Description: "Fix Z.", ReusedFromHistory: true},
+		{PRNumber: 4, IncludeScore: 10, Category: "REMOVED"},
+	}}
+	prCache := map[int]types.HistoricalPR{
+		2: {Release: "2.4.1"},
+		3: {Release: "2.4.1"},
+	}
+
+	warnings := entryWarnings(response, prCache, categories, thresholds)
+
+	require.Len(t, warnings, 2)
+	assert.Equal(t, types.WarningKindDroppedEntry, warnings[0].Kind)
+	assert.Equal(t, 1, warnings[0].PRNumber)
+	assert.Equal(t, types.WarningKindReuseViolation, warnings[1].Kind)
+	assert.Equal(t, "reuse violation: PR #2 is in the 2.4.1 CHANGELOG, but its entry was not reused", warnings[1].String())
+}
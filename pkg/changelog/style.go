@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// styleVerbs are the leading verbs of descriptions, in the imperative mood and
+// in the past tense. Verbs whose past tense is commonly an adjective starting
+// a description (e.g. "Deprecated fields") are left out.
+var styleVerbs = [][2]string{
+	{"Add", "Added"}, {"Allow", "Allowed"}, {"Avoid", "Avoided"}, {"Bump", "Bumped"},
+	{"Change", "Changed"}, {"Disable", "Disabled"}, {"Document", "Documented"},
+	{"Drop", "Dropped"}, {"Enable", "Enabled"}, {"Enhance", "Enhanced"}, {"Ensure", "Ensured"},
+	{"Expose", "Exposed"}, {"Extend", "Extended"}, {"Fix", "Fixed"}, {"Graduate", "Graduated"},
+	{"Handle", "Handled"}, {"Implement", "Implemented"}, {"Improve", "Improved"}, {"Increase", "Increased"},
+	{"Introduce", "Introduced"}, {"Make", "Made"}, {"Move", "Moved"}, {"Optimize", "Optimized"},
+	{"Prevent", "Prevented"}, {"Promote", "Promoted"}, {"Reduce", "Reduced"}, {"Refactor", "Refactored"},
+	{"Remove", "Removed"}, {"Rename", "Renamed"}, {"Replace", "Replaced"}, {"Skip", "Skipped"},
+	{"Stop", "Stopped"}, {"Support", "Supported"}, {"Update", "Updated"}, {"Upgrade", "Upgraded"},
+}
+
+// stylePrompts are the prompt instructions of the style profiles
+var stylePrompts = map[config.StyleProfile]string{
+	config.StyleAntreaClassic: `## Description Style
+
+Write each description in the imperative mood, starting with a verb such as "Add", "Fix", "Support" or "Improve" (e.g.
+"Fix Pod connectivity loss after antrea-agent restart"), like the historical CHANGELOGs.
+
+`,
+	config.StyleKubernetes: `## Description Style
+
+Write each description in the past tense, as a sentence saying what changed for users, starting with a verb such as "Added",
+"Fixed", "Changed" or "Improved" (e.g. "Fixed a bug where Pods lost connectivity after an antrea-agent restart"). This style
+takes precedence over the wording of the historical CHANGELOGs, which remain the reference for the level of detail; entries
+marked with **HISTORICAL ENTRY (MUST REUSE)** are still reused as is.
+
+`,
+}
+
+// stylePrompt returns the prompt instructions of a style profile
+func stylePrompt(style config.StyleProfile) string {
+	if prompt, ok := stylePrompts[style]; ok {
+		return prompt
+	}
+	return stylePrompts[config.StyleAntreaClassic]
+}
+
+// styleLinkStyle returns the link style rendered for LinkStyleAuto with a
+// style profile
+func styleLinkStyle(style config.StyleProfile) LinkStyle {
+	if style == config.StyleKubernetes {
+		return LinkStyleInline
+	}
+	return LinkStyleMixed
+}
+
+// applyStyle rewrites the leading verb of the descriptions which do not follow
+// the style profile (e.g. "Fixed" for antrea-classic, "Fix" for
+// kubernetes-style), so that the entries are consistent whatever the model
+// wrote. Historical entries are reused as is. It returns the PRs of the
+// rewritten descriptions.
+func applyStyle(response *types.ModelResponse, style config.StyleProfile) []int {
+	var rewritten []int
+	for i := range response.Changes {
+		change := &response.Changes[i]
+		if change.ReusedFromHistory {
+			continue
+		}
+		if description := styleDescription(change.Description, style); description != change.Description {
+			change.Description = description
+			rewritten = append(rewritten, change.PRNumber)
+		}
+	}
+	return rewritten
+}
+
+// styleDescription returns the description with its leading verb in the form
+// of the style profile
+func styleDescription(description string, style config.StyleProfile) string {
+	first, rest, _ := strings.Cut(description, " ")
+	for _, verbs := range styleVerbs {
+		from, to := verbs[1], verbs[0]
+		if style == config.StyleKubernetes {
+			from, to = verbs[0], verbs[1]
+		}
+		// Only capitalized verbs are rewritten, as descriptions start with a
+		// capital letter unless they start with an identifier (e.g. "antctl")
+		if first != from {
+			continue
+		}
+		if rest == "" {
+			return to
+		}
+		return to + " " + rest
+	}
+	return description
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestStyleDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		style       config.StyleProfile
+		expected    string
+	}{
+		{name: "classic past tense", description: "Added support for IPv6 in Egress", style: config.StyleAntreaClassic, expected: "Add support for IPv6 in Egress"},
+		{name: "classic irregular", description: "Made the sync period configurable", style: config.StyleAntreaClassic, expected: "Make the sync period configurable"},
+		{name: "classic unchanged", description: "Fix route deletion", style: config.StyleAntreaClassic, expected: "Fix route deletion"},
+		{name: "default is classic", description: "Fixed route deletion", expected: "Fix route deletion"},
+		{name: "kubernetes imperative", description: "Fix route deletion", style: config.StyleKubernetes, expected: "Fixed route deletion"},
+		{name: "kubernetes unchanged", description: "Upgraded Open vSwitch to 3.5", style: config.StyleKubernetes, expected: "Upgraded Open vSwitch to 3.5"},
+		{name: "single word", description: "Refactor", style: config.StyleKubernetes, expected: "Refactored"},
+		{name: "adjective", description: "Deprecated CRD fields are removed", style: config.StyleAntreaClassic, expected: "Deprecated CRD fields are removed"},
+		{name: "identifier", description: "antctl supports the --output flag", style: config.StyleKubernetes, expected: "antctl supports the --output flag"},
+		{name: "lowercase verb", description: "fix route deletion", style: config.StyleKubernetes, expected: "fix route deletion"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, styleDescription(tt.description, tt.style))
+		})
+	}
+}
+
+func TestApplyStyle(t *testing.T) {
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Description: "Add NodeLatencyMonitor"},
+		{PRNumber: 101, Description: "Fix Service connectivity", ReusedFromHistory: true},
+		{PRNumber: 102, Description: "Improved the performance of NetworkPolicy computation"},
+	}}
+	assert.Equal(t, []int{100}, applyStyle(response, config.StyleKubernetes))
+	assert.Equal(t, "Added NodeLatencyMonitor", response.Changes[0].Description)
+	assert.Equal(t, "Fix Service connectivity", response.Changes[1].Description)
+	assert.Equal(t, "Improved the performance of NetworkPolicy computation", response.Changes[2].Description)
+}
+
+func TestStylePrompt(t *testing.T) {
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil, WithStyleProfile(config.StyleKubernetes))
+	promptText := generator.buildPrompt("", []types.PRInfo{{Number: 100, Title: "Fix route deletion"}}, nil)
+	assert.Contains(t, promptText, "## Description Style\n\nWrite each description in the past tense")
+	assert.Equal(t, LinkStyleInline, styleLinkStyle(config.StyleKubernetes))
+
+	generator = NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil)
+	promptText = generator.buildPrompt("", []types.PRInfo{{Number: 100, Title: "Fix route deletion"}}, nil)
+	assert.Contains(t, promptText, "## Description Style\n\nWrite each description in the imperative mood")
+	assert.Equal(t, LinkStyleMixed, styleLinkStyle(""))
+}
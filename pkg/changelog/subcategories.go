@@ -0,0 +1,84 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// subcategoriesPrompt returns the prompt section asking the model to assign
+// the entries of the categories with subcategories to one of them, or "" if no
+// category has subcategories
+func subcategoriesPrompt(categories []config.Category) string {
+	var sb strings.Builder
+	for _, category := range categories {
+		if len(category.Subcategories) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("- %s:\n", category.Name))
+		for _, sub := range category.Subcategories {
+			if sub.Description != "" {
+				sb.WriteString(fmt.Sprintf("  - %s: %s\n", sub.Name, sub.Description))
+			} else {
+				sb.WriteString(fmt.Sprintf("  - %s\n", sub.Name))
+			}
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "## Subcategories\n\n" +
+		"Some categories are split into subcategories, listed below. For each entry of these categories, set the `subcategory`\n" +
+		"field to the name of the subcategory the change belongs to, exactly as written below. Leave it empty if none fits; do not\n" +
+		"invent new subcategories, and do not set it for the entries of the other categories.\n\n" +
+		sb.String() + "\n"
+}
+
+// groupBySubcategory splits the entries of a category into its subcategories,
+// in configuration order, followed by the entries assigned to no subcategory
+// (or to an unknown one) in OtherSubcategory. Empty subcategories are omitted.
+// Entries keep their order within each subcategory.
+func groupBySubcategory(category config.Category, changes []types.ChangeEntry) ([]string, map[string][]types.ChangeEntry) {
+	names := make([]string, 0, len(category.Subcategories)+1)
+	canonical := make(map[string]string)
+	for _, sub := range category.Subcategories {
+		names = append(names, sub.Name)
+		canonical[strings.ToLower(sub.Name)] = sub.Name
+	}
+	if _, ok := canonical[strings.ToLower(config.OtherSubcategory)]; !ok {
+		names = append(names, config.OtherSubcategory)
+	}
+
+	bySubcategory := make(map[string][]types.ChangeEntry)
+	for _, change := range changes {
+		name, ok := canonical[strings.ToLower(strings.TrimSpace(change.Subcategory))]
+		if !ok {
+			name = config.OtherSubcategory
+		}
+		bySubcategory[name] = append(bySubcategory[name], change)
+	}
+
+	var nonEmpty []string
+	for _, name := range names {
+		if len(bySubcategory[name]) > 0 {
+			nonEmpty = append(nonEmpty, name)
+		}
+	}
+	return nonEmpty, bySubcategory
+}
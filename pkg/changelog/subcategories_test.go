@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func subcategorizedCategories() []config.Category {
+	categories := config.DefaultCategories()
+	categories[0].Subcategories = []config.Subcategory{
+		{Name: "Networking", Description: "CNI, Egress, Multicast, Service proxy"},
+		{Name: "Windows"},
+	}
+	return categories
+}
+
+func TestFormatChangelog_Subcategories(t *testing.T) {
+	ver, err := version.Parse("2.5.0")
+	require.NoError(t, err)
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "ADDED", Subcategory: "windows", Description: "Add Egress support for Windows", IncludeScore: 80, ImportanceScore: 50, Author: "alice"},
+		{PRNumber: 101, Category: "ADDED", Subcategory: "Networking", Description: "Add Egress bandwidth limits", IncludeScore: 80, ImportanceScore: 90, Author: "bob"},
+		{PRNumber: 102, Category: "ADDED", Subcategory: "Storage", Description: "Add antctl get memberlist", IncludeScore: 80, ImportanceScore: 70, Author: "carol"},
+		{PRNumber: 103, Category: "FIXED", Subcategory: "Networking", Description: "Fix agent crash", IncludeScore: 80, ImportanceScore: 70, Author: "alice"},
+	}}
+	opts := formatOptions{
+		categories: subcategorizedCategories(),
+		thresholds: config.DefaultThresholds(),
+		date:       time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	changelogText := formatChangelog(ver, response, opts)
+	require.NoError(t, Validate(changelogText))
+	assert.Contains(t, changelogText, "### Added\n\n"+
+		"#### Networking\n\n"+
+		"- Add Egress bandwidth limits. ([#101](https://github.com/antrea-io/antrea/pull/101), [@bob])\n\n"+
+		"#### Windows\n\n"+
+		"- Add Egress support for Windows. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])\n\n"+
+		"#### Other\n\n"+
+		"- Add antctl get memberlist. ([#102](https://github.com/antrea-io/antrea/pull/102), [@carol])\n\n")
+	assert.Contains(t, changelogText, "### Fixed\n\n- Fix agent crash.", "categories without subcategories are flat")
+	assert.Contains(t, FormatEmail(changelogText), "Networking\n~~~~~~~~~~\n")
+
+	// Entries are not split if none was assigned to a subcategory
+	for i := range response.Changes {
+		response.Changes[i].Subcategory = ""
+	}
+	changelogText = formatChangelog(ver, response, opts)
+	assert.NotContains(t, changelogText, "####")
+
+	prCache := make(map[int]types.HistoricalPR)
+	parseCHANGELOGEntries(formatChangelog(ver, &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "ADDED", Subcategory: "Windows", Description: "Add Egress support for Windows", IncludeScore: 80, Author: "alice"},
+		{PRNumber: 101, Category: "ADDED", Description: "Add Egress bandwidth limits", IncludeScore: 80, Author: "bob"},
+	}}, opts), opts.categories, prCache)
+	assert.Equal(t, "ADDED", prCache[100].Category)
+	assert.Equal(t, "ADDED", prCache[101].Category, "the category is kept after the subcategory headers")
+}
+
+func TestSubcategoriesPrompt(t *testing.T) {
+	assert.Empty(t, subcategoriesPrompt(config.DefaultCategories()))
+
+	promptText := subcategoriesPrompt(subcategorizedCategories())
+	assert.Contains(t, promptText, "## Subcategories\n")
+	assert.Contains(t, promptText, "- ADDED:\n  - Networking: CNI, Egress, Multicast, Service proxy\n  - Windows\n")
+	assert.NotContains(t, promptText, "FIXED")
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// entryPRRegex extracts the PR numbers linked by a CHANGELOG entry
+var entryPRRegex = regexp.MustCompile(`\[#(\d+)\]`)
+
+// summaryEntry is an entry of a minor release summary
+type summaryEntry struct {
+	line    string
+	words   map[string]bool
+	prs     []string
+	release string
+}
+
+// SummarizeMinor consolidates all the X.Y.Z release sections of a
+// CHANGELOG-X.Y.md file into a single list of entries per category, for
+// retrospectives when a minor release reaches its end of life. An entry is
+// superseded by a later entry of the same category linking the same PR or
+// with a near-identical description (e.g. a follow-up fix for the same
+// issue), in which case only the later entry is kept, at the position of the
+// earlier one.
+func SummarizeMinor(minor, content string, categories []config.Category) (string, error) {
+	minorVer, err := version.Parse(minor + ".0")
+	if err != nil {
+		return "", fmt.Errorf("invalid minor release %q: %w", minor, err)
+	}
+	prefix := fmt.Sprintf("%d.%d.", minorVer.Major(), minorVer.Minor())
+
+	links := make(map[string]string)
+	_, sections := splitSections(content, links)
+	var releases []*version.Version
+	bySection := make(map[string]changelogSection)
+	for _, s := range sections {
+		if !strings.HasPrefix(s.version, prefix) {
+			continue
+		}
+		v, err := version.Parse(s.version)
+		if err != nil {
+			continue
+		}
+		releases = append(releases, v)
+		bySection[v.String()] = s
+	}
+	if len(releases) == 0 {
+		return "", fmt.Errorf("no %sZ release section found", prefix)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[j].GreaterThan(releases[i])
+	})
+
+	entries := make(map[string][]summaryEntry)
+	for _, release := range releases {
+		category := ""
+		for _, line := range bySection[release.String()].lines {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "### ") {
+				category = categoryForHeader(strings.TrimSpace(strings.TrimPrefix(trimmed, "### ")), categories)
+				if !isKnownCategory(category, categories) {
+					// Other sections (e.g. callouts) only repeat entries
+					category = ""
+				}
+				continue
+			}
+			if category == "" || !strings.HasPrefix(trimmed, "- ") {
+				continue
+			}
+			entry := summaryEntry{line: trimmed, release: release.String()}
+			description := trimmed
+			if idx := strings.Index(trimmed, "([#"); idx > 0 {
+				description = trimmed[:idx]
+			}
+			description = entryAnchorRegex.ReplaceAllString(description, "")
+			entry.words = descriptionWords(description)
+			for _, m := range entryPRRegex.FindAllStringSubmatch(trimmed, -1) {
+				entry.prs = append(entry.prs, m[1])
+			}
+			entries[category] = addSummaryEntry(entries[category], entry)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Changelog %d.%d Summary\n\n", minorVer.Major(), minorVer.Minor()))
+	sb.WriteString(fmt.Sprintf("## %s - %s\n\n", releases[0], releases[len(releases)-1]))
+	sb.WriteString(fmt.Sprintf("Consolidated changes of the %d %d.%d releases.\n\n", len(releases), minorVer.Major(), minorVer.Minor()))
+	var authors []string
+	seen := make(map[string]bool)
+	for _, c := range categories {
+		if len(entries[c.Name]) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("### %s\n\n", c.Header))
+		for _, e := range entries[c.Name] {
+			sb.WriteString(e.line)
+			sb.WriteString("\n")
+			for _, m := range authorRefRegex.FindAllStringSubmatch(e.line, -1) {
+				if m[2] == "" && !seen[m[1]] {
+					seen[m[1]] = true
+					authors = append(authors, m[1])
+				}
+			}
+		}
+		sb.WriteString("\n")
+	}
+	writeLinkDefinitions(&sb, authors, links)
+	return strings.TrimRight(sb.String(), "\n") + "\n", nil
+}
+
+// addSummaryEntry adds an entry to the entries of a category, replacing the
+// entry it supersedes if any
+func addSummaryEntry(entries []summaryEntry, entry summaryEntry) []summaryEntry {
+	for i, e := range entries {
+		if !sharePR(e.prs, entry.prs) && jaccard(e.words, entry.words) < minDuplicateSimilarity {
+			continue
+		}
+		log.Printf("Entry from %s supersedes entry from %s: %s", entry.release, e.release, e.line)
+		entries[i] = entry
+		return entries
+	}
+	return append(entries, entry)
+}
+
+func sharePR(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+)
+
+const minorChangelog = `# Changelog 2.4
+
+## 2.4.2 - 2025-11-01
+
+### Fixed
+
+- Fix Agent crash when the NodePortLocal port range is exhausted. ([#130](https://github.com/antrea-io/antrea/pull/130), [@carol])
+- Fix flow export to IPFIX collectors. ([#131](https://github.com/antrea-io/antrea/pull/131), [@alice])
+
+[@alice]: https://github.com/alice
+[@carol]: https://github.com/carol
+
+## 2.4.1 - 2025-10-01
+
+### Fixed
+
+- Fix Agent crash when the NodePortLocal port range is full. ([#120](https://github.com/antrea-io/antrea/pull/120), [@bob])
+
+### Windows
+
+- Fix Agent crash when the NodePortLocal port range is full. ([#120](https://github.com/antrea-io/antrea/pull/120), [@bob])
+
+[@bob]: https://github.com/bob
+
+## 2.4.0 - 2025-09-01
+
+### Added
+
+- Add Egress bandwidth limits. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+### Fixed
+
+- Fix memory leak in the FlowAggregator. ([#110](https://github.com/antrea-io/antrea/pull/110), [@bob])
+`
+
+func TestSummarizeMinor(t *testing.T) {
+	summary, err := SummarizeMinor("2.4", minorChangelog, config.DefaultCategories())
+	require.NoError(t, err)
+
+	expected := `# Changelog 2.4 Summary
+
+## 2.4.0 - 2.4.2
+
+Consolidated changes of the 3 2.4 releases.
+
+### Added
+
+- Add Egress bandwidth limits. ([#100](https://github.com/antrea-io/antrea/pull/100), [@alice])
+
+### Fixed
+
+- Fix memory leak in the FlowAggregator. ([#110](https://github.com/antrea-io/antrea/pull/110), [@bob])
+- Fix Agent crash when the NodePortLocal port range is exhausted. ([#130](https://github.com/antrea-io/antrea/pull/130), [@carol])
+- Fix flow export to IPFIX collectors. ([#131](https://github.com/antrea-io/antrea/pull/131), [@alice])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+[@carol]: https://github.com/carol
+`
+	assert.Equal(t, expected, summary)
+	assert.NoError(t, Validate(summary))
+
+	_, err = SummarizeMinor("2.5", minorChangelog, config.DefaultCategories())
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry reports anonymized metrics of the runs to an endpoint
+// chosen by the user, so that the maintainers of the tool can see its
+// real-world performance. It is disabled unless an endpoint is configured.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Outcomes of a run which did not fail. A failed run reports its failure
+// class instead (e.g. "model" or "github").
+const (
+	OutcomeSuccess             = "success"
+	OutcomeSuccessWithWarnings = "success_with_warnings"
+)
+
+// SendTimeout bounds the time the report adds to a run
+const SendTimeout = 5 * time.Second
+
+// Report holds the anonymized metrics of a run. It has no release, repository,
+// PR, author, file path or credential.
+type Report struct {
+	ToolVersion string `json:"tool_version"`
+	OS          string `json:"os"`
+	Arch        string `json:"arch"`
+	// Model is the model name for the Gemini API, or "custom" for an
+	// OpenAI-compatible API, whose model names may be private
+	Model string `json:"model"`
+	// Mode is "release", "train" or "pr-readiness"
+	Mode             string  `json:"mode"`
+	Releases         int     `json:"releases"`
+	DurationSeconds  float64 `json:"duration_seconds"`
+	ModelCalls       int     `json:"model_calls"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CandidatesTokens int64   `json:"candidates_tokens"`
+	TotalTokens      int64   `json:"total_tokens"`
+	EstimatedCostUSD float64 `json:"estimated_cost_usd"`
+	// Outcome is OutcomeSuccess, OutcomeSuccessWithWarnings or the failure
+	// class of the run
+	Outcome  string `json:"outcome"`
+	ExitCode int    `json:"exit_code"`
+}
+
+// Send posts the report as JSON to the endpoint. Telemetry is best effort, the
+// caller is expected to only log the error.
+func Send(ctx context.Context, client *http.Client, endpoint string, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to marshal telemetry report: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, SendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create telemetry request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send telemetry report: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint returned HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
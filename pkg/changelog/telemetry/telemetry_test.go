@@ -0,0 +1,65 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	report := Report{
+		ToolVersion:      "v1.2.0",
+		OS:               "linux",
+		Arch:             "amd64",
+		Model:            "gemini-2.5-flash",
+		Mode:             "release",
+		Releases:         1,
+		DurationSeconds:  42.5,
+		ModelCalls:       2,
+		PromptTokens:     1000,
+		CandidatesTokens: 200,
+		TotalTokens:      1200,
+		EstimatedCostUSD: 0.01,
+		Outcome:          OutcomeSuccess,
+	}
+	var received Report
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	require.NoError(t, Send(context.Background(), server.Client(), server.URL, report))
+	assert.Equal(t, report, received)
+}
+
+func TestSend_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := Send(context.Background(), server.Client(), server.URL, Report{Outcome: "model", ExitCode: 5})
+	assert.EqualError(t, err, "telemetry endpoint returned HTTP 503")
+}
@@ -0,0 +1,361 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testsupport provides in-memory fakes of the external services, for
+// end-to-end tests exercising the real clients rather than interface mocks.
+package testsupport
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	gogithub "github.com/google/go-github/v76/github"
+)
+
+// defaultPerPage is the page size of the GitHub API when per_page is not set
+const defaultPerPage = 30
+
+// FakeGitHub is an in-memory GitHub REST API server for a single repository,
+// serving the contents, refs, tags, commits and pulls endpoints used by the
+// GitHub client. List endpoints are paginated with Link headers like the
+// real API.
+type FakeGitHub struct {
+	// Owner and Repo are the repository served, requests for other
+	// repositories get a 404
+	Owner string
+	Repo  string
+	// DefaultBranch is the ref of the contents requests without ref (default: main)
+	DefaultBranch string
+	// MaxPerPage caps the page size of list responses, whatever the
+	// requested per_page, to exercise pagination (0 for no cap)
+	MaxPerPage int
+
+	server *httptest.Server
+
+	mutex    sync.Mutex
+	files    map[string]map[string]string
+	refs     map[string]string
+	tags     []*gogithub.RepositoryTag
+	commits  map[string]*gogithub.Commit
+	pulls    []*gogithub.PullRequest
+	prFiles  map[int][]string
+	requests []string
+}
+
+// NewFakeGitHub starts a FakeGitHub server, which is closed at the end of the test
+func NewFakeGitHub(t testing.TB, owner, repo string) *FakeGitHub {
+	t.Helper()
+	f := NewFakeGitHubServer(owner, repo)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// NewFakeGitHubServer starts a FakeGitHub server outside of a test, e.g. for
+// a self-test of the tool. The server must be stopped with Close.
+func NewFakeGitHubServer(owner, repo string) *FakeGitHub {
+	f := &FakeGitHub{
+		Owner:         owner,
+		Repo:          repo,
+		DefaultBranch: "main",
+		files:         make(map[string]map[string]string),
+		refs:          make(map[string]string),
+		commits:       make(map[string]*gogithub.Commit),
+		prFiles:       make(map[int][]string),
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// Close stops the server
+func (f *FakeGitHub) Close() {
+	f.server.Close()
+}
+
+// URL returns the base URL of the API, to be used as the base URL of the client
+func (f *FakeGitHub) URL() *url.URL {
+	u, _ := url.Parse(f.server.URL + "/")
+	return u
+}
+
+// Requests returns the method and URI of the requests received so far, e.g.
+// "GET /repos/antrea-io/antrea/pulls?page=2&per_page=100"
+func (f *FakeGitHub) Requests() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+// AddFile adds a file to a ref, "" for the default branch
+func (f *FakeGitHub) AddFile(ref, filePath, content string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if ref == "" {
+		ref = f.DefaultBranch
+	}
+	if f.files[ref] == nil {
+		f.files[ref] = make(map[string]string)
+	}
+	f.files[ref][filePath] = content
+}
+
+// AddTag adds a tag pointing to a commit, which is added with its committer
+// date if not known yet
+func (f *FakeGitHub) AddTag(name string, commit *gogithub.Commit) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	sha := commit.GetSHA()
+	f.refs["tags/"+name] = sha
+	f.tags = append(f.tags, &gogithub.RepositoryTag{Name: gogithub.Ptr(name), Commit: &gogithub.Commit{SHA: gogithub.Ptr(sha)}})
+	if _, ok := f.commits[sha]; !ok {
+		f.commits[sha] = commit
+	}
+}
+
+// AddBranch adds a branch pointing to a commit
+func (f *FakeGitHub) AddBranch(name, sha string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.refs["heads/"+name] = sha
+}
+
+// AddCommit adds a commit, which must have a SHA
+func (f *FakeGitHub) AddCommit(commit *gogithub.Commit) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.commits[commit.GetSHA()] = commit
+}
+
+// AddPullRequest adds a pull request and the paths of the files it changes.
+// Pull requests without UpdatedAt are considered updated when merged.
+func (f *FakeGitHub) AddPullRequest(pr *gogithub.PullRequest, files ...string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if pr.UpdatedAt == nil {
+		pr.UpdatedAt = pr.MergedAt
+	}
+	f.pulls = append(f.pulls, pr)
+	f.prFiles[pr.GetNumber()] = files
+}
+
+func (f *FakeGitHub) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+
+	prefix := fmt.Sprintf("/repos/%s/%s/", f.Owner, f.Repo)
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, prefix) {
+		notFound(w)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	switch {
+	case parts[0] == "contents":
+		f.serveContents(w, r, strings.Join(parts[1:], "/"))
+	case parts[0] == "tags" && len(parts) == 1:
+		writePage(w, r, f.tags, f.MaxPerPage)
+	case parts[0] == "git" && len(parts) > 2 && parts[1] == "ref":
+		sha, ok := f.refs[strings.Join(parts[2:], "/")]
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, &gogithub.Reference{
+			Ref:    gogithub.Ptr("refs/" + strings.Join(parts[2:], "/")),
+			Object: &gogithub.GitObject{Type: gogithub.Ptr("commit"), SHA: gogithub.Ptr(sha)},
+		})
+	case parts[0] == "git" && len(parts) == 3 && parts[1] == "commits":
+		commit, ok := f.commits[parts[2]]
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, commit)
+	case parts[0] == "commits" && len(parts) == 3 && parts[2] == "pulls":
+		var pulls []*gogithub.PullRequest
+		for _, pr := range f.pulls {
+			if pr.GetMergeCommitSHA() == parts[1] {
+				pulls = append(pulls, pr)
+			}
+		}
+		writePage(w, r, pulls, f.MaxPerPage)
+	case parts[0] == "pulls" && len(parts) == 1:
+		writePage(w, r, f.listPulls(r.URL.Query()), f.MaxPerPage)
+	case parts[0] == "pulls" && len(parts) >= 2:
+		number, err := strconv.Atoi(parts[1])
+		pr := f.pull(number)
+		if err != nil || pr == nil {
+			notFound(w)
+			return
+		}
+		if len(parts) == 2 {
+			writeJSON(w, pr)
+			return
+		}
+		if len(parts) == 3 && parts[2] == "files" {
+			var files []*gogithub.CommitFile
+			for _, filename := range f.prFiles[number] {
+				files = append(files, &gogithub.CommitFile{Filename: gogithub.Ptr(filename)})
+			}
+			writePage(w, r, files, f.MaxPerPage)
+			return
+		}
+		notFound(w)
+	default:
+		notFound(w)
+	}
+}
+
+// serveContents serves a file, or the entries of a directory
+func (f *FakeGitHub) serveContents(w http.ResponseWriter, r *http.Request, filePath string) {
+	ref := r.URL.Query().Get("ref")
+	if ref == "" {
+		ref = f.DefaultBranch
+	}
+	files := f.files[ref]
+	if content, ok := files[filePath]; ok {
+		sum := sha1.Sum([]byte(content))
+		writeJSON(w, &gogithub.RepositoryContent{
+			Type:     gogithub.Ptr("file"),
+			Name:     gogithub.Ptr(path.Base(filePath)),
+			Path:     gogithub.Ptr(filePath),
+			SHA:      gogithub.Ptr(hex.EncodeToString(sum[:])),
+			Encoding: gogithub.Ptr("base64"),
+			Content:  gogithub.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+		})
+		return
+	}
+
+	entries := make(map[string]string)
+	dirPrefix := strings.TrimSuffix(filePath, "/") + "/"
+	for p := range files {
+		if !strings.HasPrefix(p, dirPrefix) {
+			continue
+		}
+		name, _, isDir := strings.Cut(strings.TrimPrefix(p, dirPrefix), "/")
+		entries[name] = "file"
+		if isDir {
+			entries[name] = "dir"
+		}
+	}
+	if len(entries) == 0 {
+		notFound(w)
+		return
+	}
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	contents := make([]*gogithub.RepositoryContent, 0, len(names))
+	for _, name := range names {
+		contents = append(contents, &gogithub.RepositoryContent{
+			Type: gogithub.Ptr(entries[name]),
+			Name: gogithub.Ptr(name),
+			Path: gogithub.Ptr(dirPrefix + name),
+		})
+	}
+	writeJSON(w, contents)
+}
+
+// listPulls returns the pull requests matching the state and base filters,
+// in the requested order
+func (f *FakeGitHub) listPulls(query url.Values) []*gogithub.PullRequest {
+	state := query.Get("state")
+	if state == "" {
+		state = "open"
+	}
+	var pulls []*gogithub.PullRequest
+	for _, pr := range f.pulls {
+		if state != "all" && pr.GetState() != state {
+			continue
+		}
+		if base := query.Get("base"); base != "" && pr.GetBase().GetRef() != base {
+			continue
+		}
+		pulls = append(pulls, pr)
+	}
+	sortKey := func(pr *gogithub.PullRequest) gogithub.Timestamp {
+		if query.Get("sort") == "updated" {
+			return pr.GetUpdatedAt()
+		}
+		return pr.GetCreatedAt()
+	}
+	ascending := query.Get("direction") == "asc"
+	sort.SliceStable(pulls, func(i, j int) bool {
+		if ascending {
+			return sortKey(pulls[i]).Before(sortKey(pulls[j]).Time)
+		}
+		return sortKey(pulls[i]).After(sortKey(pulls[j]).Time)
+	})
+	return pulls
+}
+
+func (f *FakeGitHub) pull(number int) *gogithub.PullRequest {
+	for _, pr := range f.pulls {
+		if pr.GetNumber() == number {
+			return pr
+		}
+	}
+	return nil
+}
+
+// writePage writes the requested page of items, with a Link header to the
+// next page if any
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T, maxPerPage int) {
+	query := r.URL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	if maxPerPage > 0 && perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	if end < len(items) {
+		next := *r.URL
+		query.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = query.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next.RequestURI()))
+	}
+	writeJSON(w, append([]T{}, items[start:end]...))
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func notFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const (
+	// StageFetch is the stage fetching data from GitHub
+	StageFetch = "fetch"
+	// StageModel is the stage calling the model
+	StageModel = "model"
+)
+
+// StageTimeouts configures the deadlines of the stages of Generate. A zero
+// value disables the corresponding deadline.
+type StageTimeouts struct {
+	// Fetch is the deadline for fetching data from GitHub, before and after
+	// the model call
+	Fetch time.Duration
+	// Model is the deadline for each model call
+	Model time.Duration
+}
+
+// stageContext returns a context with the stage timeout, if not zero
+func stageContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// stageError attributes err to the stage if the stage deadline was exceeded
+func stageError(stageCtx context.Context, stage string, timeout time.Duration, err error) error {
+	if timeout > 0 && errors.Is(stageCtx.Err(), context.DeadlineExceeded) {
+		return &types.StageTimeoutError{Stage: stage, Timeout: timeout, Err: err}
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// Event kinds
+const (
+	KindHTTP  = "http"
+	KindModel = "model"
+)
+
+// Event records the metadata of a single external call
+type Event struct {
+	Time             time.Time `json:"time"`
+	Kind             string    `json:"kind"`
+	Method           string    `json:"method,omitempty"`
+	URL              string    `json:"url,omitempty"`
+	Model            string    `json:"model,omitempty"`
+	Status           int       `json:"status,omitempty"`
+	LatencyMS        int64     `json:"latency_ms"`
+	RateLimitLeft    string    `json:"rate_limit_remaining,omitempty"`
+	PromptTokens     int32     `json:"prompt_tokens,omitempty"`
+	CandidatesTokens int32     `json:"candidates_tokens,omitempty"`
+	TotalTokens      int32     `json:"total_tokens,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// Recorder writes trace events as JSON lines. It is safe for concurrent use.
+type Recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewRecorder creates a Recorder writing to w
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{enc: json.NewEncoder(w)}
+}
+
+// Record writes an event. Errors are ignored, tracing is best-effort.
+func (r *Recorder) Record(e Event) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = r.enc.Encode(e)
+}
+
+// Transport is an http.RoundTripper recording every request it sends
+type Transport struct {
+	Base     http.RoundTripper
+	Recorder *Recorder
+}
+
+// WrapTransport wraps a RoundTripper so that its requests are recorded
+func (r *Recorder) WrapTransport(base http.RoundTripper) http.RoundTripper {
+	return &Transport{Base: base, Recorder: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	e := Event{
+		Time:      start,
+		Kind:      KindHTTP,
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	} else {
+		e.Status = resp.StatusCode
+		e.RateLimitLeft = resp.Header.Get("X-RateLimit-Remaining")
+	}
+	t.Recorder.Record(e)
+	return resp, err
+}
+
+// ModelCaller wraps a ModelCaller, recording every call it makes
+type ModelCaller struct {
+	Inner    types.ModelCaller
+	Recorder *Recorder
+}
+
+// Call implements types.ModelCaller
+func (m *ModelCaller) Call(ctx context.Context, prompt, version, modelName string) (*types.ModelResponse, *types.ModelDetails, error) {
+	start := time.Now()
+	response, details, err := m.Inner.Call(ctx, prompt, version, modelName)
+	e := Event{
+		Time:      start,
+		Kind:      KindModel,
+		Model:     modelName,
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if details != nil {
+		e.PromptTokens = details.PromptTokens
+		e.CandidatesTokens = details.CandidatesTokens
+		e.TotalTokens = details.TotalTokens
+	}
+	m.Recorder.Record(e)
+	return response, details, err
+}
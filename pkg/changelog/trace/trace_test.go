@@ -0,0 +1,88 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func decodeEvents(t *testing.T, buf *bytes.Buffer) []Event {
+	t.Helper()
+	var events []Event
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var e Event
+		require.NoError(t, json.Unmarshal([]byte(line), &e))
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	recorder := NewRecorder(&buf)
+	client := &http.Client{Transport: recorder.WrapTransport(nil)}
+
+	resp, err := client.Get(server.URL + "/repos/antrea-io/antrea/pulls/1")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	events := decodeEvents(t, &buf)
+	require.Len(t, events, 1)
+	assert.Equal(t, KindHTTP, events[0].Kind)
+	assert.Equal(t, http.MethodGet, events[0].Method)
+	assert.Equal(t, server.URL+"/repos/antrea-io/antrea/pulls/1", events[0].URL)
+	assert.Equal(t, http.StatusNotFound, events[0].Status)
+	assert.Equal(t, "4999", events[0].RateLimitLeft)
+}
+
+func TestModelCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModelCaller := mocks.NewMockModelCaller(ctrl)
+	mockModelCaller.EXPECT().
+		Call(gomock.Any(), "prompt", "2.5.0", "gemini-2.5-flash").
+		Return(&types.ModelResponse{}, &types.ModelDetails{PromptTokens: 10, CandidatesTokens: 5, TotalTokens: 15}, nil)
+
+	var buf bytes.Buffer
+	caller := &ModelCaller{Inner: mockModelCaller, Recorder: NewRecorder(&buf)}
+	_, _, err := caller.Call(context.Background(), "prompt", "2.5.0", "gemini-2.5-flash")
+	require.NoError(t, err)
+
+	events := decodeEvents(t, &buf)
+	require.Len(t, events, 1)
+	assert.Equal(t, KindModel, events[0].Kind)
+	assert.Equal(t, "gemini-2.5-flash", events[0].Model)
+	assert.Equal(t, int32(15), events[0].TotalTokens)
+}
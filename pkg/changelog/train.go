@@ -0,0 +1,93 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// TrainResult returns the outcome of the last generated CHANGELOG, for the
+// summary report of a release train
+func (g *ChangelogGenerator) TrainResult(response *types.ModelResponse, details *types.ModelDetails) types.TrainResult {
+	// Same selection as formatChangelog
+	byCategory, _ := capEntries(groupChanges(response, g.categories, g.effectiveThresholds()), g.categories)
+	var entries int
+	for _, changes := range byCategory {
+		entries += len(changes)
+	}
+	return types.TrainResult{
+		Release:          g.release,
+		Entries:          entries,
+		QualityScore:     g.quality.Score,
+		EstimatedCostUSD: details.EstimatedCostUSD,
+		ReviewWarnings:   g.Warnings(),
+	}
+}
+
+// FormatTrainReport renders the outcome of all the releases of a release
+// train as a markdown report for the release manager
+func FormatTrainReport(name string, results []types.TrainResult) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Release train %s\n\n", name))
+
+	var failed int
+	var cost float64
+	sb.WriteString("| Release | Status | Entries | Quality | Model cost | Pull request |\n")
+	sb.WriteString("|---------|--------|---------|---------|------------|--------------|\n")
+	for _, r := range results {
+		cost += r.EstimatedCostUSD
+		status := "ready"
+		switch {
+		case r.Err != "":
+			status = "failed"
+			failed++
+		case len(r.ReviewWarnings) > 0:
+			status = "needs review"
+		}
+		pr := "-"
+		if r.PullRequestURL != "" {
+			pr = r.PullRequestURL
+		}
+		if r.Err != "" {
+			// A failed release may still have been billed for its model calls
+			cost := "-"
+			if r.EstimatedCostUSD > 0 {
+				cost = fmt.Sprintf("$%.4f", r.EstimatedCostUSD)
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | - | - | %s | - |\n", r.Release, status, cost))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d/100 | $%.4f | %s |\n", r.Release, status, r.Entries, r.QualityScore, r.EstimatedCostUSD, pr))
+	}
+	sb.WriteString(fmt.Sprintf("\n%d of %d releases succeeded, estimated model cost: $%.4f.\n", len(results)-failed, len(results), cost))
+
+	for _, r := range results {
+		if r.Err == "" && len(r.ReviewWarnings) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", r.Release))
+		if r.Err != "" {
+			sb.WriteString(fmt.Sprintf("Error: %s\n", r.Err))
+			continue
+		}
+		for _, w := range r.ReviewWarnings {
+			sb.WriteString(fmt.Sprintf("- %s\n", w))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestTrainResult(t *testing.T) {
+	generator := NewChangelogGenerator("2.5.0", "", false, "gemini-2.5-flash", nil, nil)
+	generator.quality = types.QualityScore{Score: 85}
+	generator.patchFeatures = []types.ChangeEntry{{PRNumber: 103}}
+	response := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 100, Category: "ADDED", IncludeScore: 90, Description: "Add feature"},
+		{PRNumber: 101, Category: "FIXED", IncludeScore: 30, Description: "Fix minor bug"},
+		{PRNumber: 102, Category: "CHANGED", IncludeScore: 5, Description: "Refactor tests"},
+	}}
+
+	assert.Equal(t, types.TrainResult{
+		Release:          "2.5.0",
+		Entries:          2,
+		QualityScore:     85,
+		EstimatedCostUSD: 0.02,
+		ReviewWarnings:   []string{"1 unacknowledged ADDED entries in a patch release"},
+	}, generator.TrainResult(response, &types.ModelDetails{EstimatedCostUSD: 0.02}))
+}
+
+func TestFormatTrainReport(t *testing.T) {
+	report := FormatTrainReport("2.5.0", []types.TrainResult{
+		{Release: "2.5.0", Entries: 42, QualityScore: 88, EstimatedCostUSD: 0.05, PullRequestURL: "https://github.com/antrea-io/antrea/pull/7300"},
+		{Release: "2.4.3", Entries: 6, QualityScore: 75, EstimatedCostUSD: 0.01, ReviewWarnings: []string{"2 entries look like duplicates of released changes"}},
+		{Release: "2.3.5", Err: "failed to generate changelog: no PRs"},
+		{Release: "2.2.8", Err: "changelog generation interrupted: context canceled", EstimatedCostUSD: 0.02},
+	})
+
+	assert.Equal(t, `# Release train 2.5.0
+
+| Release | Status | Entries | Quality | Model cost | Pull request |
+|---------|--------|---------|---------|------------|--------------|
+| 2.5.0 | ready | 42 | 88/100 | $0.0500 | https://github.com/antrea-io/antrea/pull/7300 |
+| 2.4.3 | needs review | 6 | 75/100 | $0.0100 | - |
+| 2.3.5 | failed | - | - | - | - |
+| 2.2.8 | failed | - | - | $0.0200 | - |
+
+2 of 4 releases succeeded, estimated model cost: $0.0800.
+
+## 2.4.3
+
+- 2 entries look like duplicates of released changes
+
+## 2.3.5
+
+Error: failed to generate changelog: no PRs
+
+## 2.2.8
+
+Error: changelog generation interrupted: context canceled
+`, report)
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// CompareTranslation compares the PRs linked by the release sections of a
+// localized CHANGELOG with the English CHANGELOG, so that localized notes do
+// not silently go stale after late edits. Only the releases of the English
+// CHANGELOG are compared, all of them if releases is empty.
+func CompareTranslation(english, localized string, releases []string) []types.TranslationDrift {
+	localizedPRs := make(map[string]map[int]bool)
+	_, localizedSections := splitSections(localized, make(map[string]string))
+	for _, s := range localizedSections {
+		localizedPRs[s.version] = sectionPRs(s)
+	}
+
+	var drifts []types.TranslationDrift
+	_, sections := splitSections(english, make(map[string]string))
+	for _, s := range sections {
+		if len(releases) > 0 && !slices.Contains(releases, s.version) {
+			continue
+		}
+		drift := types.TranslationDrift{Release: s.version}
+		translated, ok := localizedPRs[s.version]
+		if !ok {
+			drift.MissingSection = true
+			drifts = append(drifts, drift)
+			continue
+		}
+		prs := sectionPRs(s)
+		for number := range prs {
+			if !translated[number] {
+				drift.Missing = append(drift.Missing, number)
+			}
+		}
+		for number := range translated {
+			if !prs[number] {
+				drift.Extra = append(drift.Extra, number)
+			}
+		}
+		if len(drift.Missing) == 0 && len(drift.Extra) == 0 {
+			continue
+		}
+		sort.Ints(drift.Missing)
+		sort.Ints(drift.Extra)
+		drifts = append(drifts, drift)
+	}
+	return drifts
+}
+
+// sectionPRs returns the PR numbers linked by the entries of a release section
+func sectionPRs(s changelogSection) map[int]bool {
+	prs := make(map[int]bool)
+	for _, line := range s.lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), "- ") {
+			continue
+		}
+		for _, m := range entryPRRegex.FindAllStringSubmatch(line, -1) {
+			number, _ := strconv.Atoi(m[1])
+			prs[number] = true
+		}
+	}
+	return prs
+}
+
+// FormatTranslationReport renders the drift of a localized CHANGELOG from the
+// English CHANGELOG as a markdown report for the translators, the PRs being
+// linked with the link templates
+func FormatTranslationReport(localizedFile string, drifts []types.TranslationDrift, links config.LinkTemplates) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Translation drift of %s\n\n", localizedFile))
+	if len(drifts) == 0 {
+		sb.WriteString("All release sections link the same PRs as the English CHANGELOG.\n")
+		return sb.String()
+	}
+	formatPRs := func(numbers []int) string {
+		refs := make([]string, 0, len(numbers))
+		for _, number := range numbers {
+			refs = append(refs, fmt.Sprintf("[#%d](%s)", number, links.PRURL(number)))
+		}
+		return strings.Join(refs, ", ")
+	}
+	for _, d := range drifts {
+		sb.WriteString(fmt.Sprintf("## %s\n\n", d.Release))
+		if d.MissingSection {
+			sb.WriteString("The release section is not translated.\n\n")
+			continue
+		}
+		if len(d.Missing) > 0 {
+			sb.WriteString(fmt.Sprintf("- Not translated: %s\n", formatPRs(d.Missing)))
+		}
+		if len(d.Extra) > 0 {
+			sb.WriteString(fmt.Sprintf("- No longer in the English CHANGELOG: %s\n", formatPRs(d.Extra)))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+const englishChangelog = `# Changelog 2.4
+
+## 2.4.1 - 2025-03-01
+
+### Fixed
+
+- Fix Egress IP leak. ([#7100](https://github.com/antrea-io/antrea/pull/7100), [@alice])
+- Fix agent crash on Windows. ([#7101](https://github.com/antrea-io/antrea/pull/7101) [#7102](https://github.com/antrea-io/antrea/pull/7102), [@bob])
+
+## 2.4.0 - 2025-01-30
+
+### Added
+
+- Add NodeLatencyMonitor. ([#7000](https://github.com/antrea-io/antrea/pull/7000), [@carol])
+
+[@alice]: https://github.com/alice
+[@bob]: https://github.com/bob
+[@carol]: https://github.com/carol
+`
+
+func TestCompareTranslation(t *testing.T) {
+	localized := `# 更新日志 2.4
+
+## 2.4.1 - 2025-03-01
+
+### 修复
+
+- 修复 Egress IP 泄漏。([#7100](https://github.com/antrea-io/antrea/pull/7100), [@alice])
+- 修复 Traceflow 超时。([#7099](https://github.com/antrea-io/antrea/pull/7099), [@dave])
+`
+
+	drifts := CompareTranslation(englishChangelog, localized, nil)
+	assert.Equal(t, []types.TranslationDrift{
+		{Release: "2.4.1", Missing: []int{7101, 7102}, Extra: []int{7099}},
+		{Release: "2.4.0", MissingSection: true},
+	}, drifts)
+
+	assert.Empty(t, CompareTranslation(englishChangelog, englishChangelog, nil))
+	assert.Len(t, CompareTranslation(englishChangelog, localized, []string{"2.4.0"}), 1)
+
+	report := FormatTranslationReport("CHANGELOG-2.4.zh-CN.md", drifts, config.DefaultLinkTemplates())
+	assert.Contains(t, report, "- Not translated: [#7101](https://github.com/antrea-io/antrea/pull/7101), [#7102](https://github.com/antrea-io/antrea/pull/7102)\n")
+	assert.Contains(t, report, "- No longer in the English CHANGELOG: [#7099](https://github.com/antrea-io/antrea/pull/7099)\n")
+	assert.Contains(t, report, "## 2.4.0\n\nThe release section is not translated.\n")
+
+	links := config.DefaultLinkTemplates()
+	links.PR = "https://github.example.com/antrea/antrea/pull/{{.Number}}"
+	report = FormatTranslationReport("CHANGELOG-2.4.zh-CN.md", drifts, links)
+	assert.Contains(t, report, "- No longer in the English CHANGELOG: [#7099](https://github.example.com/antrea/antrea/pull/7099)\n")
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+// trendCategories are the categories compared by the trends report, in
+// CHANGELOG order
+var trendCategories = []string{"ADDED", "CHANGED", "FIXED"}
+
+// releaseEntries are the entries of a release in the historical CHANGELOGs
+type releaseEntries struct {
+	ver        *version.Version
+	entries    int
+	byCategory map[string]int
+	// authors are the lowercase logins of the authors of the entries
+	authors map[string]bool
+}
+
+// ReleaseTrends returns the statistics of a release and of the previous
+// releases of the same kind, oldest first: the previous minor releases (X.Y.0)
+// for a minor release, and the previous patch releases of the same minor for
+// a patch release. An empty release selects the latest minor release of the
+// CHANGELOGs.
+func ReleaseTrends(files map[string]string, categories []config.Category, release string, previous int) ([]types.ReleaseStats, error) {
+	releases := make(map[string]*releaseEntries)
+	for _, content := range files {
+		forEachCHANGELOGEntry(content, categories, func(rel, category, line string) {
+			// Lines without a PR link are not entries (e.g. the sub-items of
+			// an entry)
+			if !entryPRRegex.MatchString(line) {
+				return
+			}
+			ver, err := version.Parse(rel)
+			if err != nil {
+				return
+			}
+			r, ok := releases[ver.String()]
+			if !ok {
+				r = &releaseEntries{ver: ver, byCategory: make(map[string]int), authors: make(map[string]bool)}
+				releases[ver.String()] = r
+			}
+			r.entries++
+			r.byCategory[category]++
+			for _, author := range authorRefRegex.FindAllStringSubmatch(line, -1) {
+				r.authors[strings.ToLower(author[1])] = true
+			}
+		})
+	}
+	if len(releases) == 0 {
+		return nil, fmt.Errorf("no release entries found in the CHANGELOGs")
+	}
+	all := make([]*releaseEntries, 0, len(releases))
+	for _, r := range releases {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[j].ver.GreaterThan(all[i].ver) })
+
+	var target *releaseEntries
+	if release == "" {
+		for _, r := range all {
+			if r.ver.Patch() == 0 {
+				target = r
+			}
+		}
+		if target == nil {
+			return nil, fmt.Errorf("no minor release found in the CHANGELOGs")
+		}
+	} else {
+		ver, err := version.Parse(release)
+		if err != nil {
+			return nil, err
+		}
+		if target = releases[ver.String()]; target == nil {
+			return nil, fmt.Errorf("no entries found for release %s in the CHANGELOGs", ver)
+		}
+	}
+
+	// The previous releases of the same kind, most recent first
+	var selected []*releaseEntries
+	for i := len(all) - 1; i >= 0 && len(selected) < previous; i-- {
+		r := all[i]
+		if !target.ver.GreaterThan(r.ver) {
+			continue
+		}
+		sameKind := r.ver.Patch() == 0
+		if target.ver.Patch() != 0 {
+			sameKind = !sameKind && r.ver.Major() == target.ver.Major() && r.ver.Minor() == target.ver.Minor()
+		}
+		if sameKind {
+			selected = append(selected, r)
+		}
+	}
+	slices.Reverse(selected)
+	selected = append(selected, target)
+
+	stats := make([]types.ReleaseStats, 0, len(selected))
+	for _, r := range selected {
+		s := types.ReleaseStats{
+			Release:      r.ver.String(),
+			Entries:      r.entries,
+			ByCategory:   r.byCategory,
+			Contributors: len(r.authors),
+		}
+		for author := range r.authors {
+			if !authorOfEarlierRelease(all, r.ver, author) {
+				s.NewContributors++
+			}
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+// authorOfEarlierRelease returns true if the author has an entry in a release
+// older than ver
+func authorOfEarlierRelease(releases []*releaseEntries, ver *version.Version, author string) bool {
+	for _, r := range releases {
+		if ver.GreaterThan(r.ver) && r.authors[author] {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatTrendsReport renders the statistics of a release compared with the
+// previous releases (as returned by ReleaseTrends) as a markdown report, for
+// release retrospectives and community updates
+func FormatTrendsReport(stats []types.ReleaseStats) string {
+	var sb strings.Builder
+	current := stats[len(stats)-1]
+	previous := stats[:len(stats)-1]
+	sb.WriteString(fmt.Sprintf("# CHANGELOG trends of %s\n\n", current.Release))
+	if len(previous) == 0 {
+		sb.WriteString("No previous release to compare with.\n\n")
+	} else {
+		names := make([]string, 0, len(previous))
+		for _, s := range previous {
+			names = append(names, s.Release)
+		}
+		sb.WriteString(fmt.Sprintf("Compared with the %d previous releases: %s.\n\n", len(previous), strings.Join(names, ", ")))
+	}
+
+	sb.WriteString("| Release | Entries |")
+	for _, category := range trendCategories {
+		sb.WriteString(fmt.Sprintf(" %s |", category))
+	}
+	sb.WriteString(" Contributors | New contributors |\n")
+	sb.WriteString("|---------|---------|")
+	for _, category := range trendCategories {
+		sb.WriteString(strings.Repeat("-", len(category)+2) + "|")
+	}
+	sb.WriteString("--------------|------------------|\n")
+	for _, s := range stats {
+		release := s.Release
+		if s.Release == current.Release {
+			release = "**" + release + "**"
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d |", release, s.Entries))
+		for _, category := range trendCategories {
+			sb.WriteString(fmt.Sprintf(" %d (%.0f%%) |", s.ByCategory[category], share(s.ByCategory[category], s.Entries)))
+		}
+		sb.WriteString(fmt.Sprintf(" %d | %d |\n", s.Contributors, s.NewContributors))
+	}
+	if len(previous) == 0 {
+		return sb.String()
+	}
+
+	average := func(value func(types.ReleaseStats) float64) float64 {
+		var sum float64
+		for _, s := range previous {
+			sum += value(s)
+		}
+		return sum / float64(len(previous))
+	}
+	trend := func(name string, value func(types.ReleaseStats) float64) string {
+		avg, count := average(value), value(current)
+		if avg == 0 {
+			return fmt.Sprintf("- %s: %.0f, none in the previous releases\n", name, count)
+		}
+		return fmt.Sprintf("- %s: %.0f, %+.0f%% compared with the average of the previous releases (%.1f)\n", name, count, (count-avg)/avg*100, avg)
+	}
+	sb.WriteString("\n## Summary\n\n")
+	sb.WriteString(trend("Entries", func(s types.ReleaseStats) float64 { return float64(s.Entries) }))
+	sb.WriteString(trend("Contributors", func(s types.ReleaseStats) float64 { return float64(s.Contributors) }))
+	sb.WriteString(trend("New contributors", func(s types.ReleaseStats) float64 { return float64(s.NewContributors) }))
+	mix := make([]string, 0, len(trendCategories))
+	for _, category := range trendCategories {
+		currentShare := share(current.ByCategory[category], current.Entries)
+		avg := average(func(s types.ReleaseStats) float64 { return share(s.ByCategory[category], s.Entries) })
+		mix = append(mix, fmt.Sprintf("%s %.0f%% (%+.0f points)", category, currentShare, currentShare-avg))
+	}
+	sb.WriteString(fmt.Sprintf("- Category mix: %s\n", strings.Join(mix, ", ")))
+	return sb.String()
+}
+
+// share returns the percentage of count in total
+func share(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) * 100 / float64(total)
+}
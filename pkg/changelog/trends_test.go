@@ -0,0 +1,105 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+var trendsChangelogs = map[string]string{
+	"CHANGELOG-2.3.md": `# Changelog 2.3
+
+## 2.3.1 - 2025-02-20
+
+### Fixed
+
+- Fix Egress IP leak. ([#7005](https://github.com/antrea-io/antrea/pull/7005), [@bob])
+
+## 2.3.0 - 2025-01-10
+
+### Added
+
+- Add Egress bandwidth limit. ([#6900](https://github.com/antrea-io/antrea/pull/6900), [@alice])
+
+### Fixed
+
+- Fix crash of the agent. ([#6901](https://github.com/antrea-io/antrea/pull/6901), [@bob])
+`,
+	"CHANGELOG-2.4.md": `# Changelog 2.4
+
+## 2.4.0 - 2025-04-10
+
+### Added
+
+- Add NodeLatencyMonitor. ([#7020](https://github.com/antrea-io/antrea/pull/7020), [@Alice])
+  - The monitor is disabled by default.
+
+### Changed
+
+- Upgrade Open vSwitch to 3.5. ([#7021](https://github.com/antrea-io/antrea/pull/7021), [@carol])
+
+### Fixed
+
+- Fix Service connectivity. ([#7022](https://github.com/antrea-io/antrea/pull/7022) [#7023](https://github.com/antrea-io/antrea/pull/7023), [@bob] [@dave])
+`,
+	"CHANGELOG-2.5.md": `# Changelog 2.5
+
+## Unreleased
+
+### Added
+
+- Add FQDN policy audit. ([#7200](https://github.com/antrea-io/antrea/pull/7200), [@erin])
+`,
+}
+
+func TestReleaseTrends(t *testing.T) {
+	stats, err := ReleaseTrends(trendsChangelogs, nil, "", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []types.ReleaseStats{
+		{Release: "2.3.0", Entries: 2, ByCategory: map[string]int{"ADDED": 1, "FIXED": 1}, Contributors: 2, NewContributors: 2},
+		{Release: "2.4.0", Entries: 3, ByCategory: map[string]int{"ADDED": 1, "CHANGED": 1, "FIXED": 1}, Contributors: 4, NewContributors: 2},
+	}, stats)
+
+	stats, err = ReleaseTrends(trendsChangelogs, nil, "2.3.1", 3)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "2.3.1", stats[0].Release)
+
+	_, err = ReleaseTrends(trendsChangelogs, nil, "2.6.0", 3)
+	assert.ErrorContains(t, err, "no entries found for release 2.6.0")
+}
+
+func TestFormatTrendsReport(t *testing.T) {
+	stats := []types.ReleaseStats{
+		{Release: "2.3.0", Entries: 2, ByCategory: map[string]int{"ADDED": 1, "FIXED": 1}, Contributors: 2, NewContributors: 2},
+		{Release: "2.4.0", Entries: 3, ByCategory: map[string]int{"ADDED": 1, "CHANGED": 1, "FIXED": 1}, Contributors: 4, NewContributors: 2},
+	}
+	report := FormatTrendsReport(stats)
+	assert.Contains(t, report, "# CHANGELOG trends of 2.4.0\n\nCompared with the 1 previous releases: 2.3.0.\n")
+	assert.Contains(t, report, "| 2.3.0 | 2 | 1 (50%) | 0 (0%) | 1 (50%) | 2 | 2 |\n")
+	assert.Contains(t, report, "| **2.4.0** | 3 | 1 (33%) | 1 (33%) | 1 (33%) | 4 | 2 |\n")
+	assert.Contains(t, report, "- Entries: 3, +50% compared with the average of the previous releases (2.0)\n")
+	assert.Contains(t, report, "- Contributors: 4, +100% compared with the average of the previous releases (2.0)\n")
+	assert.Contains(t, report, "- Category mix: ADDED 33% (-17 points), CHANGED 33% (+33 points), FIXED 33% (-17 points)\n")
+
+	report = FormatTrendsReport(stats[:1])
+	assert.Contains(t, report, "No previous release to compare with.")
+	assert.NotContains(t, report, "## Summary")
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The error types below classify failures, so that callers can branch on the
+// failure class with errors.As instead of matching error text. They are
+// returned wrapped with context, and IsRetryable reports whether retrying the
+// run may succeed.
+
+// RateLimitedError is returned when a service (GitHub or the model API) rejects
+// a request because of rate limits
+type RateLimitedError struct {
+	// Service is the rate limited service, e.g. "github" or "gemini"
+	Service string
+	// RetryAfter is how long to wait before retrying, if known (0 otherwise)
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s rate limit exceeded (retry after %s): %v", e.Service, e.RetryAfter.Round(time.Second), e.Err)
+	}
+	return fmt.Sprintf("%s rate limit exceeded: %v", e.Service, e.Err)
+}
+
+func (e *RateLimitedError) Unwrap() error { return e.Err }
+
+// Retryable returns true, the request may succeed once the rate limit is reset
+func (e *RateLimitedError) Retryable() bool { return true }
+
+// NotFoundError is returned when a GitHub resource (tag, file, PR...) does not exist
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("not found: %v", e.Err)
+}
+
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// Retryable returns false, the resource will not appear by retrying
+func (e *NotFoundError) Retryable() bool { return false }
+
+// ModelMalformedOutputError is returned when the model response cannot be parsed
+type ModelMalformedOutputError struct {
+	// Output is the raw model output
+	Output string
+	Err    error
+}
+
+func (e *ModelMalformedOutputError) Error() string {
+	return fmt.Sprintf("malformed model output: %v\nResponse: %s", e.Err, e.Output)
+}
+
+func (e *ModelMalformedOutputError) Unwrap() error { return e.Err }
+
+// Retryable returns true, the model output is not deterministic
+func (e *ModelMalformedOutputError) Retryable() bool { return true }
+
+// BudgetExceededError is returned when a run is aborted because it would
+// exceed a configured limit, e.g. the maximum number of PRs sent to the model
+type BudgetExceededError struct {
+	Reason string
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("aborted: %s", e.Reason)
+}
+
+// Retryable returns false, the run needs different settings
+func (e *BudgetExceededError) Retryable() bool { return false }
+
+// StageTimeoutError is returned when a stage of the run (e.g. fetching PRs or
+// calling the model) exceeds its deadline
+type StageTimeoutError struct {
+	Stage   string
+	Timeout time.Duration
+	Err     error
+}
+
+func (e *StageTimeoutError) Error() string {
+	return fmt.Sprintf("%s stage timed out after %s: %v", e.Stage, e.Timeout, e.Err)
+}
+
+func (e *StageTimeoutError) Unwrap() error { return e.Err }
+
+// Retryable returns true, the deadline may have been exceeded because of a stuck connection
+func (e *StageTimeoutError) Retryable() bool { return true }
+
+// GitHubError is returned when a GitHub API request fails, unless the failure
+// is classified more precisely (RateLimitedError or NotFoundError)
+type GitHubError struct {
+	Err error
+}
+
+func (e *GitHubError) Error() string { return e.Err.Error() }
+
+func (e *GitHubError) Unwrap() error { return e.Err }
+
+// ModelError is returned when a model call fails
+type ModelError struct {
+	Err error
+}
+
+func (e *ModelError) Error() string { return e.Err.Error() }
+
+func (e *ModelError) Unwrap() error { return e.Err }
+
+// ValidationError is returned when the generated changelog does not pass the
+// validation checks
+type ValidationError struct {
+	Err error
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// IsRetryable returns true if err (or an error it wraps) is classified as
+// retryable. Unclassified errors are not retryable.
+func IsRetryable(err error) bool {
+	var r interface{ Retryable() bool }
+	return errors.As(err, &r) && r.Retryable()
+}
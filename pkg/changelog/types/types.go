@@ -16,6 +16,8 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/go-github/v76/github"
@@ -29,17 +31,51 @@ type PRInfo struct {
 	Author   string
 	Labels   []string
 	MergedAt time.Time
+	// BuildFiles lists the changed build files (build matrices, Dockerfiles), when detected
+	BuildFiles []string
+	// DocsOnly is true if the PR only changes documentation, when detected
+	DocsOnly bool
+	// NotableDependency is the notable dependency upgraded by a bot PR, when
+	// detected
+	NotableDependency string
+	// FollowUpOf is the PR of the release this PR follows up on, when detected
+	FollowUpOf int
+	// MergeCommitSHA is the commit of the PR on the release branch (the
+	// cherry-pick PR's commit for a backported PR)
+	MergeCommitSHA string
+	// FixedIssues are the issues closed by the PR, when detected
+	FixedIssues []IssueRef
+}
+
+// IssueRef identifies a GitHub issue by number and title
+type IssueRef struct {
+	Number int
+	Title  string
 }
 
 // ChangeEntry represents a single changelog entry from the model
 type ChangeEntry struct {
 	PRNumber          int    `json:"pr_number"`
 	Category          string `json:"category"`
+	Subcategory       string `json:"subcategory,omitempty"` // Only for categories with subcategories
 	Description       string `json:"description"`
 	IncludeScore      int    `json:"include_score"`
 	ImportanceScore   int    `json:"importance_score"`
 	ReusedFromHistory bool   `json:"reused_from_history"`
-	Author            string `json:"-"`
+	// DescriptionConfidence is the confidence of the model in the accuracy of
+	// the description (0-100), nil if not provided
+	DescriptionConfidence *int `json:"description_confidence,omitempty"`
+	// TitleFallback is true if the description is the PR title, because the
+	// model's description had a very low confidence
+	TitleFallback  bool     `json:"title_fallback,omitempty"`
+	GroupedWith    []int    `json:"grouped_with,omitempty"` // Other PRs describing the same change
+	Author         string   `json:"-"`
+	GroupedAuthors []string `json:"-"` // Authors of the GroupedWith PRs
+	// MergeCommitSHA and ReleaseTag map the entry to the exact builds
+	// containing it, they are not set by the model
+	MergeCommitSHA         string   `json:"merge_commit_sha,omitempty"`
+	GroupedMergeCommitSHAs []string `json:"grouped_merge_commit_shas,omitempty"` // Commits of the GroupedWith PRs
+	ReleaseTag             string   `json:"release_tag,omitempty"`
 }
 
 // ModelResponse is the structured response from the AI model
@@ -57,6 +93,49 @@ type ModelDetails struct {
 	CandidatesTokens int32   `json:"candidates_tokens,omitempty"`
 	TotalTokens      int32   `json:"total_tokens,omitempty"`
 	EstimatedCostUSD float64 `json:"estimated_cost_usd,omitempty"`
+	// Calls is the number of model calls the details cover
+	Calls int `json:"calls,omitempty"`
+	// Quota is the quota left after the last call, if the provider reports it
+	Quota *QuotaUsage `json:"quota,omitempty"`
+}
+
+// Add adds the latency, tokens, cost and calls of other to the details, so
+// that the details of a run cover all its model calls (e.g. the description
+// shortening pass)
+func (d *ModelDetails) Add(other *ModelDetails) {
+	d.LatencySeconds += other.LatencySeconds
+	d.PromptTokens += other.PromptTokens
+	d.CandidatesTokens += other.CandidatesTokens
+	d.TotalTokens += other.TotalTokens
+	d.EstimatedCostUSD += other.EstimatedCostUSD
+	d.Calls += other.Calls
+	if other.Quota != nil {
+		d.Quota = other.Quota
+	}
+}
+
+// QuotaUsage is the quota of the model provider left after a call. Fields are
+// nil when the provider does not report them.
+type QuotaUsage struct {
+	LimitRequests     *int64 `json:"limit_requests,omitempty"`
+	RemainingRequests *int64 `json:"remaining_requests,omitempty"`
+	LimitTokens       *int64 `json:"limit_tokens,omitempty"`
+	RemainingTokens   *int64 `json:"remaining_tokens,omitempty"`
+}
+
+func (q *QuotaUsage) String() string {
+	format := func(remaining, limit *int64) string {
+		switch {
+		case remaining == nil:
+			return "unknown"
+		case limit == nil:
+			return fmt.Sprintf("%d", *remaining)
+		default:
+			return fmt.Sprintf("%d of %d", *remaining, *limit)
+		}
+	}
+	return fmt.Sprintf("requests remaining: %s, tokens remaining: %s",
+		format(q.RemainingRequests, q.LimitRequests), format(q.RemainingTokens, q.LimitTokens))
 }
 
 // Prompt contains the full prompt sent to the model
@@ -70,11 +149,389 @@ type Prompt struct {
 type HistoricalPR struct {
 	Description string
 	Category    string
+	// Release is the release (X.Y.Z) of the entry, if known
+	Release string
+}
+
+// SkipReason describes why a PR was excluded from the CHANGELOG
+type SkipReason string
+
+const (
+	// SkipReasonBotAuthor means the PR was authored by an ignored bot account
+	SkipReasonBotAuthor SkipReason = "bot author"
+	// SkipReasonExcludedLabel means the PR has a label excluded from the CHANGELOG
+	SkipReasonExcludedLabel SkipReason = "excluded label"
+	// SkipReasonRevertPair means the PR was reverted (or is a revert) within the same release
+	SkipReasonRevertPair SkipReason = "revert pair"
+	// SkipReasonFilter means the PR does not match the PR filter expression
+	SkipReasonFilter SkipReason = "filter expression"
+	// SkipReasonOverride means the PR was excluded for the run with an override
+	SkipReasonOverride SkipReason = "excluded by override"
+	// SkipReasonLowIncludeScore means the model's include_score is below the inclusion threshold
+	SkipReasonLowIncludeScore SkipReason = "include_score below threshold"
+	// SkipReasonUnknownCategory means the model returned a category the formatter does not render
+	SkipReasonUnknownCategory SkipReason = "unknown category"
+	// SkipReasonDocsOnly means the PR only changes documentation
+	SkipReasonDocsOnly SkipReason = "documentation only"
+)
+
+// HistoryConflict records a PR whose historical CHANGELOG category disagrees
+// with the category returned by the model or implied by its labels
+type HistoryConflict struct {
+	Number             int    `json:"pr_number"`
+	Title              string `json:"title"`
+	HistoricalCategory string `json:"historical_category"`
+	ModelCategory      string `json:"model_category"`
+	Label              string `json:"label,omitempty"`
+	LabelCategory      string `json:"label_category,omitempty"`
+}
+
+// HistoricalDuplicate records a new entry whose description is near-identical
+// to a historical entry of another PR, e.g. because the PR was re-opened under
+// a new number for a change which was already released
+type HistoricalDuplicate struct {
+	Number                int     `json:"pr_number"`
+	Description           string  `json:"description"`
+	HistoricalNumber      int     `json:"historical_pr_number"`
+	HistoricalDescription string  `json:"historical_description"`
+	HistoricalRelease     string  `json:"historical_release,omitempty"`
+	Similarity            float64 `json:"similarity"`
+}
+
+// DriftCommit is a commit of the release branch which was not mapped to a PR
+// from its title
+type DriftCommit struct {
+	SHA   string `json:"sha"`
+	Title string `json:"title"`
+	// PRs are the merged PRs found with the commit association API, if any
+	PRs []int `json:"prs,omitempty"`
+}
+
+// DriftPR is a PR found on only one side of the release drift audit
+type DriftPR struct {
+	Number int    `json:"pr_number"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+}
+
+// ReviewRoute assigns a CHANGELOG entry to the code owners of most of the
+// files changed by its PRs, who are asked to review it
+type ReviewRoute struct {
+	PRNumber    int    `json:"pr_number"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	// Owners are empty if no file of the entry has an owner
+	Owners []string `json:"owners,omitempty"`
+}
+
+// ReleaseDrift compares the PRs of the commits on the release branch since the
+// from-release tag with the PRs selected by the generator
+type ReleaseDrift struct {
+	Branch      string `json:"branch"`
+	FromRelease string `json:"from_release"`
+	// NotSelected are the release note PRs whose commits are on the branch but
+	// which were not selected (e.g. cherry-picks without the kind/cherry-pick label)
+	NotSelected []DriftPR `json:"not_selected"`
+	// NotOnBranch are the selected PRs whose commits are not on the branch
+	// since the from-release tag (e.g. missed or reverted cherry-picks)
+	NotOnBranch []DriftPR `json:"not_on_branch"`
+	// UnusualTitles are the commits without a PR number in their title, which
+	// were mapped with the commit association API
+	UnusualTitles []DriftCommit `json:"unusual_titles"`
+	// Unmapped are the commits which could not be mapped to a merged PR
+	Unmapped []DriftCommit `json:"unmapped"`
+}
+
+// Discrepancies returns the number of PRs and commits which need to be checked
+func (d *ReleaseDrift) Discrepancies() int {
+	return len(d.NotSelected) + len(d.NotOnBranch) + len(d.Unmapped)
+}
+
+// TrainResult is the outcome of a release of a release train
+type TrainResult struct {
+	Release string `json:"release"`
+	// Err is the error which stopped the release, if any
+	Err string `json:"error,omitempty"`
+	// Entries is the number of rendered entries
+	Entries int `json:"entries"`
+	// QualityScore is the quality score of the CHANGELOG (0-100)
+	QualityScore     int     `json:"quality_score"`
+	EstimatedCostUSD float64 `json:"estimated_cost_usd"`
+	// ReviewWarnings need a human before releasing
+	ReviewWarnings []string `json:"review_warnings,omitempty"`
+	// PullRequestURL is the URL of the CHANGELOG pull request, with --create-pr
+	PullRequestURL string `json:"pull_request_url,omitempty"`
+}
+
+// TranslationDrift records a release section of a localized CHANGELOG which
+// does not link the same PRs as the English CHANGELOG, e.g. because the
+// English one was edited after the translation
+type TranslationDrift struct {
+	Release string `json:"release"`
+	// MissingSection is true if the localized CHANGELOG has no section for the release
+	MissingSection bool `json:"missing_section,omitempty"`
+	// Missing are the PRs of the English section the localized section does not link
+	Missing []int `json:"missing,omitempty"`
+	// Extra are the PRs of the localized section the English section does not link
+	Extra []int `json:"extra,omitempty"`
+}
+
+// PRReadiness is the release-note readiness of the description of a PR
+type PRReadiness struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+	// Score is from 0 to 100, 100 for a description the model can summarize
+	// without guessing
+	Score int `json:"score"`
+	// Issues are the problems found, e.g. "empty description"
+	Issues []string `json:"issues,omitempty"`
+}
+
+// SignOffStatus is an item of the sign-off checklist of a release
+type SignOffStatus struct {
+	Area   string   `json:"area"`
+	Owners []string `json:"owners"`
+	// SignedOff is true once the item is checked on the release tracking issue
+	SignedOff bool `json:"signed_off"`
+}
+
+// SearchMatch is an entry of a historical CHANGELOG matching a search, e.g.
+// to find the release which contained a PR
+type SearchMatch struct {
+	// File is the CHANGELOG file of the entry (e.g. CHANGELOG-2.4.md)
+	File     string `json:"file"`
+	Release  string `json:"release"`
+	Category string `json:"category"`
+	// PRNumbers are the PRs linked by the entry, the first one being the
+	// main PR of the entry
+	PRNumbers   []int    `json:"pr_numbers"`
+	Authors     []string `json:"authors,omitempty"`
+	Description string   `json:"description"`
+}
+
+// ReleaseStats are the statistics of the CHANGELOG section of a release, to
+// compare releases
+type ReleaseStats struct {
+	Release string `json:"release"`
+	// Entries is the number of entries of the ADDED, CHANGED and FIXED
+	// sections, and ByCategory their number per category
+	Entries    int            `json:"entries"`
+	ByCategory map[string]int `json:"by_category"`
+	// Contributors is the number of distinct authors of the entries, and
+	// NewContributors the number of them who are not an author of any
+	// earlier release of the CHANGELOGs
+	Contributors    int `json:"contributors"`
+	NewContributors int `json:"new_contributors"`
+}
+
+// NotesComparison compares the PRs of the CHANGELOG section of a release with
+// the PRs of the release notes GitHub generates for its tag
+type NotesComparison struct {
+	Release string `json:"release"`
+	// Common is the number of PRs found on both sides
+	Common int `json:"common"`
+	// OnlyInGitHub are the PRs of the GitHub notes which no CHANGELOG entry
+	// links. Most are expected (e.g. PRs without the release note label).
+	OnlyInGitHub []NotesPR `json:"only_in_github"`
+	// OnlyInChangelog are the PRs linked by the CHANGELOG which are not in
+	// the GitHub notes (e.g. typos in PR numbers, or PRs missing from the tag)
+	OnlyInChangelog []NotesPR `json:"only_in_changelog"`
+}
+
+// NotesPR is a PR found on only one side of the release notes comparison
+type NotesPR struct {
+	Number int `json:"pr_number"`
+	// Text is the title of the PR in the GitHub notes, or the CHANGELOG entry
+	// linking it
+	Text   string `json:"text"`
+	Author string `json:"author,omitempty"`
+}
+
+// GuardrailViolation records a category whose number of entries is outside of
+// the limits configured for the kind of release
+type GuardrailViolation struct {
+	Category string `json:"category"`
+	// Release is the kind of release whose limits apply (minor or patch)
+	Release string `json:"release"`
+	Count   int    `json:"count"`
+	Min     *int   `json:"min,omitempty"`
+	Max     *int   `json:"max,omitempty"`
+}
+
+// String describes the violation, e.g. "3 ADDED entries, expected at most 0 for a patch release"
+func (v GuardrailViolation) String() string {
+	var expected []string
+	if v.Min != nil {
+		expected = append(expected, fmt.Sprintf("at least %d", *v.Min))
+	}
+	if v.Max != nil {
+		expected = append(expected, fmt.Sprintf("at most %d", *v.Max))
+	}
+	return fmt.Sprintf("%d %s entries, expected %s for a %s release", v.Count, v.Category, strings.Join(expected, " and "), v.Release)
+}
+
+// MissingLabel records a PR the model considers worth including in the
+// CHANGELOG, but which lacks the action/release-note label
+type MissingLabel struct {
+	Number       int    `json:"pr_number"`
+	Title        string `json:"title"`
+	Author       string `json:"author"`
+	IncludeScore int    `json:"include_score"`
+	Category     string `json:"category"`
+	Description  string `json:"description"`
+}
+
+// QualityScore summarizes the quality of a generated CHANGELOG. The ratios are
+// between 0 and 1, and are 1 when there is nothing to measure.
+type QualityScore struct {
+	// Score is the overall score, between 0 and 100
+	Score int `json:"score"`
+	// Coverage is the ratio of PRs with the release note label which are
+	// included in the CHANGELOG
+	Coverage float64 `json:"coverage"`
+	// Confidence is the average include_score of the included entries, divided by 100
+	Confidence float64 `json:"confidence"`
+	// LintViolations is the number of included entries whose description does
+	// not follow the length and sentence constraints
+	LintViolations int `json:"lint_violations"`
+	// ReuseCompliance is the ratio of included entries with a historical
+	// entry which reuse it
+	ReuseCompliance float64 `json:"reuse_compliance"`
+}
+
+// Dashboard is the release dashboard data of several releases, exported as a
+// single JSON document
+type Dashboard struct {
+	GeneratedAt string             `json:"generated_at"`
+	ToolVersion string             `json:"tool_version"`
+	Releases    []DashboardRelease `json:"releases"`
+	// Unavailable is the dashboard data the releaser does not produce (e.g.
+	// release asset verification), so that the dashboard does not show it as
+	// healthy
+	Unavailable []string `json:"unavailable"`
+}
+
+// DashboardRelease is the dashboard data of a release
+type DashboardRelease struct {
+	Release string `json:"release"`
+	// Runs are the prepare-changelog runs of the release, oldest first
+	Runs []DashboardRun `json:"runs"`
+	// PRs, NotReady, Drift and Notes are fetched from GitHub, they are not set
+	// when only the artifacts are exported
+	PRs int `json:"prs,omitempty"`
+	// NotReady are the PRs whose description should be improved
+	NotReady []PRReadiness    `json:"not_ready,omitempty"`
+	Drift    *ReleaseDrift    `json:"drift,omitempty"`
+	Notes    *NotesComparison `json:"notes_comparison,omitempty"`
+	// Err is the error which stopped fetching the data of the release, if any
+	Err string `json:"error,omitempty"`
+}
+
+// DashboardRun is a prepare-changelog run of a release, read from its artifacts
+type DashboardRun struct {
+	Timestamp        string  `json:"timestamp"`
+	Model            string  `json:"model"`
+	Calls            int     `json:"calls"`
+	TotalTokens      int32   `json:"total_tokens"`
+	EstimatedCostUSD float64 `json:"estimated_cost_usd"`
+	// ModelOutput is true if the run saved its model output, which Entries,
+	// Warnings and Quality are read from. It is false e.g. for a run whose
+	// model call failed.
+	ModelOutput bool `json:"model_output"`
+	// Entries is the number of entries of the model output
+	Entries  int `json:"entries"`
+	Warnings int `json:"warnings"`
+	// Quality is nil if the model output has no quality score, e.g. for a run
+	// which failed before computing it
+	Quality *QualityScore `json:"quality,omitempty"`
+}
+
+// SkippedPR records a PR excluded from the CHANGELOG and why
+type SkippedPR struct {
+	Number int        `json:"pr_number"`
+	Title  string     `json:"title"`
+	Author string     `json:"author"`
+	Reason SkipReason `json:"reason"`
+	Detail string     `json:"detail,omitempty"`
+}
+
+// PROverride records a PR forcibly included in or excluded from a run by the
+// release manager
+type PROverride struct {
+	Number int    `json:"pr_number"`
+	Title  string `json:"title"`
+	Author string `json:"author"`
+	// Included is true for a forced inclusion, false for a forced exclusion
+	Included bool `json:"included"`
+	// Detail describes what the override changed, e.g. the bypassed exclusion
+	Detail string `json:"detail,omitempty"`
+}
+
+// WarningKind classifies the non-fatal issues of a run
+type WarningKind string
+
+const (
+	// WarningKindSkippedFile means a file of a check could not be read, and the check was skipped or incomplete
+	WarningKindSkippedFile WarningKind = "skipped file"
+	// WarningKindUnreachablePR means a PR referenced by a commit or a cherry-pick could not be fetched
+	WarningKindUnreachablePR WarningKind = "unreachable PR"
+	// WarningKindDroppedEntry means an entry returned by the model is not rendered in the CHANGELOG
+	WarningKindDroppedEntry WarningKind = "dropped entry"
+	// WarningKindReuseViolation means the entry of a PR found in a historical CHANGELOG was not reused
+	WarningKindReuseViolation WarningKind = "reuse violation"
+	// WarningKindDescription means a description constraint could not be enforced
+	WarningKindDescription WarningKind = "description"
+	// WarningKindReview means the CHANGELOG needs the attention of a human before releasing
+	WarningKindReview WarningKind = "review"
+	// WarningKindQuota means the quota of the model provider is running out
+	WarningKindQuota WarningKind = "quota"
+	// WarningKindMarkdown means a written CHANGELOG has markdown issues
+	WarningKindMarkdown WarningKind = "markdown"
+	// WarningKindPromptInjection means a PR contains instructions to the model,
+	// which were removed from the prompt, or an entry looks derailed by them
+	WarningKindPromptInjection WarningKind = "prompt injection"
+	// WarningKindLatePR means a PR was merged into the release branch after the CHANGELOG was generated
+	WarningKindLatePR WarningKind = "late PR"
+)
+
+// Warning records a non-fatal issue of a run
+type Warning struct {
+	Kind    WarningKind `json:"kind"`
+	Message string      `json:"message"`
+	// PRNumber and Path are the PR and the file the warning is about, if any
+	PRNumber int    `json:"pr_number,omitempty"`
+	Path     string `json:"path,omitempty"`
+}
+
+// String describes the warning, e.g. "skipped file: go.mod not found at v2.4.0"
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Kind, w.Message)
+}
+
+// CorrectedEntry is the category and description of a CHANGELOG entry
+type CorrectedEntry struct {
+	Category    string `json:"category"`
+	Description string `json:"description"`
+}
+
+// Correction records how a release manager edited a generated entry before
+// the CHANGELOG was merged
+type Correction struct {
+	Release  string `json:"release"`
+	PRNumber int    `json:"pr_number"`
+	// Before is the generated entry, nil if the entry was added by hand
+	Before *CorrectedEntry `json:"before,omitempty"`
+	// After is the merged entry, nil if the generated entry was removed
+	After *CorrectedEntry `json:"after,omitempty"`
 }
 
 // ModelCaller is an interface for calling AI models to generate changelog entries
 type ModelCaller interface {
-	// Call sends a prompt to the model and returns the structured response and metadata
+	// Call sends a prompt to the model and returns the structured response and
+	// metadata. The call must be aborted as soon as ctx is done. On error, the
+	// metadata may still be returned for a call which was billed (e.g. with
+	// malformed output), so that the cost of failed runs is reported.
 	Call(ctx context.Context, prompt, version, modelName string) (*ModelResponse, *ModelDetails, error)
 }
 
@@ -97,4 +554,61 @@ type GitHubClient interface {
 
 	// GetPullRequest gets a single pull request
 	GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error)
+
+	// GetPullRequests gets multiple pull requests in batched requests, keyed by
+	// number. Pull requests which do not exist are omitted from the result.
+	GetPullRequests(ctx context.Context, owner, repo string, numbers []int) (map[int]*github.PullRequest, error)
+
+	// CompareCommits lists all commits reachable from head but not from base
+	CompareCommits(ctx context.Context, owner, repo, base, head string) ([]*github.RepositoryCommit, error)
+
+	// ListPullRequestsWithCommit lists the pull requests associated with a commit
+	ListPullRequestsWithCommit(ctx context.Context, owner, repo, sha string) ([]*github.PullRequest, error)
+
+	// ListPullRequestFiles lists the paths of all files changed by a pull request
+	ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]string, error)
+
+	// GetIssue gets a single issue. Pull requests are issues too, see
+	// Issue.IsPullRequest.
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+
+	// ListOpenIssues lists the open issues with a label, excluding pull requests
+	ListOpenIssues(ctx context.Context, owner, repo, label string) ([]*github.Issue, error)
+
+	// ListTags lists the names of all tags of a repository
+	ListTags(ctx context.Context, owner, repo string) ([]string, error)
+
+	// GetFileAtRef gets the content and blob SHA of a file at a ref. An empty
+	// SHA is returned if the file does not exist.
+	GetFileAtRef(ctx context.Context, owner, repo, path, ref string) (string, string, error)
+
+	// ListLabels lists all labels of a repository
+	ListLabels(ctx context.Context, owner, repo string) ([]*github.Label, error)
+}
+
+// PRPublisher is an interface for the GitHub operations needed to open the CHANGELOG pull request
+type PRPublisher interface {
+	// GetBranchSHA gets the SHA of the head commit of a branch
+	GetBranchSHA(ctx context.Context, owner, repo, branch string) (string, error)
+
+	// CreateBranch creates a branch pointing to a commit
+	CreateBranch(ctx context.Context, owner, repo, branch, sha string) error
+
+	// GetFileAtRef gets the content and blob SHA of a file at a ref. An empty
+	// SHA is returned if the file does not exist.
+	GetFileAtRef(ctx context.Context, owner, repo, path, ref string) (string, string, error)
+
+	// CommitFile creates or updates a file on a branch. The sha is the blob SHA
+	// of the file being replaced, or empty for a new file.
+	CommitFile(ctx context.Context, owner, repo, branch, path, message string, content []byte, sha string) error
+
+	// CreatePullRequest opens a pull request
+	CreatePullRequest(ctx context.Context, owner, repo string, pull *github.NewPullRequest) (*github.PullRequest, error)
+
+	// CreateReviewComment posts a review with a comment body on a pull request
+	CreateReviewComment(ctx context.Context, owner, repo string, number int, body string) error
+
+	// RequestReviewers requests reviews on a pull request from users and
+	// teams (team slugs of the repository owner)
+	RequestReviewers(ctx context.Context, owner, repo string, number int, users, teams []string) error
 }
@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// unresolvedRefRegex matches author references left as plain text by the
+// markdown parser, i.e. references without a matching link definition
+var unresolvedRefRegex = regexp.MustCompile(`\[(@[^\]]*)\]`)
+
+// Validate parses a rendered changelog as markdown and checks structural
+// invariants: every [@author] reference has a matching link definition, every
+// link definition is used, and headings never skip a level
+func Validate(changelogText string) error {
+	source := []byte(stripFrontMatter(changelogText))
+	pc := parser.NewContext()
+	doc := goldmark.New().Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+
+	var problems []string
+	usedRefs := make(map[string]bool)
+	lastLevel := 0
+
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.Heading:
+			if lastLevel == 0 && node.Level > 2 {
+				problems = append(problems, fmt.Sprintf("first heading %q has level %d, expected 1 or 2", headingText(node, source), node.Level))
+			} else if lastLevel != 0 && node.Level > lastLevel+1 {
+				problems = append(problems, fmt.Sprintf("heading %q jumps from level %d to %d", headingText(node, source), lastLevel, node.Level))
+			}
+			lastLevel = node.Level
+		case *ast.Link:
+			if node.Reference != nil {
+				usedRefs[normalizeRefLabel(string(node.Reference.Value))] = true
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.Paragraph, *ast.TextBlock:
+			for _, match := range unresolvedRefRegex.FindAllStringSubmatch(inlineText(node, source), -1) {
+				problems = append(problems, fmt.Sprintf("reference [%s] has no link definition", match[1]))
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk changelog markdown: %w", err)
+	}
+
+	var orphans []string
+	for _, ref := range pc.References() {
+		if !usedRefs[normalizeRefLabel(string(ref.Label()))] {
+			orphans = append(orphans, string(ref.Label()))
+		}
+	}
+	sort.Strings(orphans)
+	for _, label := range orphans {
+		problems = append(problems, fmt.Sprintf("link definition [%s] is never used", label))
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid changelog markdown:\n  - " + strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// inlineText concatenates the plain text of a block's inline children,
+// skipping the content of resolved links
+func inlineText(n ast.Node, source []byte) string {
+	var sb strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch child := c.(type) {
+		case *ast.Text:
+			sb.Write(child.Segment.Value(source))
+		case *ast.Link:
+			sb.WriteString("<link>")
+		default:
+			sb.WriteString(inlineText(child, source))
+		}
+	}
+	return sb.String()
+}
+
+func headingText(h *ast.Heading, source []byte) string {
+	return inlineText(h, source)
+}
+
+func normalizeRefLabel(label string) string {
+	return strings.ToLower(strings.TrimSpace(label))
+}
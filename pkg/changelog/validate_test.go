@@ -0,0 +1,78 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/version"
+)
+
+func TestValidate_FormatterOutput(t *testing.T) {
+	response := &types.ModelResponse{
+		Changes: []types.ChangeEntry{
+			{PRNumber: 1, Category: "ADDED", Description: "Add X", IncludeScore: 100, Author: "alice"},
+			{PRNumber: 2, Category: "FIXED", Description: "Fix Y", IncludeScore: 30, Author: "bob"},
+			{PRNumber: 3, Category: "FIXED", Description: "Fix Z", IncludeScore: 10, Author: "carol"},
+		},
+	}
+
+	for _, ver := range []*version.Version{version.New(2, 5, 0), version.New(2, 5, 1)} {
+		text := formatChangelog(ver, response, formatOptions{thresholds: config.DefaultThresholds(), provenanceComment: provenanceComment("gemini-2.5-flash", "prompt", "20250130-143025")})
+		require.NoError(t, Validate(text), "Formatter output for %s should be valid", ver)
+	}
+}
+
+func TestValidate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "missing author definition",
+			text:     "## 2.5.0 - 2025-01-01\n\n### Added\n\n- Add X. ([#1](https://github.com/antrea-io/antrea/pull/1), [@alice])\n",
+			expected: "reference [@alice] has no link definition",
+		},
+		{
+			name:     "orphan definition",
+			text:     "## 2.5.0 - 2025-01-01\n\n### Added\n\n- Add X. ([#1](https://github.com/antrea-io/antrea/pull/1), [@alice])\n\n[@alice]: https://github.com/alice\n[@bob]: https://github.com/bob\n",
+			expected: "link definition [@bob] is never used",
+		},
+		{
+			name:     "skipped heading level",
+			text:     "# Changelog 2.5\n\n### Added\n",
+			expected: "jumps from level 1 to 3",
+		},
+		{
+			name:     "first heading too deep",
+			text:     "### Added\n",
+			expected: "expected 1 or 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.text)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
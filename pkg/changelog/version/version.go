@@ -16,6 +16,7 @@ package version
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 )
@@ -40,6 +41,22 @@ func Parse(versionStr string) (*Version, error) {
 	}, nil
 }
 
+// ParseTag parses a release tag (vX.Y.Z). Pre-release tags (e.g. v2.5.0-rc.1)
+// and tags which are not versions are rejected.
+func ParseTag(tag string) (*Version, error) {
+	if !strings.HasPrefix(tag, "v") {
+		return nil, fmt.Errorf("invalid release tag %s: missing v prefix", tag)
+	}
+	v, err := semver.StrictNewVersion(strings.TrimPrefix(tag, "v"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid release tag %s: %w", tag, err)
+	}
+	if v.Prerelease() != "" || v.Metadata() != "" {
+		return nil, fmt.Errorf("invalid release tag %s: not a final release", tag)
+	}
+	return New(v.Major(), v.Minor(), v.Patch()), nil
+}
+
 // New creates a new Version instance with the given components
 func New(major, minor, patch uint64) *Version {
 	return &Version{
@@ -93,3 +110,28 @@ func (v *Version) CalculatePreviousRelease() string {
 	// Patch release: previous patch version
 	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch-1)
 }
+
+// PreviousRelease returns the comparison baseline for this version among the
+// existing releases: the latest previous patch release of the same minor for
+// patch releases, or the latest previous minor release (X.Y.0) for minor
+// releases. Unlike CalculatePreviousRelease, it handles skipped versions. It
+// returns nil if no existing release qualifies.
+func (v *Version) PreviousRelease(releases []*Version) *Version {
+	var previous *Version
+	for _, r := range releases {
+		if !v.GreaterThan(r) {
+			continue
+		}
+		if v.patch > 0 {
+			if r.major != v.major || r.minor != v.minor {
+				continue
+			}
+		} else if r.patch != 0 {
+			continue
+		}
+		if previous == nil || r.GreaterThan(previous) {
+			previous = r
+		}
+	}
+	return previous
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTag(t *testing.T) {
+	v, err := ParseTag("v2.5.1")
+	require.NoError(t, err)
+	assert.Equal(t, "2.5.1", v.String())
+
+	for _, tag := range []string{"2.5.1", "v2.5.0-rc.1", "v2.5", "latest"} {
+		_, err := ParseTag(tag)
+		assert.Error(t, err, "Tag %s should be rejected", tag)
+	}
+}
+
+func TestPreviousRelease(t *testing.T) {
+	var releases []*Version
+	for _, tag := range []string{"v1.15.0", "v1.15.1", "v2.0.0", "v2.1.0", "v2.3.0", "v2.3.1", "v2.3.3", "v2.4.0"} {
+		v, err := ParseTag(tag)
+		require.NoError(t, err)
+		releases = append(releases, v)
+	}
+
+	tests := []struct {
+		release  string
+		expected string
+	}{
+		{"2.3.4", "2.3.3"},
+		{"2.3.3", "2.3.1"}, // 2.3.2 was skipped
+		{"2.4.1", "2.4.0"},
+		{"2.5.0", "2.4.0"},
+		{"2.3.0", "2.1.0"}, // 2.2.0 was skipped
+		{"2.0.0", "1.15.0"},
+		{"2.0.1", "2.0.0"},
+		{"3.0.0", "2.4.0"},
+		{"1.15.0", ""},
+		{"2.2.1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.release, func(t *testing.T) {
+			v, err := Parse(tt.release)
+			require.NoError(t, err)
+			previous := v.PreviousRelease(releases)
+			if tt.expected == "" {
+				assert.Nil(t, previous)
+			} else {
+				require.NotNil(t, previous)
+				assert.Equal(t, tt.expected, previous.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// LatePRs returns the selected PRs of the release which the previous run (see
+// WithPreviousResponse) does not cover, i.e. the PRs merged since the
+// changelog was generated, without calling the model. Only the merged PRs are
+// listed: the PRs which are not covered are filtered as when generating the
+// changelog, but the other inputs of the changelog are not fetched.
+func (g *ChangelogGenerator) LatePRs(ctx context.Context) ([]types.PRInfo, error) {
+	if g.previousResponse == nil {
+		return nil, fmt.Errorf("the output of a previous run is required to find the late PRs")
+	}
+	fetchCtx, cancel := stageContext(ctx, g.timeouts.Fetch)
+	defer cancel()
+	inputs, err := g.releaseRange(fetchCtx)
+	if err == nil {
+		err = g.listPRs(fetchCtx, inputs)
+	}
+	if err != nil {
+		return nil, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+	}
+
+	covered := make(map[int]bool)
+	for _, change := range g.previousResponse.Changes {
+		covered[change.PRNumber] = true
+		for _, number := range change.GroupedWith {
+			covered[number] = true
+		}
+	}
+	var candidates []types.PRInfo
+	for _, pr := range inputs.prs {
+		if !covered[pr.Number] {
+			candidates = append(candidates, pr)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	// Only the candidates are filtered, so the files of the PRs already in
+	// the changelog are not fetched again
+	selected, err := g.selectPRs(fetchCtx, candidates)
+	if err != nil {
+		return nil, stageError(fetchCtx, StageFetch, g.timeouts.Fetch, err)
+	}
+	// The PRs forced with the overrides are fetched even if they are covered
+	_, late := splitIncremental(g.previousResponse, selected)
+	return late, nil
+}
+
+// ReleaseTagged returns true if the tag of the release (e.g. v2.5.0) exists
+// in antrea-io/antrea
+func ReleaseTagged(ctx context.Context, client types.GitHubClient, release string) (bool, error) {
+	tag := "v" + release
+	if _, err := client.GetTagRef(ctx, repoOwner, repoName, tag); err != nil {
+		var notFoundErr *types.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get tag %s: %w", tag, err)
+	}
+	return true, nil
+}
+
+// FormatLatePRsAlert renders the alert about PRs merged into the release
+// branch after the changelog was generated, e.g. posted on the release
+// tracking issue. regenerated tells whether the changelog was generated again.
+func FormatLatePRsAlert(release string, prs []types.PRInfo, regenerated bool) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d PRs were merged for %s after the CHANGELOG was generated:\n\n", len(prs), release))
+	for _, pr := range prs {
+		sb.WriteString(fmt.Sprintf("- #%d: %s (@%s)\n", pr.Number, pr.Title, pr.Author))
+	}
+	if regenerated {
+		sb.WriteString("\nThe CHANGELOG was generated again to include them, please review the new entries.\n")
+	} else {
+		sb.WriteString("\nThe CHANGELOG must be generated again (e.g. with `--incremental`) before the release is tagged.\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v76/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/config"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/mocks"
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+func TestReleaseTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	mockGitHubClient.EXPECT().GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(&gogithub.Reference{Ref: gogithub.Ptr("refs/tags/v2.4.0")}, nil)
+	mockGitHubClient.EXPECT().GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.5.0").
+		Return(nil, &types.NotFoundError{Err: errors.New("404 Not Found")})
+	mockGitHubClient.EXPECT().GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.6.0").
+		Return(nil, &types.GitHubError{Err: errors.New("500 Internal Server Error")})
+
+	tagged, err := ReleaseTagged(context.Background(), mockGitHubClient, "2.4.0")
+	require.NoError(t, err)
+	assert.True(t, tagged)
+
+	tagged, err = ReleaseTagged(context.Background(), mockGitHubClient, "2.5.0")
+	require.NoError(t, err)
+	assert.False(t, tagged)
+
+	_, err = ReleaseTagged(context.Background(), mockGitHubClient, "2.6.0")
+	require.ErrorContains(t, err, "failed to get tag v2.6.0")
+}
+
+func TestLatePRs_NoPreviousRun(t *testing.T) {
+	generator := NewChangelogGenerator("2.5.0", "2.4.0", false, "gemini-2.5-flash", nil, nil)
+	_, err := generator.LatePRs(context.Background())
+	require.ErrorContains(t, err, "the output of a previous run is required")
+}
+
+func TestLatePRs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Only the merged PRs are listed: the historical CHANGELOGs are not
+	// fetched, and only the files of the PRs not covered are
+	mockGitHubClient := mocks.NewMockGitHubClient(ctrl)
+	sha := "abc123"
+	mockGitHubClient.EXPECT().GetTagRef(gomock.Any(), "antrea-io", "antrea", "v2.4.0").
+		Return(&gogithub.Reference{Object: &gogithub.GitObject{SHA: &sha}}, nil)
+	mockGitHubClient.EXPECT().GetCommit(gomock.Any(), "antrea-io", "antrea", sha).
+		Return(&gogithub.Commit{Committer: &gogithub.CommitAuthor{Date: &gogithub.Timestamp{Time: time.Now().Add(-30 * 24 * time.Hour)}}}, nil)
+	mergedAt := &gogithub.Timestamp{Time: time.Now()}
+	pr := func(number int, title, author string) *gogithub.PullRequest {
+		return &gogithub.PullRequest{
+			Number:   gogithub.Ptr(number),
+			Title:    gogithub.Ptr(title),
+			User:     &gogithub.User{Login: gogithub.Ptr(author)},
+			MergedAt: mergedAt,
+			Labels:   []*gogithub.Label{{Name: gogithub.Ptr("action/release-note")}},
+		}
+	}
+	mockGitHubClient.EXPECT().ListPullRequests(gomock.Any(), "antrea-io", "antrea", gomock.Any()).
+		Return([]*gogithub.PullRequest{
+			pr(7200, "Fix Egress IP leak", "alice"),
+			pr(7201, "Fix Egress IP leak on Windows", "bob"),
+			pr(7202, "Fix IPsec tunnel flapping", "carol"),
+			pr(7203, "Update the Egress documentation", "dave"),
+			pr(7204, "Update module golang.org/x/net to v0.38.0", "renovate[bot]"),
+		}, &gogithub.Response{}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 7202).
+		Return([]string{"pkg/agent/route/route_linux.go"}, nil)
+	mockGitHubClient.EXPECT().ListPullRequestFiles(gomock.Any(), "antrea-io", "antrea", 7203).
+		Return([]string{"docs/egress.md"}, nil)
+
+	previous := &types.ModelResponse{Changes: []types.ChangeEntry{
+		{PRNumber: 7200, Category: "FIXED", Description: "Fix Egress IP leak", IncludeScore: 90, GroupedWith: []int{7201}},
+	}}
+	generator := NewChangelogGenerator("2.5.0", "2.4.0", false, "gemini-2.5-flash", nil, mockGitHubClient,
+		WithPreviousResponse(previous), WithDocsOnlyPRs(DocsOnlyExclude, config.DefaultDocsPaths()))
+	late, err := generator.LatePRs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, late, 1, "the PRs filtered when generating the changelog are not late")
+	assert.Equal(t, 7202, late[0].Number)
+}
+
+func TestFormatLatePRsAlert(t *testing.T) {
+	prs := []types.PRInfo{
+		{Number: 7200, Title: "Fix IPsec tunnel flapping", Author: "alice"},
+		{Number: 7201, Title: "Bump golang.org/x/net to v0.38.0", Author: "bob"},
+	}
+
+	alert := FormatLatePRsAlert("2.5.0", prs, false)
+	assert.Equal(t, "2 PRs were merged for 2.5.0 after the CHANGELOG was generated:\n\n"+
+		"- #7200: Fix IPsec tunnel flapping (@alice)\n"+
+		"- #7201: Bump golang.org/x/net to v0.38.0 (@bob)\n\n"+
+		"The CHANGELOG must be generated again (e.g. with `--incremental`) before the release is tagged.\n", alert)
+
+	alert = FormatLatePRsAlert("2.5.0", prs[:1], true)
+	assert.Contains(t, alert, "- #7200: Fix IPsec tunnel flapping (@alice)\n")
+	assert.Contains(t, alert, "The CHANGELOG was generated again to include them")
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changelog
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/antrea-io/antrea-releaser/pkg/changelog/types"
+)
+
+// ConfirmFunc asks the user to confirm a suspicious run and returns true to continue
+type ConfirmFunc func(warning string) bool
+
+// WindowLimits configures the sanity checks of the release window. A zero
+// value disables the corresponding check.
+type WindowLimits struct {
+	// MaxMonths is the maximum age of the from-release, in months
+	MaxMonths int
+	// MaxPRs is the maximum number of PRs sent to the model
+	MaxPRs int
+}
+
+// checkWindowAge warns if the from-release is older than the configured limit
+func (g *ChangelogGenerator) checkWindowAge(fromRelease string, since time.Time) error {
+	if g.windowLimits.MaxMonths <= 0 {
+		return nil
+	}
+	if !since.Before(g.clock().AddDate(0, -g.windowLimits.MaxMonths, 0)) {
+		return nil
+	}
+	warning := fmt.Sprintf("The from-release %s was tagged on %s, more than %d months ago. Is --from-release correct?",
+		fromRelease, since.Format("2006-01-02"), g.windowLimits.MaxMonths)
+	if !g.confirmWindow(warning) {
+		return fmt.Errorf("aborted: %s", warning)
+	}
+	return nil
+}
+
+// checkWindowSize warns if more PRs than the configured limit would be sent to the model
+func (g *ChangelogGenerator) checkWindowSize(numPRs int) error {
+	if g.windowLimits.MaxPRs <= 0 || numPRs <= g.windowLimits.MaxPRs {
+		return nil
+	}
+	warning := fmt.Sprintf("%d PRs would be sent to the model, more than the limit of %d. This will produce a large and expensive prompt.",
+		numPRs, g.windowLimits.MaxPRs)
+	if !g.confirmWindow(warning) {
+		return &types.BudgetExceededError{Reason: warning}
+	}
+	return nil
+}
+
+// confirmWindow logs a warning and returns true if the run should continue
+func (g *ChangelogGenerator) confirmWindow(warning string) bool {
+	banner := strings.Repeat("!", 80)
+	log.Printf("%s\nWARNING: %s\n%s", banner, warning, banner)
+	return g.confirm != nil && g.confirm(warning)
+}